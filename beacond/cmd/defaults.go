@@ -27,6 +27,9 @@ import (
 //nolint:funlen // happens
 func DefaultComponents() []any {
 	c := []any{
+		components.ProvideABCIQueryHandlers[
+			*BeaconBlockHeader, *ExecutionPayloadHeader,
+		],
 		components.ProvideABCIMiddleware[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*BlobSidecar, *BlobSidecars, *Deposit, *ExecutionPayloadHeader,
@@ -47,6 +50,10 @@ func DefaultComponents() []any {
 		components.ProvideBlockStore[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Logger,
 		],
+		components.ProvideBlockPruner[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
+			*BlockStore, *Logger,
+		],
 		components.ProvideBlockStoreService[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*BlockStore, *Logger,
@@ -77,7 +84,9 @@ func DefaultComponents() []any {
 			*AvailabilityStore, *BeaconBlockBody, *BeaconBlockHeader,
 			*ConsensusSidecars, *BlobSidecar, *BlobSidecars, *Logger,
 		],
-		components.ProvideDBManager[*AvailabilityStore, *DepositStore, *Logger],
+		components.ProvideDBManager[
+			*AvailabilityStore, *BlockStore, *DepositStore, *Logger,
+		],
 		components.ProvideDepositPruner[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*Deposit, *DepositStore, *Logger,
@@ -91,7 +100,7 @@ func DefaultComponents() []any {
 		components.ProvideDispatcher[
 			*ConsensusBlock, *BeaconBlock,
 			*ConsensusSidecars, *BlobSidecars,
-			*Genesis, *Logger,
+			*Genesis, *Logger, *Withdrawal,
 		],
 		components.ProvideEngineClient[
 			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
@@ -100,6 +109,12 @@ func DefaultComponents() []any {
 			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
 		],
 		components.ProvideJWTSecret,
+		components.ProvideLightClientStore[*Logger],
+		components.ProvideLightClientService[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *BeaconState,
+			*BeaconStateMarshallable, *BlobSidecars, *ExecutionPayloadHeader,
+			*KVStore, *Logger, *CometBFTService,
+		],
 		components.ProvideLocalBuilder[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
@@ -119,10 +134,13 @@ func DefaultComponents() []any {
 		components.ProvideSidecarFactory[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 		],
+		components.ProvideSnapshotRestoredHandler[
+			*BeaconState, *DepositService, *StorageBackend,
+		],
 		components.ProvideStateProcessor[
 			*Logger, *BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
-			*BeaconState, *BeaconStateMarshallable, *Deposit, *ExecutionPayload,
-			*ExecutionPayloadHeader, *KVStore,
+			*BeaconState, *BeaconStateMarshallable, *Deposit, *DepositStore,
+			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore,
 		],
 		components.ProvideKVStore[*BeaconBlockHeader, *ExecutionPayloadHeader],
 		components.ProvideStorageBackend[
@@ -131,12 +149,18 @@ func DefaultComponents() []any {
 		],
 		components.ProvideTelemetrySink,
 		components.ProvideTelemetryService,
+		components.ProvideTracingService,
 		components.ProvideTrustedSetup,
 		components.ProvideValidatorService[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
-			*BlockStore, *BlobSidecars, *Deposit, *DepositStore,
-			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
+			*BlockStore, *BlobSidecars, *Deposit, *DepositContract,
+			*DepositStore, *ExecutionPayload, *ExecutionPayloadHeader,
+			*KVStore, *Logger, *StorageBackend,
+		],
+		components.ProvideVoluntaryExitPool[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *BeaconState,
+			*Deposit, *ExecutionPayload, *ExecutionPayloadHeader, *Logger,
 			*StorageBackend,
 		],
 		// TODO Hacks
@@ -150,26 +174,41 @@ func DefaultComponents() []any {
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BlockStore, *BeaconState,
 			*BeaconStateMarshallable, *BlobSidecars, *Deposit, *DepositStore,
-			*ExecutionPayloadHeader, *KVStore, *CometBFTService, *StorageBackend,
+			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore,
+			*CometBFTService, *StorageBackend,
 		],
 	)
 
 	c = append(c,
 		components.ProvideNodeAPIHandlers[
-			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
-			*ExecutionPayloadHeader, *KVStore, NodeAPIContext,
+			*BeaconBlock, *BeaconBlockHeader, *BeaconState,
+			*BeaconStateMarshallable, *BlindedBeaconBlock, *BlobSidecar,
+			*BlobSidecars, *ExecutionPayloadHeader, *KVStore, NodeAPIContext,
 		],
 		components.ProvideNodeAPIBeaconHandler[
-			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+			*BeaconBlock, *BeaconBlockHeader, *BeaconState,
+			*BlindedBeaconBlock, *BlobSidecar, *BlobSidecars, *CometBFTService,
+			NodeAPIContext,
 		],
 		components.ProvideNodeAPIBuilderHandler[NodeAPIContext],
 		components.ProvideNodeAPIConfigHandler[NodeAPIContext],
-		components.ProvideNodeAPIDebugHandler[NodeAPIContext],
-		components.ProvideNodeAPIEventsHandler[NodeAPIContext],
-		components.ProvideNodeAPINodeHandler[NodeAPIContext],
+		components.ProvideNodeAPIDebugHandler[
+			*BeaconBlock, *BeaconBlockHeader, *BeaconState,
+			*BeaconStateMarshallable, *BlobSidecars, *ExecutionPayloadHeader,
+			*KVStore, *CometBFTService, NodeAPIContext,
+		],
+		components.ProvideNodeAPIEventsHandler[
+			*BeaconBlock, *BeaconBlockHeader, *BlobSidecar, *BlobSidecars,
+			NodeAPIContext, *Withdrawal,
+		],
+		components.ProvideNodeAPINodeHandler[
+			*BeaconBlock, *BeaconBlockHeader, *BeaconState, *BlobSidecars,
+			*CometBFTService, NodeAPIContext,
+		],
 		components.ProvideNodeAPIProofHandler[
-			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
-			*ExecutionPayloadHeader, *KVStore, *CometBFTService, NodeAPIContext,
+			*BeaconBlock, *BeaconBlockHeader, *BeaconState,
+			*BeaconStateMarshallable, *BlobSidecars, *ExecutionPayloadHeader,
+			*KVStore, *CometBFTService, NodeAPIContext,
 		],
 	)
 
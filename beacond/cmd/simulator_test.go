@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build simulated
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/testing/simulator"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/stretchr/testify/require"
+)
+
+// newNetwork starts a simulated network of beacond nodes built from the
+// default components, applying the given options to its configuration.
+func newNetwork(
+	t *testing.T,
+	options ...func(*simulator.Config[*Logger]),
+) *simulator.Network[*Logger] {
+	t.Helper()
+
+	// The nodes run the devnet chain spec with Deneb+ active from genesis
+	// and short epochs, loaded from a spec file that overrides the base
+	// spec.
+	chainSpec := filepath.Join(t.TempDir(), "spec.toml")
+	require.NoError(t, os.WriteFile(chainSpec, []byte(fmt.Sprintf(
+		"deposit-eth1-chain-id = %d\ndeneb-plus-fork-epoch = 0\n"+
+			"slots-per-epoch = 8\n",
+		spec.DevnetEth1ChainID,
+	)), 0o600))
+
+	cfg := simulator.DefaultConfig[*Logger]()
+	cfg.Components = DefaultComponents()
+	cfg.HomeDir = t.TempDir()
+	cfg.KZGTrustedSetupPath = "../../testing/files/kzg-trusted-setup.json"
+	cfg.NewLogger = func(int) *Logger {
+		out := io.Discard
+		if testing.Verbose() {
+			out = os.Stdout
+		}
+		logCfg := phuslu.DefaultConfig()
+		return phuslu.NewLogger(out, &logCfg)
+	}
+	cfg.AppOptions[flags.ChainSpec] = chainSpec
+	for _, option := range options {
+		option(&cfg)
+	}
+
+	network, err := simulator.New(cfg)
+	require.NoError(t, err)
+	t.Cleanup(network.Close)
+	return network
+}
+
+// requireAgreement requires every node to have committed the last block
+// decided by the network with the same AppHash.
+func requireAgreement(t *testing.T, network *simulator.Network[*Logger]) {
+	t.Helper()
+	for _, node := range network.Nodes() {
+		require.NoError(t, node.Halted())
+		require.Equal(t, network.Height(), node.Height())
+		require.Equal(t, network.Node(0).AppHash(), node.AppHash())
+	}
+}
+
+func TestSimulatedBlockProduction(t *testing.T) {
+	t.Parallel()
+	network := newNetwork(t)
+	start := network.Height()
+	require.NoError(t, network.ProduceBlocks(context.Background(), 10))
+	require.Equal(t, start+10, network.Height())
+	requireAgreement(t, network)
+	for _, node := range network.Nodes() {
+		for name, err := range node.ServicesHealth() {
+			require.NoError(t, err, name)
+		}
+	}
+}
+
+func TestSimulatedCrashedProposer(t *testing.T) {
+	t.Parallel()
+	network := newNetwork(t)
+	start := network.Height()
+	network.Crash(0)
+	require.NoError(t, network.ProduceBlocks(context.Background(), 8))
+
+	// The heights node 0 was due to propose at are decided in later rounds.
+	var skipped bool
+	for height := start + 1; height <= network.Height(); height++ {
+		require.NotEqual(t, network.Node(0).Address(), network.Proposer(height))
+		skipped = skipped || network.Round(height) > 0
+	}
+	require.True(t, skipped)
+
+	network.Recover(0)
+	require.NoError(t, network.ProduceBlocks(context.Background(), 2))
+	requireAgreement(t, network)
+}
+
+func TestSimulatedPartition(t *testing.T) {
+	t.Parallel()
+	network := newNetwork(t)
+	require.NoError(t, network.ProduceBlocks(context.Background(), 2))
+
+	// Neither half of an even split reaches a quorum.
+	network.Partition([]int{0, 1}, []int{2, 3})
+	require.ErrorIs(
+		t,
+		network.ProduceBlocks(context.Background(), 1),
+		simulator.ErrNoQuorum,
+	)
+	network.Heal()
+	require.NoError(t, network.ProduceBlocks(context.Background(), 2))
+	requireAgreement(t, network)
+
+	// The majority side of an uneven split keeps deciding blocks while the
+	// isolated node falls behind until the partition heals.
+	network.Partition([]int{0, 1, 2})
+	require.NoError(t, network.ProduceBlocks(context.Background(), 4))
+	require.Less(t, network.Node(3).Height(), network.Height())
+	network.Heal()
+	require.NoError(t, network.ProduceBlocks(context.Background(), 1))
+	requireAgreement(t, network)
+}
+
+func TestSimulatedInvalidPayload(t *testing.T) {
+	t.Parallel()
+	network := newNetwork(t)
+
+	// A single node rejecting a payload does not prevent a quorum.
+	network.Node(1).ExecutionClient().SetPayloadStatus(engine.INVALID, 1)
+	require.NoError(t, network.ProduceBlocks(context.Background(), 1))
+	require.Equal(t, 0, network.Round(network.Height()))
+	start := network.Height()
+
+	// Payloads with an invalid block hash are rejected by every node, the
+	// heights node 0 proposes at are decided in later rounds.
+	network.Node(0).ExecutionClient().CorruptPayloads(true)
+	require.NoError(t, network.ProduceBlocks(context.Background(), 4))
+	var skipped bool
+	for height := start + 1; height <= network.Height(); height++ {
+		require.NotEqual(t, network.Node(0).Address(), network.Proposer(height))
+		skipped = skipped || network.Round(height) > 0
+	}
+	require.True(t, skipped)
+
+	network.Node(0).ExecutionClient().CorruptPayloads(false)
+	require.NoError(t, network.ProduceBlocks(context.Background(), 4))
+	requireAgreement(t, network)
+}
+
+func TestSimulatedSlowExecutionClient(t *testing.T) {
+	t.Parallel()
+	network := newNetwork(t)
+	for _, node := range network.Nodes() {
+		node.ExecutionClient().SetLatency(20 * time.Millisecond)
+	}
+	require.NoError(t, network.ProduceBlocks(context.Background(), 4))
+	requireAgreement(t, network)
+}
+
+func TestSimulatedDeposit(t *testing.T) {
+	t.Parallel()
+	network := newNetwork(t)
+	require.NoError(t, network.ProduceBlocks(context.Background(), 2))
+
+	// The validator joins the set once its deposit is processed at the next
+	// epoch boundary.
+	require.NoError(t, network.AddValidator(math.Gwei(32e9)))
+	require.NoError(t, network.ProduceBlocks(context.Background(), 20))
+	require.Equal(t, 5, network.Validators())
+	requireAgreement(t, network)
+}
+
+func TestSimulatedTracing(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	// Spans are flushed when the network is closed, which happens in an
+	// earlier registered cleanup.
+	t.Cleanup(func() { requireTraced(t, path) })
+
+	network := newNetwork(t, func(cfg *simulator.Config[*Logger]) {
+		cfg.AppOptions[flags.TracingEnabled] = true
+		cfg.AppOptions[flags.TracingExporter] = "file"
+		cfg.AppOptions[flags.TracingFilePath] = path
+	})
+	require.NoError(t, network.ProduceBlocks(context.Background(), 3))
+}
+
+// requireTraced requires the spans written to the file at path to cover the
+// block lifecycle, with the traces of a block linked together.
+func requireTraced(t *testing.T, path string) {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var (
+		names   = make(map[string]bool)
+		linked  bool
+		scanner = bufio.NewScanner(file)
+	)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var span struct {
+			Name  string
+			Links []json.RawMessage
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &span))
+		names[span.Name] = true
+		linked = linked ||
+			(span.Name == "cometbft.FinalizeBlock" && len(span.Links) > 0)
+	}
+	require.NoError(t, scanner.Err())
+
+	for _, name := range []string{
+		"cometbft.PrepareProposal",
+		"cometbft.ProcessProposal",
+		"cometbft.FinalizeBlock",
+		"middleware.FinalizeBlock",
+		"dispatcher.Publish",
+		"validator.BuildBlockAndSidecars",
+		"validator.ComputeStateRoot",
+		"blockchain.VerifyStateRoot",
+		"blockchain.ExecuteStateTransition",
+		"state_processor.ProcessSlots",
+		"engine.NewPayload",
+		"engine.ForkchoiceUpdated",
+	} {
+		require.True(t, names[name], name)
+	}
+	require.True(t, linked)
+}
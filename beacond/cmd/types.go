@@ -23,6 +23,7 @@ package main
 import (
 	"cosmossdk.io/core/appmodule/v2"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/pool"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
@@ -39,6 +40,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
 	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	lightclient "github.com/berachain/beacon-kit/mod/node-api/light_client"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
@@ -48,12 +50,13 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	lightclientstore "github.com/berachain/beacon-kit/mod/storage/pkg/lightclient"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/statestore"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -136,7 +139,7 @@ type (
 	IndexDB = filedb.RangeDB
 
 	// KVStore is a type alias for the KV store.
-	KVStore = beacondb.KVStore[
+	KVStore = statestore.KVStore[
 		*BeaconBlockHeader,
 		*Eth1Data,
 		*ExecutionPayloadHeader,
@@ -145,6 +148,15 @@ type (
 		Validators,
 	]
 
+	// LightClientService is a type alias for the light client service.
+	LightClientService = lightclient.Service[
+		*BeaconBlock,
+		*BeaconBlockHeader,
+		*BeaconState,
+		*BeaconStateMarshallable,
+		*LightClientStore,
+	]
+
 	// LocalBuilder is a type alias for the local builder.
 	LocalBuilder = payloadbuilder.PayloadBuilder[
 		*BeaconState,
@@ -203,7 +215,6 @@ type (
 
 	// ValidatorService is a type alias for the validator service.
 	ValidatorService = validator.Service[
-		*AttestationData,
 		*BeaconBlock,
 		*BeaconBlockBody,
 		*BeaconState,
@@ -214,8 +225,13 @@ type (
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
 		*ForkData,
-		*SlashingInfo,
 		*SlotData,
+		*SignedVoluntaryExit,
+	]
+
+	// VoluntaryExitPool is a type alias for the voluntary exit pool.
+	VoluntaryExitPool = pool.VoluntaryExitPool[
+		*BeaconBlock, *BeaconBlockBody, *BeaconState, *SignedVoluntaryExit,
 	]
 )
 
@@ -231,10 +247,11 @@ type (
 	AvailabilityStore = dastore.Store[*BeaconBlockBody]
 
 	// BeaconBlock type aliases.
-	ConsensusBlock    = consruntimetypes.ConsensusBlock[*BeaconBlock]
-	BeaconBlock       = types.BeaconBlock
-	BeaconBlockBody   = types.BeaconBlockBody
-	BeaconBlockHeader = types.BeaconBlockHeader
+	ConsensusBlock     = consruntimetypes.ConsensusBlock[*BeaconBlock]
+	BeaconBlock        = types.BeaconBlock
+	BeaconBlockBody    = types.BeaconBlockBody
+	BeaconBlockHeader  = types.BeaconBlockHeader
+	BlindedBeaconBlock = types.BlindedBeaconBlock
 
 	// BeaconState is a type alias for the BeaconState.
 	BeaconState = statedb.StateDB[
@@ -309,6 +326,12 @@ type (
 		*ExecutionPayloadHeader,
 	]
 
+	// LightClientStore is a type alias for the light client update store.
+	LightClientStore = lightclientstore.KVStore[*LightClientUpdate]
+
+	// LightClientUpdate is a type alias for the light client update.
+	LightClientUpdate = types.LightClientUpdate
+
 	// Logger is a type alias for the logger.
 	Logger = phuslu.Logger
 
@@ -358,6 +381,9 @@ type (
 	// PayloadID is a type alias for the payload ID.
 	PayloadID = engineprimitives.PayloadID
 
+	// SignedVoluntaryExit is a type alias for the signed voluntary exit.
+	SignedVoluntaryExit = types.SignedVoluntaryExit
+
 	// SlashingInfo is a type alias for the slashing info.
 	SlashingInfo = types.SlashingInfo
 
@@ -394,6 +420,9 @@ type (
 	// DAPruner is a type alias for the DA pruner.
 	DAPruner = pruner.Pruner[*IndexDB]
 
+	// BlockPruner is a type alias for the block pruner.
+	BlockPruner = pruner.Pruner[*BlockStore]
+
 	// DepositPruner is a type alias for the deposit pruner.
 	DepositPruner = pruner.Pruner[*DepositStore]
 )
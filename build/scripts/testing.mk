@@ -280,4 +280,9 @@ test-e2e: ## run e2e tests
 	@$(MAKE) build-docker VERSION=kurtosis-local test-e2e-no-build
 
 test-e2e-no-build:
-	go test -tags e2e,bls12381 ./testing/e2e/. -v
\ No newline at end of file
+	go test -tags e2e,bls12381 ./testing/e2e/. -v
+
+test-simulated: ## run the in-process multi-node network simulations
+	go test -tags simulated,bls12381,pebbledb,blst ./beacond/cmd/. -v
+	go test -tags simulated -ldflags=-checklinkname=0 \
+		./mod/cli/pkg/commands/deposit/. -v
\ No newline at end of file
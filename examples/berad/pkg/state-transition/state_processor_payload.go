@@ -116,6 +116,8 @@ func (sp *StateProcessor[
 			payload,
 			body.GetBlobKzgCommitments().ToVersionedHashes(),
 			&parentBeaconBlockRoot,
+			nil,
+			sp.cs.ActiveForkVersionForSlot(blk.GetSlot().Unwrap()),
 			optimisticEngine,
 		),
 	); err != nil {
@@ -35,16 +35,19 @@ var _ types.Dispatcher = (*Dispatcher)(nil)
 type Dispatcher struct {
 	brokers map[async.EventID]types.Broker
 	logger  log.Logger
+	sink    TelemetrySink
 }
 
 // NewDispatcher creates a new event server.
 func New(
 	logger log.Logger,
+	sink TelemetrySink,
 	options ...Option,
 ) (*Dispatcher, error) {
 	d := &Dispatcher{
 		brokers: make(map[async.EventID]types.Broker),
 		logger:  logger,
+		sink:    sink,
 	}
 	for _, option := range options {
 		if err := option(d); err != nil {
@@ -55,7 +58,13 @@ func New(
 }
 
 // Publish dispatches the given event to the broker with the given eventID.
-func (d *Dispatcher) Publish(event async.BaseEvent) error {
+// The publication is traced as a child of the span of the event context.
+func (d *Dispatcher) Publish(event async.BaseEvent) (err error) {
+	_, endSpan := d.sink.StartSpan(
+		event.Context(), "dispatcher.Publish", "event", event.ID(),
+	)
+	defer func() { endSpan(err) }()
+
 	broker, ok := d.brokers[event.ID()]
 	if !ok {
 		return errBrokerNotFound(event.ID())
@@ -21,8 +21,10 @@
 package blockchain
 
 import (
+	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -41,6 +43,19 @@ func newChainMetrics(
 	}
 }
 
+// startBlockSpan starts a span for the processing of a block, identified by
+// its slot and root. The root is only computed when tracing is enabled.
+func (cm *chainMetrics) startBlockSpan(
+	ctx context.Context,
+	name string,
+	slot math.Slot,
+	root func() common.Root,
+) (context.Context, func(error)) {
+	return cm.sink.StartSpan(
+		ctx, name, "slot", slot, "block_root", func() any { return root() },
+	)
+}
+
 // measureStateTransitionDuration measures the time to process
 // the state transition for a block.
 func (cm *chainMetrics) measureStateTransitionDuration(
@@ -60,6 +60,13 @@ func (s *Service[
 	}
 
 	st := s.storageBackend.StateFromContext(ctx)
+
+	// A node that is block syncing, e.g. after restoring from a state sync
+	// snapshot, only sees blocks through FinalizeBlock. Force a sync of the
+	// startup head here as well so that the execution client is brought in
+	// line with the restored state before the first payload is sent.
+	s.forceStartupSyncOnce.Do(func() { s.forceStartupHead(ctx, st) })
+
 	valUpdates, err := s.executeStateTransition(ctx, st, blk)
 	if err != nil {
 		return nil, err
@@ -87,7 +94,7 @@ func (s *Service[
 		return nil, err
 	}
 
-	go s.sendPostBlockFCU(ctx, st, blk)
+	s.spawn(func() { s.sendPostBlockFCU(ctx, st, blk) })
 
 	return valUpdates.CanonicalSort(), nil
 }
@@ -100,6 +107,11 @@ func (s *Service[
 	st BeaconStateT,
 	blk ConsensusBlockT,
 ) (transition.ValidatorUpdates, error) {
+	beaconBlk := blk.GetBeaconBlock()
+	ctx, endSpan := s.metrics.startBlockSpan(
+		ctx, "blockchain.ExecuteStateTransition",
+		beaconBlk.GetSlot(), beaconBlk.HashTreeRoot,
+	)
 	startTime := time.Now()
 	defer s.metrics.measureStateTransitionDuration(startTime)
 	valUpdates, err := s.stateProcessor.Transition(
@@ -132,7 +144,8 @@ func (s *Service[
 			NextPayloadTimestamp: blk.GetNextPayloadTimestamp(),
 		},
 		st,
-		blk.GetBeaconBlock(),
+		beaconBlk,
 	)
+	endSpan(err)
 	return valUpdates, err
 }
@@ -81,11 +81,13 @@ func (s *Service[
 		)
 
 		if s.shouldBuildOptimisticPayloads() {
-			go s.handleRebuildPayloadForRejectedBlock(
-				ctx,
-				preState,
-				nextPayloadTimestamp,
-			)
+			s.spawn(func() {
+				s.handleRebuildPayloadForRejectedBlock(
+					ctx,
+					preState,
+					nextPayloadTimestamp,
+				)
+			})
 		}
 
 		return err
@@ -98,12 +100,14 @@ func (s *Service[
 	)
 
 	if s.shouldBuildOptimisticPayloads() {
-		go s.handleOptimisticPayloadBuild(
-			ctx,
-			postState,
-			beaconBlk,
-			nextPayloadTimestamp,
-		)
+		s.spawn(func() {
+			s.handleOptimisticPayloadBuild(
+				ctx,
+				postState,
+				beaconBlk,
+				nextPayloadTimestamp,
+			)
+		})
 	}
 
 	return nil
@@ -116,10 +120,17 @@ func (s *Service[
 	ctx context.Context,
 	st BeaconStateT,
 	blk ConsensusBlockT,
-) error {
+) (err error) {
+	beaconBlk := blk.GetBeaconBlock()
+	ctx, endSpan := s.metrics.startBlockSpan(
+		ctx, "blockchain.VerifyStateRoot",
+		beaconBlk.GetSlot(), beaconBlk.HashTreeRoot,
+	)
+	defer func() { endSpan(err) }()
+
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
-	_, err := s.stateProcessor.Transition(
+	_, err = s.stateProcessor.Transition(
 		// We run with a non-optimistic engine here to ensure
 		// that the proposer does not try to push through a bad block.
 		&transition.Context{
@@ -131,7 +142,7 @@ func (s *Service[
 			ProposerAddress:         blk.GetProposerAddress(),
 			NextPayloadTimestamp:    blk.GetNextPayloadTimestamp(),
 		},
-		st, blk.GetBeaconBlock(),
+		st, beaconBlk,
 	)
 	if errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
 		// It is safe for the validator to ignore this error since
@@ -87,6 +87,13 @@ type Service[
 	subBlockReceived chan async.Event[ConsensusBlockT]
 	// subGenDataReceived is a channel holding GenesisDataReceived events.
 	subGenDataReceived chan async.Event[GenesisT]
+	// stopCh is closed when the service is stopped.
+	stopCh chan struct{}
+	// stopOnce ensures stopCh is closed only once.
+	stopOnce sync.Once
+	// wg tracks the event loop and the forkchoice updates and payload
+	// builds it spawns.
+	wg sync.WaitGroup
 }
 
 // NewService creates a new validator service.
@@ -149,6 +156,7 @@ func NewService[
 		subFinalBlkReceived:     make(chan async.Event[ConsensusBlockT]),
 		subBlockReceived:        make(chan async.Event[ConsensusBlockT]),
 		subGenDataReceived:      make(chan async.Event[GenesisT]),
+		stopCh:                  make(chan struct{}),
 	}
 }
 
@@ -184,23 +192,56 @@ func (s *Service[
 	}
 
 	// start the main event loop to listen and handle events.
+	s.wg.Add(1)
 	go s.eventLoop(ctx)
 	return nil
 }
 
+// Stop stops the service, waiting for the block being processed and the
+// forkchoice updates and payload builds in flight to complete.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _,
+]) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+	return async.WaitContext(ctx, &s.wg)
+}
+
+// spawn runs f in a goroutine tracked by the service.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _,
+]) spawn(f func()) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		f()
+	}()
+}
+
 // eventLoop listens for events and handles them accordingly.
 func (s *Service[
 	_, _, _, _, _, _, _, _, _, _, _,
 ]) eventLoop(ctx context.Context) {
+	defer s.wg.Done()
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-s.subGenDataReceived:
+		case <-s.stopCh:
+			return
+		case event, ok := <-s.subGenDataReceived:
+			if !ok {
+				return
+			}
 			s.handleGenDataReceived(event)
-		case event := <-s.subBlockReceived:
+		case event, ok := <-s.subBlockReceived:
+			if !ok {
+				return
+			}
 			s.handleBeaconBlockReceived(event)
-		case event := <-s.subFinalBlkReceived:
+		case event, ok := <-s.subFinalBlkReceived:
+			if !ok {
+				return
+			}
 			s.handleBeaconBlockFinalization(event)
 		}
 	}
@@ -233,6 +233,12 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+
+	// StartSpan starts a span with the provided attributes, returning
+	// the context carrying it and a function to end it.
+	StartSpan(
+		ctx context.Context, name string, args ...any,
+	) (context.Context, func(error))
 }
 
 type ValidatorUpdates = transition.ValidatorUpdates
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// VoluntaryExitPool holds the signed voluntary exits submitted to this node
+// until they are included in a finalized block, or fail verification while
+// building one. It keeps at most one exit per validator.
+type VoluntaryExitPool[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[VoluntaryExitT],
+	BeaconStateT any,
+	VoluntaryExitT VoluntaryExit,
+] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// sb is the storage backend used to fetch the head state.
+	sb StorageBackend[BeaconStateT]
+	// stateProcessor is used to verify the submitted exits.
+	stateProcessor StateProcessor[BeaconStateT, VoluntaryExitT]
+	// exits are the pending voluntary exits, keyed by validator index.
+	exits map[math.ValidatorIndex]VoluntaryExitT
+	// mu protects exits.
+	mu sync.RWMutex
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+}
+
+// NewVoluntaryExitPool creates a new voluntary exit pool.
+func NewVoluntaryExitPool[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[VoluntaryExitT],
+	BeaconStateT any,
+	VoluntaryExitT VoluntaryExit,
+](
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+	sb StorageBackend[BeaconStateT],
+	stateProcessor StateProcessor[BeaconStateT, VoluntaryExitT],
+) *VoluntaryExitPool[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, VoluntaryExitT,
+] {
+	return &VoluntaryExitPool[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, VoluntaryExitT,
+	]{
+		logger:                logger,
+		dispatcher:            dispatcher,
+		sb:                    sb,
+		stateProcessor:        stateProcessor,
+		exits:                 make(map[math.ValidatorIndex]VoluntaryExitT),
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+	}
+}
+
+// Name returns the name of the service.
+func (p *VoluntaryExitPool[_, _, _, _]) Name() string {
+	return "voluntary-exit-pool"
+}
+
+// Start subscribes the pool to BeaconBlockFinalized events and starts the
+// main event loop to prune the exits included in finalized blocks.
+func (p *VoluntaryExitPool[_, _, _, _]) Start(ctx context.Context) error {
+	if err := p.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, p.subFinalizedBlkEvents,
+	); err != nil {
+		p.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+
+	go p.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop for the voluntary exit pool.
+func (p *VoluntaryExitPool[_, _, _, _]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-p.subFinalizedBlkEvents:
+			p.onFinalizeBlock(event)
+		}
+	}
+}
+
+// onFinalizeBlock removes the exits included in the finalized block.
+func (p *VoluntaryExitPool[BeaconBlockT, _, _, _]) onFinalizeBlock(
+	event async.Event[BeaconBlockT],
+) {
+	exits := event.Data().GetBody().GetVoluntaryExits()
+	if len(exits) == 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, exit := range exits {
+		delete(p.exits, exit.GetValidatorIndex())
+	}
+}
+
+// Add verifies the voluntary exit against the head state and inserts it into
+// the pool. An exit already pending for the same validator is kept.
+func (p *VoluntaryExitPool[_, _, _, VoluntaryExitT]) Add(
+	exit VoluntaryExitT,
+) error {
+	st := p.sb.StateFromContext(context.Background())
+	if err := p.stateProcessor.VerifyVoluntaryExit(st, exit); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.exits[exit.GetValidatorIndex()]; !ok {
+		p.exits[exit.GetValidatorIndex()] = exit
+	}
+	return nil
+}
+
+// Remove evicts the voluntary exit pending for the validator of the given
+// exit, e.g. once it failed verification against the head state.
+func (p *VoluntaryExitPool[_, _, _, VoluntaryExitT]) Remove(
+	exit VoluntaryExitT,
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.exits, exit.GetValidatorIndex())
+}
+
+// Get returns all the pending voluntary exits, ordered by validator index.
+func (p *VoluntaryExitPool[_, _, _, VoluntaryExitT]) Get() []VoluntaryExitT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	indexes := make([]math.ValidatorIndex, 0, len(p.exits))
+	for index := range p.exits {
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+
+	exits := make([]VoluntaryExitT, len(indexes))
+	for i, index := range indexes {
+		exits[i] = p.exits[index]
+	}
+	return exits
+}
@@ -37,11 +37,16 @@ import (
 
 // buildBlockAndSidecars builds a new beacon block.
 func (s *Service[
-	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+	BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, SlotDataT, _,
 ]) buildBlockAndSidecars(
 	ctx context.Context,
 	slotData SlotDataT,
-) (BeaconBlockT, BlobSidecarsT, error) {
+) (_ BeaconBlockT, _ BlobSidecarsT, err error) {
+	ctx, endSpan := s.metrics.sink.StartSpan(
+		ctx, "validator.BuildBlockAndSidecars", "slot", slotData.GetSlot(),
+	)
+	defer func() { endSpan(err) }()
+
 	var (
 		blk       BeaconBlockT
 		sidecars  BlobSidecarsT
@@ -92,7 +97,7 @@ func (s *Service[
 	// We have to assemble the block body prior to producing the sidecars
 	// since we need to generate the inclusion proofs.
 	if err = s.buildBlockBody(
-		ctx, st, blk, reveal, envelope, slotData,
+		ctx, st, blk, reveal, envelope,
 	); err != nil {
 		return blk, sidecars, err
 	}
@@ -138,7 +143,7 @@ func (s *Service[
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) getEmptyBeaconBlockForSlot(
 	st BeaconStateT, requestedSlot math.Slot,
 ) (BeaconBlockT, error) {
@@ -170,7 +175,7 @@ func (s *Service[
 
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+	_, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
 ]) buildRandaoReveal(
 	st BeaconStateT,
 	slot math.Slot,
@@ -198,8 +203,8 @@ func (s *Service[
 
 // retrieveExecutionPayload retrieves the execution payload for the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
-	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, SlotDataT,
+	BeaconBlockT, _, BeaconStateT, _, _, _, _, ExecutionPayloadT,
+	ExecutionPayloadHeaderT, _, SlotDataT, _,
 ]) retrieveExecutionPayload(
 	ctx context.Context,
 	st BeaconStateT,
@@ -238,8 +243,11 @@ func (s *Service[
 		// call that needs to be called before requesting the Payload.
 		// TODO: We should decouple the PayloadBuilder from BeaconState to make
 		// this less confusing.
-		return s.localPayloadBuilder.RequestPayloadSync(
-			ctx,
+		spanCtx, endSpan := s.metrics.sink.StartSpan(
+			ctx, "validator.RequestPayloadSync", "slot", blk.GetSlot(),
+		)
+		envelope, err = s.localPayloadBuilder.RequestPayloadSync(
+			spanCtx,
 			st,
 			blk.GetSlot(),
 			slotData.GetNextPayloadTimestamp().Unwrap(),
@@ -247,21 +255,21 @@ func (s *Service[
 			lph.GetBlockHash(),
 			lph.GetParentHash(),
 		)
+		endSpan(err)
+		return envelope, err
 	}
 	return envelope, nil
 }
 
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, Eth1DataT, ExecutionPayloadT, _,
-	_, _, SlotDataT,
+	BeaconBlockT, _, BeaconStateT, _, _, _, _, ExecutionPayloadT, _, _, _, _,
 ]) buildBlockBody(
-	_ context.Context,
+	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
 	reveal crypto.BLSSignature,
 	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
-	slotData SlotDataT,
 ) error {
 	// Assemble a new block with the payload.
 	body := blk.GetBody()
@@ -281,30 +289,30 @@ func (s *Service[
 	// Set the KZG commitments on the block body.
 	body.SetBlobKzgCommitments(blobsBundle.GetCommitments())
 
-	depositIndex, err := st.GetEth1DepositIndex()
-	if err != nil {
-		return ErrNilDepositIndexStart
-	}
+	// Get the epoch to find the active fork version.
+	epoch := s.chainSpec.SlotToEpoch(blk.GetSlot())
+	activeForkVersion := s.chainSpec.ActiveForkVersionForEpoch(
+		epoch,
+	)
 
-	// Dequeue deposits from the state.
-	deposits, err := s.sb.DepositStore().GetDepositsByIndex(
-		depositIndex,
-		s.chainSpec.MaxDepositsPerBlock(),
+	// Set the eth1 data and the deposits it accounts for on the block body.
+	eth1Data, deposits, err := s.getEth1DataAndDeposits(
+		ctx, st, activeForkVersion,
 	)
 	if err != nil {
 		return err
 	}
-
-	// Set the deposits on the block body.
+	body.SetEth1Data(eth1Data)
 	body.SetDeposits(deposits)
 
-	var eth1Data Eth1DataT
-	// TODO: assemble real eth1data.
-	body.SetEth1Data(eth1Data.New(
-		common.Root{},
-		0,
-		common.ExecutionHash{},
-	))
+	// From Electra on, the body also carries the execution requests of the
+	// payload and the voluntary exits.
+	if activeForkVersion >= version.Electra {
+		if err = s.setExecutionRequests(body, envelope); err != nil {
+			return err
+		}
+		body.SetVoluntaryExits(s.getVoluntaryExits(st))
+	}
 
 	// Set the graffiti on the block body.
 	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
@@ -314,27 +322,178 @@ func (s *Service[
 	}
 	body.SetGraffiti(graffiti)
 
-	// Get the epoch to find the active fork version.
-	epoch := s.chainSpec.SlotToEpoch(blk.GetSlot())
-	activeForkVersion := s.chainSpec.ActiveForkVersionForEpoch(
-		epoch,
+	body.SetExecutionPayload(envelope.GetExecutionPayload())
+	return nil
+}
+
+// setExecutionRequests sets the execution requests returned along with the
+// payload on the block body.
+func (s *Service[
+	_, BeaconBlockBodyT, _, _, _, _, _, ExecutionPayloadT, _, _, _, _,
+]) setExecutionRequests(
+	body BeaconBlockBodyT,
+	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+) error {
+	requests, err := engineprimitives.DecodeExecutionRequests(
+		envelope.GetExecutionRequests(),
 	)
-	if activeForkVersion >= version.DenebPlus {
-		// Set the attestations on the block body.
-		body.SetAttestations(slotData.GetAttestationData())
+	if err != nil {
+		return err
+	}
+	body.SetExecutionRequests(requests)
+	return nil
+}
 
-		// Set the slashing info on the block body.
-		body.SetSlashingInfo(slotData.GetSlashingInfo())
+// getEth1DataAndDeposits returns the eth1 data for the block to vote for,
+// along with the outstanding deposits that the block must include under it.
+func (s *Service[
+	_, _, BeaconStateT, _, DepositT, _, Eth1DataT, _, _, _, _, _,
+]) getEth1DataAndDeposits(
+	ctx context.Context,
+	st BeaconStateT,
+	forkVersion uint32,
+) (Eth1DataT, []DepositT, error) {
+	var eth1Data Eth1DataT
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return eth1Data, nil, ErrNilDepositIndexStart
 	}
 
-	body.SetExecutionPayload(envelope.GetExecutionPayload())
-	return nil
+	// Before Deneb+, blocks carry no eth1 data and include deposits
+	// regardless of it.
+	if forkVersion < version.DenebPlus {
+		var deposits []DepositT
+		deposits, err = s.sb.DepositStore().GetDepositsByIndex(
+			depositIndex,
+			s.chainSpec.MaxDepositsPerBlock(),
+		)
+		return eth1Data.New(
+			common.Root{}, 0, common.ExecutionHash{},
+		), deposits, err
+	}
+
+	if eth1Data, err = s.getEth1Data(ctx, st); err != nil {
+		return eth1Data, nil, err
+	}
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return eth1Data, nil, err
+	}
+
+	// Include exactly the outstanding deposits up to the per-block limit,
+	// which are the deposits before the deposit requests start index from
+	// Electra on. Right after Deneb+, the deposit index may still be ahead
+	// of the eth1 data.
+	var numDeposits uint64
+	limit := min(eth1Data.GetDepositCount().Unwrap(), startIndex)
+	if limit > depositIndex {
+		numDeposits = min(
+			s.chainSpec.MaxDepositsPerBlock(), limit-depositIndex,
+		)
+	}
+	deposits, err := s.sb.DepositStore().GetDepositsByIndex(
+		depositIndex, numDeposits,
+	)
+	if err != nil {
+		return eth1Data, nil, err
+	}
+	if uint64(len(deposits)) != numDeposits {
+		return eth1Data, nil, fmt.Errorf(
+			"%w: expected %d from index %d, got %d",
+			ErrMissingDeposits, numDeposits, depositIndex, len(deposits),
+		)
+	}
+	return eth1Data, deposits, nil
+}
+
+// getEth1Data returns the eth1 data of the deposit contract as of the
+// execution block Eth1FollowDistance blocks behind the latest execution
+// payload. It falls back to the eth1 data of the state whenever the deposit
+// contract cannot be read or its deposit tree is not known to the deposit
+// store yet, as the deposit root must be verifiable by every node.
+func (s *Service[
+	_, _, BeaconStateT, _, _, _, Eth1DataT, _, _, _, _, _,
+]) getEth1Data(
+	ctx context.Context,
+	st BeaconStateT,
+) (Eth1DataT, error) {
+	current, err := st.GetEth1Data()
+	if err != nil {
+		return current, err
+	}
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return current, err
+	}
+
+	followDistance := math.U64(s.chainSpec.Eth1FollowDistance())
+	if lph.GetNumber() < followDistance {
+		return current, nil
+	}
+
+	count, blockHash, err := s.depositContract.ReadEth1Data(
+		ctx, lph.GetNumber()-followDistance,
+	)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to read eth1 data from deposit contract, "+
+				"reusing the eth1 data of the state",
+			"error", err,
+		)
+		return current, nil
+	}
+
+	// The deposit count can never decrease, as the deposits up to it may
+	// already have been included.
+	if count < current.GetDepositCount() {
+		return current, nil
+	}
+
+	depositRoot, err := s.sb.DepositStore().GetDepositRoot(count.Unwrap())
+	if err != nil {
+		s.logger.Warn(
+			"Deposit store is behind the deposit contract, "+
+				"reusing the eth1 data of the state",
+			"deposit_count", count, "error", err,
+		)
+		return current, nil
+	}
+
+	var eth1Data Eth1DataT
+	return eth1Data.New(depositRoot, count, blockHash), nil
+}
+
+// getVoluntaryExits returns the pending voluntary exits from the pool that
+// are valid against the given state, up to the per-block limit. Exits failing
+// verification are evicted from the pool, so they are not verified again for
+// every block.
+func (s *Service[
+	_, _, BeaconStateT, _, _, _, _, _, _, _, _, VoluntaryExitT,
+]) getVoluntaryExits(st BeaconStateT) []VoluntaryExitT {
+	var (
+		maxExits = s.chainSpec.MaxVoluntaryExitsPerBlock()
+		exits    = make([]VoluntaryExitT, 0, maxExits)
+	)
+	for _, exit := range s.voluntaryExitPool.Get() {
+		if uint64(len(exits)) >= maxExits {
+			break
+		}
+		if err := s.stateProcessor.VerifyVoluntaryExit(st, exit); err != nil {
+			s.logger.Warn(
+				"Evicting invalid voluntary exit from pool", "error", err,
+			)
+			s.voluntaryExitPool.Remove(exit)
+			continue
+		}
+		exits = append(exits, exit)
+	}
+	return exits
 }
 
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) computeAndSetStateRoot(
 	ctx context.Context,
 	proposerAddress []byte,
@@ -363,17 +522,22 @@ func (s *Service[
 
 // computeStateRoot computes the state root of an outgoing block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
 ]) computeStateRoot(
 	ctx context.Context,
 	proposerAddress []byte,
 	nextPayloadTimestamp math.U64,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) (common.Root, error) {
+) (_ common.Root, err error) {
+	ctx, endSpan := s.metrics.sink.StartSpan(
+		ctx, "validator.ComputeStateRoot", "slot", blk.GetSlot(),
+	)
+	defer func() { endSpan(err) }()
+
 	startTime := time.Now()
 	defer s.metrics.measureStateRootComputationTime(startTime)
-	if _, err := s.stateProcessor.Transition(
+	if _, err = s.stateProcessor.Transition(
 		// TODO: We should think about how having optimistic
 		// engine enabled here would affect the proposer when
 		// the payload in their block has come from a remote builder.
@@ -34,6 +34,10 @@ var (
 	// ErrNilBlobsBundle is an error for when the blobs bundle is nil.
 	ErrNilBlobsBundle = errors.New("nil blobs bundle")
 
+	// ErrMissingDeposits is an error for when the deposit store does not
+	// hold the deposits to include in a block.
+	ErrMissingDeposits = errors.New("missing deposits in deposit store")
+
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
@@ -33,12 +33,11 @@ import (
 
 // Service is responsible for building beacon blocks and sidecars.
 type Service[
-	AttestationDataT any,
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[
-		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		DepositT, Eth1DataT, ExecutionPayloadT, VoluntaryExitT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
@@ -46,8 +45,8 @@ type Service[
 	ExecutionPayloadT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
-	SlashingInfoT any,
-	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	SlotDataT SlotData,
+	VoluntaryExitT any,
 ] struct {
 	// cfg is the validator config.
 	cfg *Config
@@ -61,6 +60,8 @@ type Service[
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT]
 	// sb is the beacon state backend.
 	sb StorageBackend[BeaconStateT, DepositStoreT]
+	// depositContract is used to read the eth1 data voted for by blocks.
+	depositContract DepositContract
 	// dispatcher is the dispatcher.
 	dispatcher asynctypes.EventDispatcher
 	// stateProcessor is responsible for processing the state.
@@ -69,6 +70,7 @@ type Service[
 		BeaconStateT,
 		*transition.Context,
 		ExecutionPayloadHeaderT,
+		VoluntaryExitT,
 	]
 	// localPayloadBuilder represents the local block builder, this builder
 	// is connected to this nodes execution client via the EngineAPI.
@@ -78,6 +80,9 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// voluntaryExitPool holds the pending voluntary exits to include in
+	// blocks.
+	voluntaryExitPool VoluntaryExitPool[VoluntaryExitT]
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 	// subNewSlot is a channel to hold NewSlot events.
@@ -86,12 +91,11 @@ type Service[
 
 // NewService creates a new validator service.
 func NewService[
-	AttestationDataT any,
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[
-		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		DepositT, Eth1DataT, ExecutionPayloadT, VoluntaryExitT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
@@ -99,45 +103,49 @@ func NewService[
 	ExecutionPayloadT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
-	SlashingInfoT any,
-	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	SlotDataT SlotData,
+	VoluntaryExitT any,
 ](
 	cfg *Config,
 	logger log.Logger,
 	chainSpec common.ChainSpec,
 	sb StorageBackend[BeaconStateT, DepositStoreT],
+	depositContract DepositContract,
 	stateProcessor StateProcessor[
 		BeaconBlockT,
 		BeaconStateT,
 		*transition.Context,
 		ExecutionPayloadHeaderT,
+		VoluntaryExitT,
 	],
 	signer crypto.BLSSigner,
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	voluntaryExitPool VoluntaryExitPool[VoluntaryExitT],
 	ts TelemetrySink,
 	dispatcher asynctypes.EventDispatcher,
 ) *Service[
-	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
-	BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
-	ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, BlobSidecarsT, DepositT,
+	DepositStoreT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkDataT, SlotDataT, VoluntaryExitT,
 ] {
 	return &Service[
-		AttestationDataT, BeaconBlockT, BeaconBlockBodyT,
-		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT,
-		SlotDataT,
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, BlobSidecarsT, DepositT,
+		DepositStoreT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ForkDataT, SlotDataT, VoluntaryExitT,
 	]{
 		cfg:                   cfg,
 		logger:                logger,
 		sb:                    sb,
+		depositContract:       depositContract,
 		chainSpec:             chainSpec,
 		signer:                signer,
 		stateProcessor:        stateProcessor,
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
+		voluntaryExitPool:     voluntaryExitPool,
 		metrics:               newValidatorMetrics(ts),
 		dispatcher:            dispatcher,
 		subNewSlot:            make(chan async.Event[SlotDataT]),
@@ -146,7 +154,7 @@ func NewService[
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "validator"
 }
@@ -154,7 +162,7 @@ func (s *Service[
 // Start listens for NewSlot events and builds a block and sidecars for the
 // requested slot data.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(
 	ctx context.Context,
 ) error {
@@ -169,14 +177,19 @@ func (s *Service[
 }
 
 // eventLoop is the main event loop for the validator service.
-func (s *Service[_, _, _, _, _, _, _, _, _, _, _, _, _]) eventLoop(
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) eventLoop(
 	ctx context.Context,
 ) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-s.subNewSlot:
+		case event, ok := <-s.subNewSlot:
+			if !ok {
+				return
+			}
 			s.handleNewSlot(event)
 		}
 	}
@@ -186,7 +199,7 @@ func (s *Service[_, _, _, _, _, _, _, _, _, _, _, _, _]) eventLoop(
 // emits BuiltBeaconBlock and BuiltSidecars events containing the built block
 // and sidecars.
 func (s *Service[
-	_, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _, SlotDataT,
+	BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, SlotDataT, _,
 ]) handleNewSlot(req async.Event[SlotDataT]) {
 	var (
 		blk      BeaconBlockT
@@ -60,7 +60,7 @@ type BeaconBlock[
 
 // BeaconBlockBody represents a beacon block body interface.
 type BeaconBlockBody[
-	AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT any,
+	DepositT, Eth1DataT, ExecutionPayloadT, VoluntaryExitT any,
 ] interface {
 	constraints.SSZMarshallable
 	constraints.Nillable
@@ -74,17 +74,18 @@ type BeaconBlockBody[
 	SetExecutionPayload(ExecutionPayloadT)
 	// SetGraffiti sets the graffiti of the beacon block body.
 	SetGraffiti(common.Bytes32)
-	// SetAttestations sets the attestations of the beacon block body.
-	SetAttestations([]AttestationDataT)
-	// SetSlashingInfo sets the slashing info of the beacon block body.
-	SetSlashingInfo([]SlashingInfoT)
 	// SetBlobKzgCommitments sets the blob KZG commitments of the beacon block
 	// body.
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+	// SetVoluntaryExits sets the voluntary exits of the beacon block body.
+	SetVoluntaryExits([]VoluntaryExitT)
+	// SetExecutionRequests sets the execution requests of the beacon block
+	// body.
+	SetExecutionRequests(*engineprimitives.ExecutionRequests)
 }
 
 // BeaconState represents a beacon state interface.
-type BeaconState[ExecutionPayloadHeaderT any] interface {
+type BeaconState[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	// GetBlockRootAtIndex returns the block root at the given index.
 	GetBlockRootAtIndex(uint64) (common.Root, error)
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
@@ -101,6 +102,11 @@ type BeaconState[ExecutionPayloadHeaderT any] interface {
 	// GetEth1DepositIndex returns the latest deposit index from the beacon
 	// state.
 	GetEth1DepositIndex() (uint64, error)
+	// GetDepositRequestsStartIndex returns the index of the first deposit
+	// made through a deposit request, unset until then.
+	GetDepositRequestsStartIndex() (uint64, error)
+	// GetEth1Data returns the eth1 data the state voted for last.
+	GetEth1Data() (Eth1DataT, error)
 	// GetGenesisValidatorsRoot returns the genesis validators root.
 	GetGenesisValidatorsRoot() (common.Root, error)
 }
@@ -124,6 +130,20 @@ type DepositStore[DepositT any] interface {
 		startIndex uint64,
 		numView uint64,
 	) ([]DepositT, error)
+	// GetDepositRoot returns the root of the deposit tree when it held the
+	// given number of deposits.
+	GetDepositRoot(count uint64) (common.Root, error)
+}
+
+// DepositContract represents the deposit contract on the execution layer.
+type DepositContract interface {
+	// ReadEth1Data reads the number of deposits made to the deposit
+	// contract as of the given execution block, along with the hash of
+	// that block.
+	ReadEth1Data(
+		ctx context.Context,
+		blkNum math.U64,
+	) (math.U64, common.ExecutionHash, error)
 }
 
 // Eth1Data represents the eth1 data interface.
@@ -134,10 +154,14 @@ type Eth1Data[T any] interface {
 		depositCount math.U64,
 		blockHash common.ExecutionHash,
 	) T
+	// GetDepositCount returns the deposit count.
+	GetDepositCount() math.U64
 }
 
 // ExecutionPayloadHeader represents the execution payload header interface.
 type ExecutionPayloadHeader interface {
+	// GetNumber returns the block number of the execution payload header.
+	GetNumber() math.U64
 	// GetTimestamp returns the timestamp of the execution payload header.
 	GetTimestamp() math.U64
 	// GetBlockHash returns the block hash of the execution payload header.
@@ -183,13 +207,9 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 }
 
 // SlotData represents the slot data interface.
-type SlotData[AttestationDataT, SlashingInfoT any] interface {
+type SlotData interface {
 	// GetSlot returns the slot of the incoming slot.
 	GetSlot() math.Slot
-	// GetAttestationData returns the attestation data of the incoming slot.
-	GetAttestationData() []AttestationDataT
-	// GetSlashingInfo returns the slashing info of the incoming slot.
-	GetSlashingInfo() []SlashingInfoT
 	// GetProposerAddress returns the address of the validator
 	// selected by consensus to propose the block
 	GetProposerAddress() []byte
@@ -205,6 +225,7 @@ type StateProcessor[
 	BeaconStateT any,
 	ContextT any,
 	ExecutionPayloadHeaderT any,
+	VoluntaryExitT any,
 ] interface {
 	// ProcessSlot processes the slot.
 	ProcessSlots(
@@ -216,6 +237,17 @@ type StateProcessor[
 		st BeaconStateT,
 		blk BeaconBlockT,
 	) (transition.ValidatorUpdates, error)
+	// VerifyVoluntaryExit verifies that the voluntary exit can be included
+	// in a block built on top of the given state.
+	VerifyVoluntaryExit(st BeaconStateT, exit VoluntaryExitT) error
+}
+
+// VoluntaryExitPool is the interface for the pool of pending voluntary exits.
+type VoluntaryExitPool[VoluntaryExitT any] interface {
+	// Get returns the pending voluntary exits.
+	Get() []VoluntaryExitT
+	// Remove evicts the given voluntary exit from the pool.
+	Remove(VoluntaryExitT)
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -237,4 +269,9 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+	// StartSpan starts a span with the provided attributes, returning the
+	// context carrying it and a function to end it.
+	StartSpan(
+		ctx context.Context, name string, args ...any,
+	) (context.Context, func(error))
 }
@@ -57,6 +57,20 @@ type Spec[
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
 
+	// MinValidatorWithdrawabilityDelay returns the number of epochs between a
+	// validator exiting and its balance becoming withdrawable.
+	MinValidatorWithdrawabilityDelay() uint64
+
+	// Validator cycle
+
+	// MinPerEpochChurnLimit returns the minimum number of validators that may
+	// exit per epoch.
+	MinPerEpochChurnLimit() uint64
+
+	// ChurnLimitQuotient returns the divisor of the validator set size used
+	// to compute the per epoch churn limit.
+	ChurnLimitQuotient() uint64
+
 	// Signature Domains
 
 	// DomainTypeProposer returns the domain for proposer signatures.
@@ -92,6 +106,10 @@ type Spec[
 	// block.
 	MaxDepositsPerBlock() uint64
 
+	// MaxVoluntaryExitsPerBlock returns the maximum number of voluntary exit
+	// operations per block.
+	MaxVoluntaryExitsPerBlock() uint64
+
 	// DepositEth1ChainID returns the chain ID of the deposit contract.
 	DepositEth1ChainID() uint64
 
@@ -267,6 +285,30 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// MinValidatorWithdrawabilityDelay returns the number of epochs between a
+// validator exiting and its balance becoming withdrawable.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinValidatorWithdrawabilityDelay() uint64 {
+	return c.Data.MinValidatorWithdrawabilityDelay
+}
+
+// MinPerEpochChurnLimit returns the minimum number of validators that may
+// exit per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// ChurnLimitQuotient returns the divisor of the validator set size used to
+// compute the per epoch churn limit.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
 // DomainTypeProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -338,6 +380,14 @@ func (c chainSpec[
 	return c.Data.MaxDepositsPerBlock
 }
 
+// MaxVoluntaryExitsPerBlock returns the maximum number of voluntary exits per
+// block.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxVoluntaryExitsPerBlock() uint64 {
+	return c.Data.MaxVoluntaryExitsPerBlock
+}
+
 // DepositEth1ChainID returns the chain ID of the execution chain.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -52,6 +52,18 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// MinValidatorWithdrawabilityDelay is the number of epochs an exited
+	// validator has to wait before its balance becomes withdrawable.
+	MinValidatorWithdrawabilityDelay uint64 `mapstructure:"min-validator-withdrawability-delay"`
+
+	// Validator cycle constants.
+	//
+	// MinPerEpochChurnLimit is the minimum number of validators that may exit
+	// per epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// ChurnLimitQuotient is the divisor of the validator set size used to
+	// compute the per epoch churn limit.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
 
 	// Signature domains.
 	//
@@ -81,6 +93,9 @@ type SpecData[
 	// MaxDepositsPerBlock specifies the maximum number of deposit operations
 	// allowed per block.
 	MaxDepositsPerBlock uint64 `mapstructure:"max-deposits-per-block"`
+	// MaxVoluntaryExitsPerBlock specifies the maximum number of voluntary
+	// exit operations allowed per block.
+	MaxVoluntaryExitsPerBlock uint64 `mapstructure:"max-voluntary-exits-per-block"`
 	// DepositEth1ChainID is the chain ID of the execution client.
 	DepositEth1ChainID uint64 `mapstructure:"deposit-eth1-chain-id"`
 	// Eth1FollowDistance is the distance between the eth1 chain and the beacon
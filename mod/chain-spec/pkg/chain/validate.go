@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// bytesPerFieldElement is the number of bytes of a blob field element.
+const bytesPerFieldElement = 32
+
+// ErrInvalidSpec is returned when the chain spec is not internally
+// consistent.
+var ErrInvalidSpec = errors.New("invalid chain spec")
+
+// Validate checks that the chain-specific parameters are internally
+// consistent, returning an error wrapping ErrInvalidSpec for the first
+// inconsistency found.
+func (d SpecData[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) Validate() error {
+	for _, nonZero := range []struct {
+		name  string
+		value uint64
+	}{
+		{"slots-per-epoch", d.SlotsPerEpoch},
+		{"slots-per-historical-root", d.SlotsPerHistoricalRoot},
+		{"effective-balance-increment", d.EffectiveBalanceIncrement},
+		{"epochs-per-historical-vector", d.EpochsPerHistoricalVector},
+		{"epochs-per-slashings-vector", d.EpochsPerSlashingsVector},
+		{"historical-roots-limit", d.HistoricalRootsLimit},
+		{"validator-registry-limit", d.ValidatorRegistryLimit},
+		{"max-withdrawals-per-payload", d.MaxWithdrawalsPerPayload},
+		{
+			"max-validators-per-withdrawals-sweep",
+			d.MaxValidatorsPerWithdrawalsSweep,
+		},
+		{"deposit-eth1-chain-id", d.DepositEth1ChainID},
+		{"churn-limit-quotient", d.ChurnLimitQuotient},
+	} {
+		if nonZero.value == 0 {
+			return fmt.Errorf("%w: %s must be set", ErrInvalidSpec, nonZero.name)
+		}
+	}
+
+	switch {
+	case d.DepositContractAddress == ExecutionAddressT{}:
+		return fmt.Errorf(
+			"%w: deposit-contract-address must be set", ErrInvalidSpec,
+		)
+	case d.MinDepositAmount > d.MaxEffectiveBalance:
+		return fmt.Errorf(
+			"%w: min-deposit-amount %d exceeds max-effective-balance %d",
+			ErrInvalidSpec, d.MinDepositAmount, d.MaxEffectiveBalance,
+		)
+	case d.EjectionBalance >= d.MaxEffectiveBalance:
+		return fmt.Errorf(
+			"%w: ejection-balance %d must be below max-effective-balance %d",
+			ErrInvalidSpec, d.EjectionBalance, d.MaxEffectiveBalance,
+		)
+	case d.MaxEffectiveBalance%d.EffectiveBalanceIncrement != 0:
+		return fmt.Errorf(
+			"%w: max-effective-balance %d is not a multiple of "+
+				"effective-balance-increment %d",
+			ErrInvalidSpec, d.MaxEffectiveBalance, d.EffectiveBalanceIncrement,
+		)
+	case d.MaxBlobsPerBlock > d.MaxBlobCommitmentsPerBlock:
+		return fmt.Errorf(
+			"%w: max-blobs-per-block %d exceeds "+
+				"max-blob-commitments-per-block %d",
+			ErrInvalidSpec, d.MaxBlobsPerBlock, d.MaxBlobCommitmentsPerBlock,
+		)
+	case d.BytesPerBlob != d.FieldElementsPerBlob*bytesPerFieldElement:
+		return fmt.Errorf(
+			"%w: bytes-per-blob %d does not match %d field elements per blob",
+			ErrInvalidSpec, d.BytesPerBlob, d.FieldElementsPerBlob,
+		)
+	case d.DenebPlusForkEpoch > d.ElectraForkEpoch:
+		return fmt.Errorf(
+			"%w: deneb-plus-fork-epoch %d is after electra-fork-epoch %d",
+			ErrInvalidSpec, d.DenebPlusForkEpoch, d.ElectraForkEpoch,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/stretchr/testify/require"
+)
+
+type specData = chain.SpecData[
+	domainType, epoch, executionAddress, slot, cometBFTConfig,
+]
+
+// validSpecData returns internally consistent chain spec data.
+func validSpecData() specData {
+	return specData{
+		MinDepositAmount:                 1e9,
+		MaxEffectiveBalance:              32e9,
+		EjectionBalance:                  16e9,
+		EffectiveBalanceIncrement:        1e9,
+		SlotsPerEpoch:                    32,
+		SlotsPerHistoricalRoot:           8,
+		DepositContractAddress:           executionAddress{0x42},
+		DepositEth1ChainID:               80087,
+		DenebPlusForkEpoch:               9,
+		ElectraForkEpoch:                 10,
+		EpochsPerHistoricalVector:        8,
+		EpochsPerSlashingsVector:         8,
+		HistoricalRootsLimit:             8,
+		ValidatorRegistryLimit:           1 << 40,
+		MaxWithdrawalsPerPayload:         16,
+		MaxValidatorsPerWithdrawalsSweep: 1 << 14,
+		MaxBlobCommitmentsPerBlock:       16,
+		MaxBlobsPerBlock:                 6,
+		FieldElementsPerBlob:             4096,
+		BytesPerBlob:                     131072,
+		ChurnLimitQuotient:               1 << 16,
+	}
+}
+
+func TestSpecData_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*specData)
+		valid  bool
+	}{
+		{name: "valid", mutate: func(*specData) {}, valid: true},
+		{
+			name:   "zero slots per epoch",
+			mutate: func(d *specData) { d.SlotsPerEpoch = 0 },
+		},
+		{
+			name:   "zero withdrawals sweep",
+			mutate: func(d *specData) { d.MaxValidatorsPerWithdrawalsSweep = 0 },
+		},
+		{
+			name: "missing deposit contract",
+			mutate: func(d *specData) {
+				d.DepositContractAddress = executionAddress{}
+			},
+		},
+		{
+			name:   "min deposit above max balance",
+			mutate: func(d *specData) { d.MinDepositAmount = 64e9 },
+		},
+		{
+			name:   "ejection balance at max balance",
+			mutate: func(d *specData) { d.EjectionBalance = 32e9 },
+		},
+		{
+			name:   "max balance not a multiple of increment",
+			mutate: func(d *specData) { d.EffectiveBalanceIncrement = 3e9 },
+		},
+		{
+			name:   "more blobs than commitments",
+			mutate: func(d *specData) { d.MaxBlobsPerBlock = 17 },
+		},
+		{
+			name:   "blob size mismatch",
+			mutate: func(d *specData) { d.BytesPerBlob = 4096 },
+		},
+		{
+			name:   "forks out of order",
+			mutate: func(d *specData) { d.DenebPlusForkEpoch = 11 },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := validSpecData()
+			tt.mutate(&data)
+			err := data.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, chain.ErrInvalidSpec)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	cmdlib "github.com/berachain/beacon-kit/mod/cli/pkg/commands"
 	servertypes "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/config"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
@@ -35,6 +36,7 @@ import (
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // CLIBuilder is the builder for the commands.Root (root command).
@@ -66,6 +68,8 @@ func New[
 	cb := &CLIBuilder[T, ExecutionPayloadT, LoggerT]{
 		suppliers: []any{
 			os.Stdout, // supply io.Writer for logger
+			// supply AppOptions for the chain spec
+			appOptionsFromArgs(os.Args[1:]),
 		},
 	}
 	for _, opt := range opts {
@@ -74,6 +78,15 @@ func New[
 	return cb
 }
 
+// appOptionsFromArgs returns the application options the root command
+// dependencies are built with. The chain spec is needed to build the
+// commands, so its flag is read from the arguments before cobra parses them.
+func appOptionsFromArgs(args []string) *viper.Viper {
+	appOpts := viper.New()
+	appOpts.Set(flags.ChainSpec, flags.ChainSpecFromArgs(args))
+	return appOpts
+}
+
 // Build builds the CLI commands.
 func (cb *CLIBuilder[
 	T, ExecutionPayloadT, LoggerT,
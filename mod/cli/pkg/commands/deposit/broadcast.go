@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+// depositBackend is the backend required to send a deposit transaction and
+// wait for it to be mined.
+type depositBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// broadcastDepositTx sends the signed deposit message to the deposit contract
+// of the chain spec using the transaction options from the command flags.
+func broadcastDepositTx(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) (*gethtypes.Receipt, *deposit.BeaconDepositContractDeposit, error) {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	key, err := getTransactorKey(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	url, err := cmd.Flags().GetString(rpcURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer client.Close()
+
+	// Make sure the deposit lands on the chain of the deposit contract.
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	expectedChainID := new(big.Int).SetUint64(chainSpec.DepositEth1ChainID())
+	if chainID.Cmp(expectedChainID) != 0 {
+		return nil, nil, fmt.Errorf(
+			"%w: expected %s, got %s",
+			ErrChainIDMismatch, expectedChainID, chainID,
+		)
+	}
+
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts.Context = ctx
+	if err = applyGasFlags(cmd, opts); err != nil {
+		return nil, nil, err
+	}
+
+	return sendDeposit(
+		ctx,
+		client,
+		gethcommon.Address(chainSpec.DepositContractAddress()),
+		opts,
+		depositMsg,
+		signature,
+	)
+}
+
+// sendDeposit calls deposit on the contract at the given address, waits for
+// the transaction to be mined and returns its receipt along with the emitted
+// deposit log.
+func sendDeposit(
+	ctx context.Context,
+	backend depositBackend,
+	contractAddr gethcommon.Address,
+	opts *bind.TransactOpts,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) (*gethtypes.Receipt, *deposit.BeaconDepositContractDeposit, error) {
+	dc, err := deposit.NewBeaconDepositContract(contractAddr, backend)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The deposit amount is sent along with the transaction in wei.
+	txOpts := *opts
+	txOpts.Value = depositMsg.Amount.ToWei().ToBig()
+	tx, err := dc.Deposit(
+		&txOpts,
+		depositMsg.Pubkey[:],
+		depositMsg.Credentials[:],
+		depositMsg.Amount.Unwrap(),
+		signature[:],
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if receipt == nil {
+		return nil, nil, ErrDepositReceiptEmpty
+	}
+	if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+		return receipt, nil, fmt.Errorf(
+			"%w: %s", ErrDepositTransactionFailed, tx.Hash(),
+		)
+	}
+
+	// Find the deposit log emitted for this deposit message.
+	for _, l := range receipt.Logs {
+		if l == nil || l.Address != contractAddr {
+			continue
+		}
+		event, parseErr := dc.ParseDeposit(*l)
+		if parseErr != nil {
+			continue
+		}
+		if bytes.Equal(event.Pubkey, depositMsg.Pubkey[:]) &&
+			bytes.Equal(event.Credentials, depositMsg.Credentials[:]) &&
+			bytes.Equal(event.Signature, signature[:]) &&
+			event.Amount == depositMsg.Amount.Unwrap() {
+			return receipt, event, nil
+		}
+	}
+	return receipt, nil, fmt.Errorf(
+		"%w: %s", ErrDepositLogNotFound, tx.Hash(),
+	)
+}
+
+// getTransactorKey returns the key to sign the deposit transaction, read
+// from the private key flag or, if not set, from the keystore flags.
+func getTransactorKey(cmd *cobra.Command) (*ecdsa.PrivateKey, error) {
+	privKey, err := cmd.Flags().GetString(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	if privKey != "" {
+		return ethcrypto.HexToECDSA(strings.TrimPrefix(privKey, "0x"))
+	}
+
+	path, err := cmd.Flags().GetString(keystorePath)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return nil, ErrPrivateKeyRequired
+	}
+	password, err := cmd.Flags().GetString(keystorePassword)
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G304 // the keystore path is provided by the operator.
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, err
+	}
+	if key.PrivateKey == nil {
+		return nil, ErrPrivateKeyEmpty
+	}
+	return key.PrivateKey, nil
+}
+
+// applyGasFlags sets the gas options of the transaction from the command
+// flags. Unset options are left for the execution client to fill in.
+func applyGasFlags(cmd *cobra.Command, opts *bind.TransactOpts) error {
+	limit, err := cmd.Flags().GetUint64(gasLimit)
+	if err != nil {
+		return err
+	}
+	opts.GasLimit = limit
+
+	if opts.GasFeeCap, err = getWeiFlag(cmd, gasFeeCap); err != nil {
+		return err
+	}
+	opts.GasTipCap, err = getWeiFlag(cmd, gasTipCap)
+	return err
+}
+
+// getWeiFlag parses the wei amount of the given flag, returning nil if the
+// flag is not set.
+func getWeiFlag(cmd *cobra.Command, flag string) (*big.Int, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	wei, ok := new(big.Int).SetString(value, 0)
+	if !ok || wei.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %s=%s", ErrInvalidGasPrice, flag, value)
+	}
+	return wei, nil
+}
@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build simulated
+
+package deposit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/require"
+)
+
+// simulatedBackend is a geth simulated chain which mines a block for every
+// transaction sent, so that the deposits can be waited for.
+//
+// The simulated chain links github.com/fjl/memsize, which Go 1.23 only links
+// with -ldflags=-checklinkname=0, so these tests run with the simulations.
+type simulatedBackend struct {
+	simulated.Client
+	chain *simulated.Backend
+}
+
+func (b *simulatedBackend) SendTransaction(
+	ctx context.Context,
+	tx *gethtypes.Transaction,
+) error {
+	if err := b.Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	b.chain.Commit()
+	return nil
+}
+
+// newSimulatedBackend starts a simulated chain funding the given key and
+// deploys the deposit contract on it, allowing the key to make the given
+// number of deposits. It returns the backend and the contract address.
+func newSimulatedBackend(
+	t *testing.T,
+	key *ecdsa.PrivateKey,
+	allowedDeposits uint64,
+) (*simulatedBackend, gethcommon.Address) {
+	t.Helper()
+	owner := ethcrypto.PubkeyToAddress(key.PublicKey)
+	chain := simulated.NewBackend(gethtypes.GenesisAlloc{
+		owner: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))},
+	})
+	t.Cleanup(func() { require.NoError(t, chain.Close()) })
+	backend := &simulatedBackend{Client: chain.Client(), chain: chain}
+
+	opts := newTestTransactOpts(t, backend, key)
+	contractAddr, _, dc, err := deposit.DeployBeaconDepositContract(
+		opts, backend, owner,
+	)
+	require.NoError(t, err)
+	if allowedDeposits > 0 {
+		_, err = dc.AllowDeposit(opts, owner, allowedDeposits)
+		require.NoError(t, err)
+	}
+	return backend, contractAddr
+}
+
+func TestSendDeposit(t *testing.T) {
+	var (
+		depositMsg = &types.DepositMessage{
+			Pubkey:      crypto.BLSPubkey{0x01},
+			Credentials: types.WithdrawalCredentials{0x01},
+			Amount:      math.Gwei(32e9),
+		}
+		signature = crypto.BLSSignature{0x02}
+	)
+
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		// amount is the deposited amount in gwei.
+		amount math.Gwei
+		// allowedDeposits is the number of deposits the sender may make.
+		allowedDeposits uint64
+		// otherDeposits is the number of deposits made before this one.
+		otherDeposits int
+		// noContract sends the deposit to an address without the contract.
+		noContract bool
+		wantErr    error
+	}{
+		{
+			name:            "deposit log found",
+			amount:          depositMsg.Amount,
+			allowedDeposits: 1,
+		},
+		{
+			name:            "deposit log found after other deposits",
+			amount:          depositMsg.Amount,
+			allowedDeposits: 3,
+			otherDeposits:   2,
+		},
+		{
+			name:            "deposit below the minimum is reverted",
+			amount:          math.Gwei(1e9),
+			allowedDeposits: 1,
+			wantErr:         ErrDepositTransactionFailed,
+		},
+		{
+			name:    "unauthorized deposit is reverted",
+			amount:  depositMsg.Amount,
+			wantErr: ErrDepositTransactionFailed,
+		},
+		{
+			name:            "deposit contract not deployed at the address",
+			amount:          depositMsg.Amount,
+			allowedDeposits: 1,
+			noContract:      true,
+			wantErr:         ErrDepositLogNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			backend, contractAddr := newSimulatedBackend(
+				t, key, tt.allowedDeposits,
+			)
+			if tt.noContract {
+				contractAddr = gethcommon.HexToAddress(
+					"0x4242424242424242424242424242424242424242",
+				)
+			}
+
+			for i := range tt.otherDeposits {
+				other := *depositMsg
+				other.Pubkey = crypto.BLSPubkey{0x03, byte(i)}
+				_, _, err = sendDeposit(
+					ctx, backend, contractAddr,
+					newTestTransactOpts(t, backend, key),
+					&other, signature,
+				)
+				require.NoError(t, err)
+			}
+
+			msg := *depositMsg
+			msg.Amount = tt.amount
+			receipt, event, err := sendDeposit(
+				ctx, backend, contractAddr,
+				newTestTransactOpts(t, backend, key),
+				&msg, signature,
+			)
+
+			// The deposit amount is sent to the deposit contract in wei.
+			require.NotNil(t, receipt)
+			sent, _, err2 := backend.TransactionByHash(ctx, receipt.TxHash)
+			require.NoError(t, err2)
+			require.Equal(t, contractAddr, *sent.To())
+			require.Equal(t, msg.Amount.ToWei().ToBig(), sent.Value())
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(tt.otherDeposits), event.Index)
+			require.Equal(t, msg.Pubkey[:], event.Pubkey)
+			require.Equal(t, msg.Credentials[:], event.Credentials)
+			require.Equal(t, msg.Amount.Unwrap(), event.Amount)
+			require.Equal(t, signature[:], event.Signature)
+
+			dc, err := deposit.NewBeaconDepositContract(contractAddr, backend)
+			require.NoError(t, err)
+			count, err := dc.DepositCount(&bind.CallOpts{Context: ctx})
+			require.NoError(t, err)
+			require.Equal(t, uint64(tt.otherDeposits+1), count)
+		})
+	}
+}
+
+// newTestTransactOpts returns transaction options for the key on the chain of
+// the backend, with a fixed gas limit so that reverted deposits are mined
+// instead of failing gas estimation.
+func newTestTransactOpts(
+	t *testing.T,
+	backend *simulatedBackend,
+	key *ecdsa.PrivateKey,
+) *bind.TransactOpts {
+	t.Helper()
+	chainID, err := backend.ChainID(context.Background())
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	require.NoError(t, err)
+	opts.GasLimit = 1_000_000
+	return opts
+}
@@ -47,7 +47,8 @@ func NewCreateValidator[
 		Long: `Creates a validator deposit with the necessary credentials. The 
 		arguments are expected in the order of withdrawal credentials, deposit
 		amount, current version, and genesis validator root. If the broadcast
-		flag is set to true, a private key must be provided to sign the transaction.`,
+		flag is set to true, the deposit is sent to the deposit contract and
+		a private key or keystore must be provided to sign the transaction.`,
 		Args: cobra.ExactArgs(4), //nolint:mnd // The number of arguments.
 		RunE: createValidatorCmd[ExecutionPayloadT](chainSpec),
 	}
@@ -59,13 +60,24 @@ func NewCreateValidator[
 	)
 	cmd.Flags().
 		String(valPrivateKey, defaultValidatorPrivateKey, valPrivateKeyMsg)
+	cmd.Flags().BoolP(
+		broadcastDeposit, broadcastDepositShorthand,
+		defaultBroadcastDeposit, broadcastDepositMsg,
+	)
+	cmd.Flags().String(rpcURL, defaultRPCURL, rpcURLMsg)
+	cmd.Flags().String(keystorePath, defaultKeystorePath, keystorePathMsg)
+	cmd.Flags().String(
+		keystorePassword, defaultKeystorePassword, keystorePasswordMsg,
+	)
+	cmd.Flags().Uint64(gasLimit, defaultGasLimit, gasLimitMsg)
+	cmd.Flags().String(gasFeeCap, defaultGasFeeCap, gasFeeCapMsg)
+	cmd.Flags().String(gasTipCap, defaultGasTipCap, gasTipCapMsg)
 
 	return cmd
 }
 
-// createValidatorCmd returns a command that builds a create validator request.
-//
-
+// createValidatorCmd returns a command that builds a create validator request
+// and optionally broadcasts it to the deposit contract.
 func createValidatorCmd[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 ](
@@ -74,6 +86,11 @@ func createValidatorCmd[
 	return func(cmd *cobra.Command, args []string) error {
 		logger := log.NewLogger(os.Stdout)
 
+		broadcast, err := cmd.Flags().GetBool(broadcastDeposit)
+		if err != nil {
+			return err
+		}
+
 		// Get the BLS signer.
 		blsSigner, err := getBLSSigner(cmd)
 		if err != nil {
@@ -124,14 +141,32 @@ func createValidatorCmd[
 
 		// If the broadcast flag is not set, output the deposit message and
 		// signature and return early.
+		if !broadcast {
+			logger.Info(
+				"Deposit Message CallData",
+				"pubkey", depositMsg.Pubkey.String(),
+				"withdrawal credentials", depositMsg.Credentials.String(),
+				"amount", depositMsg.Amount,
+				"signature", signature.String(),
+			)
+			return nil
+		}
+
+		receipt, event, err := broadcastDepositTx(
+			cmd, chainSpec, depositMsg, signature,
+		)
+		if err != nil {
+			return err
+		}
+
 		logger.Info(
-			"Deposit Message CallData",
+			"Deposit broadcasted",
+			"tx hash", receipt.TxHash.Hex(),
+			"block number", receipt.BlockNumber,
+			"deposit index", event.Index,
 			"pubkey", depositMsg.Pubkey.String(),
-			"withdrawal credentials", depositMsg.Credentials.String(),
 			"amount", depositMsg.Amount,
-			"signature", signature.String(),
 		)
-
 		return nil
 	}
 }
@@ -42,4 +42,24 @@ var (
 	// ErrPrivateKeyEmpty is returned when the private key is empty.
 	ErrPrivateKeyEmpty = errors.New(
 		"private key is empty")
+
+	// ErrDepositTransactionFailed is returned when the deposit transaction
+	// is reverted.
+	ErrDepositTransactionFailed = errors.New(
+		"deposit transaction failed")
+
+	// ErrDepositLogNotFound is returned when the deposit transaction does not
+	// emit the expected deposit log.
+	ErrDepositLogNotFound = errors.New(
+		"deposit log not found in receipt")
+
+	// ErrChainIDMismatch is returned when the execution client is not on the
+	// chain of the deposit contract.
+	ErrChainIDMismatch = errors.New(
+		"execution client chain ID does not match the chain spec")
+
+	// ErrInvalidGasPrice is returned when a gas price flag is not a valid
+	// amount of wei.
+	ErrInvalidGasPrice = errors.New(
+		"invalid gas price")
 )
@@ -29,12 +29,40 @@ const (
 
 	// validatorPrivateKey is the flag for the validator private key.
 	valPrivateKey = "validator-private-key"
+
+	// broadcastDeposit is the flag for broadcasting the deposit transaction.
+	broadcastDeposit = "broadcast"
+
+	// rpcURL is the flag for the execution client RPC URL.
+	rpcURL = "rpc-url"
+
+	// keystorePath is the flag for the keystore file of the key to sign the
+	// deposit transaction.
+	keystorePath = "keystore"
+
+	// keystorePassword is the flag for the password of the keystore file.
+	keystorePassword = "keystore-password"
+
+	// gasLimit is the flag for the gas limit of the deposit transaction.
+	gasLimit = "gas-limit"
+
+	// gasFeeCap is the flag for the max fee per gas of the deposit
+	// transaction.
+	gasFeeCap = "gas-fee-cap"
+
+	// gasTipCap is the flag for the max priority fee per gas of the deposit
+	// transaction.
+	gasTipCap = "gas-tip-cap"
 )
 
 const (
 	// overrideNodeKeyShorthand is the shorthand flag for the overrideNodeKey
 	// flag.
 	overrideNodeKeyShorthand = "o"
+
+	// broadcastDepositShorthand is the shorthand flag for the
+	// broadcastDeposit flag.
+	broadcastDepositShorthand = "b"
 )
 
 const (
@@ -47,12 +75,35 @@ const (
 	// defaultValidatorPrivateKey is the default value for the
 	// validatorPrivateKey flag.
 	defaultValidatorPrivateKey = ""
+
+	// defaultBroadcastDeposit is the default value for the broadcastDeposit
+	// flag.
+	defaultBroadcastDeposit = false
+
+	// defaultRPCURL is the default value for the rpcURL flag.
+	defaultRPCURL = "http://localhost:8545"
+
+	// defaultKeystorePath is the default value for the keystorePath flag.
+	defaultKeystorePath = ""
+
+	// defaultKeystorePassword is the default value for the keystorePassword
+	// flag.
+	defaultKeystorePassword = ""
+
+	// defaultGasLimit is the default value for the gasLimit flag.
+	defaultGasLimit uint64 = 0
+
+	// defaultGasFeeCap is the default value for the gasFeeCap flag.
+	defaultGasFeeCap = ""
+
+	// defaultGasTipCap is the default value for the gasTipCap flag.
+	defaultGasTipCap = ""
 )
 
 const (
 	// privateKeyFlagMsg is the usage description for the privateKey flag.
-	privateKeyMsg = `private key to sign and pay for the deposit message. 
-	This is required if the broadcast flag is set.`
+	privateKeyMsg = "hex encoded private key to sign and pay for the deposit " +
+		"transaction. Required to broadcast unless a keystore is set."
 
 	// overrideNodeKeyFlagMsg is the usage description for the overrideNodeKey
 	// flag.
@@ -62,4 +113,31 @@ const (
 	// valPrivateKey flag.
 	valPrivateKeyMsg = `validator private key. This is required if the 
 	override-node-key flag is set.`
+
+	// broadcastDepositMsg is the usage description for the broadcastDeposit
+	// flag.
+	broadcastDepositMsg = "broadcast the deposit transaction to the deposit " +
+		"contract and wait for it to be included"
+
+	// rpcURLMsg is the usage description for the rpcURL flag.
+	rpcURLMsg = "execution client RPC URL to broadcast the deposit to"
+
+	// keystorePathMsg is the usage description for the keystorePath flag.
+	keystorePathMsg = "keystore file of the key to sign and pay for the " +
+		"deposit transaction, used if no private key is provided"
+
+	// keystorePasswordMsg is the usage description for the keystorePassword
+	// flag.
+	keystorePasswordMsg = "password of the keystore file"
+
+	// gasLimitMsg is the usage description for the gasLimit flag.
+	gasLimitMsg = "gas limit of the deposit transaction, estimated if 0"
+
+	// gasFeeCapMsg is the usage description for the gasFeeCap flag.
+	gasFeeCapMsg = "max fee per gas of the deposit transaction in wei, " +
+		"suggested by the execution client if not set"
+
+	// gasTipCapMsg is the usage description for the gasTipCap flag.
+	gasTipCapMsg = "max priority fee per gas of the deposit transaction in " +
+		"wei, suggested by the execution client if not set"
 )
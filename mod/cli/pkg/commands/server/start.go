@@ -27,6 +27,7 @@ import (
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
 	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/config/pkg/config"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
@@ -48,6 +49,10 @@ const (
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+
+	// State sync snapshot flags.
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 )
 
 // StartCmdOptions defines options that can be customized in
@@ -103,6 +108,9 @@ nothing: all historic states will be saved, nothing will be deleted (i.e. archiv
 everything: 2 latest states will be kept; pruning at 10 block intervals.
 custom: allow pruning options to be manually specified through 'pruning-keep-recent', and 'pruning-interval'
 
+State sync snapshots of the application state are taken every
+'--state-sync.snapshot-interval' blocks and the most recent
+'--state-sync.snapshot-keep-recent' snapshots are served to peers.
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
@@ -113,6 +121,14 @@ custom: allow pruning options to be manually specified through 'pruning-keep-rec
 				return err
 			}
 
+			appCfg, err := config.GetConfig(v)
+			if err != nil {
+				return err
+			}
+			if err = appCfg.ValidateBasic(); err != nil {
+				return err
+			}
+
 			// Open the Database
 			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
 			if err != nil {
@@ -181,6 +197,16 @@ func addStartNodeFlags[
 			"Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().
 		Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().
+		Uint64(
+			FlagStateSyncSnapshotInterval,
+			0,
+			"State sync snapshot interval (0 to disable)")
+	cmd.Flags().
+		Uint32(
+			FlagStateSyncSnapshotKeepRecent,
+			2, //nolint:mnd // default retention.
+			"Number of recent state sync snapshots to keep (0 to keep all)")
 
 	// add support for all CometBFT-specific command line options
 	cmtcmd.AddNodeFlags(cmd)
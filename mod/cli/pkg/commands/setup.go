@@ -26,6 +26,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
 	servertypes "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/spec"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/state"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/validator"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	cmtcli "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/cli"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
@@ -48,6 +51,10 @@ func DefaultRootCommandSetup[
 	appCreator servertypes.AppCreator[T, LoggerT],
 	chainSpec common.ChainSpec,
 ) {
+	// `--chain-spec` is read before the commands are built, but is registered
+	// on the root command so that cobra accepts it for every subcommand.
+	flags.AddChainSpecFlag(root.cmd)
+
 	// Add all the commands to the root command.
 	root.cmd.AddCommand(
 		// `comet`
@@ -60,14 +67,20 @@ func DefaultRootCommandSetup[
 		deposit.Commands[ExecutionPayloadT](chainSpec),
 		// `jwt`
 		jwt.Commands(),
+		// `spec`
+		spec.Commands(),
 		// `rollback`
 		server.NewRollbackCmd(appCreator),
+		// `state`
+		state.Commands(),
 		// `start`
 		server.StartCmdWithOptions(appCreator, server.StartCmdOptions[T]{
 			AddFlags: flags.AddBeaconKitFlags,
 		}),
 		// `status`
 		cmtcli.StatusCommand(),
+		// `validator`
+		validator.Commands(chainSpec),
 		// `version`
 		version.NewVersionCommand(),
 	)
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	configspec "github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagOutput = "output"
+	OutputTOML = "toml"
+	OutputJSON = "json"
+)
+
+// ErrUnknownOutput indicates that the requested output format is not
+// supported.
+var ErrUnknownOutput = errors.New("unknown output format")
+
+// Commands creates a new command for inspecting the chain spec.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "spec",
+		Short:                      "Chain spec subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewShowCommand(),
+	)
+
+	return cmd
+}
+
+// NewShowCommand creates a new command for printing the effective chain spec.
+func NewShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Prints the effective chain spec",
+		Long: `This command prints the chain spec the node runs with. The spec is 
+loaded from the file passed with --chain-spec, or else from the preset selected 
+by the CHAIN_SPEC environment variable.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, err := cmd.Flags().GetString(flags.ChainSpec)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			data, err := components.ChainSpecData(path)
+			if err != nil {
+				return err
+			}
+			fields, err := configspec.ToMap(data)
+			if err != nil {
+				return err
+			}
+
+			bz, err := marshal(fields, output)
+			if err != nil {
+				return err
+			}
+
+			cmd.Print(string(bz))
+			return nil
+		},
+	}
+	cmd.Flags().StringP(
+		FlagOutput, "o", OutputTOML, "Output format (toml|json)",
+	)
+	return cmd
+}
+
+// marshal encodes the chain spec fields in the given output format.
+func marshal(fields map[string]any, output string) ([]byte, error) {
+	switch output {
+	case OutputTOML:
+		return toml.Marshal(fields)
+	case OutputJSON:
+		bz, err := json.MarshalIndent(fields, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(bz, '\n'), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOutput, output)
+	}
+}
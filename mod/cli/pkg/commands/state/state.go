@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"encoding/binary"
+	"os"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/db"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// electraFlag is the flag for diffing states of the Electra fork.
+	electraFlag = "electra"
+	// electraFlagMsg is the usage of the electra flag.
+	electraFlagMsg = "the states are of the Electra fork, whose state holds " +
+		"the deposit requests start index"
+)
+
+// Commands creates a new command for inspecting beacon states.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "state",
+		Short:                      "Beacon state subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewDiffCommand(),
+	)
+
+	return cmd
+}
+
+// NewDiffCommand creates a new command for diffing two SSZ encoded beacon
+// states.
+func NewDiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [state-a.ssz] [state-b.ssz]",
+		Short: "Reports the fields that differ between two beacon states",
+		Long: `This command compares two SSZ encoded beacon states, as served by 
+/eth/v2/debug/beacon/states/:state_id, and prints every differing field with 
+its object path. States of the Electra fork are diffed with --electra.`,
+		Args: cobra.ExactArgs(2), //nolint:mnd // two states.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			a, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			b, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			electra, err := cmd.Flags().GetBool(electraFlag)
+			if err != nil {
+				return err
+			}
+			forkVersion := version.Deneb
+			if electra {
+				forkVersion = version.Electra
+			}
+
+			diffs, err := db.Diff(types.BeaconStateSchema(forkVersion), a, b)
+			if err != nil {
+				return err
+			}
+			if len(diffs) == 0 {
+				cmd.Println("states are identical")
+				return nil
+			}
+			for _, diff := range diffs {
+				cmd.Printf(
+					"%s: %s -> %s\n", diff.Path,
+					formatValue(diff, diff.A), formatValue(diff, diff.B),
+				)
+			}
+			cmd.Printf("%d differing fields\n", len(diffs))
+			return nil
+		},
+	}
+	cmd.Flags().Bool(electraFlag, false, electraFlagMsg)
+	return cmd
+}
+
+// formatValue returns a human readable form of the given value of the
+// difference. Unsigned integers and booleans are printed in decimal and other
+// values in hex.
+func formatValue(diff db.Difference, value []byte) string {
+	if value == nil {
+		return "<none>"
+	}
+	if diff.Type.ID().IsBasic() && len(value) <= 8 {
+		var buf [8]byte
+		copy(buf[:], value)
+		return strconv.FormatUint(binary.LittleEndian.Uint64(buf[:]), 10)
+	}
+	return hex.EncodeBytes(value)
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitVoluntaryExit(t *testing.T) {
+	exit := &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{
+			Epoch:          7,
+			ValidatorIndex: 3,
+		},
+		Signature: crypto.BLSSignature{0x01, 0x02},
+	}
+
+	var received types.SignedVoluntaryExit
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, voluntaryExitsPath, r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusOK)
+		},
+	))
+	defer srv.Close()
+
+	require.NoError(t, submitVoluntaryExit(
+		context.Background(), srv.URL+"/", exit,
+	))
+	require.Equal(t, *exit.Message, *received.Message)
+	require.Equal(t, exit.Signature, received.Signature)
+}
+
+func TestSubmitVoluntaryExitRejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "validator already exited", http.StatusBadRequest)
+		},
+	))
+	defer srv.Close()
+
+	err := submitVoluntaryExit(
+		context.Background(), srv.URL, &types.SignedVoluntaryExit{
+			Message: &types.VoluntaryExit{},
+		},
+	)
+	require.ErrorIs(t, err, ErrVoluntaryExitRejected)
+	require.ErrorContains(t, err, "validator already exited")
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"os"
+
+	"cosmossdk.io/log"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/spf13/cobra"
+)
+
+// NewExit creates a new command to create a signed voluntary exit.
+func NewExit(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit",
+		Short: "Creates a signed voluntary exit",
+		Long: `Creates a voluntary exit signed by the validator key. The
+		arguments are expected in the order of validator index, epoch, current
+		version, and genesis validator root. If the broadcast flag is set to
+		true, the exit is submitted to the voluntary exit pool of the node API
+		at the given URL.`,
+		Args: cobra.ExactArgs(4), //nolint:mnd // The number of arguments.
+		RunE: exitCmd(chainSpec),
+	}
+
+	cmd.Flags().BoolP(
+		overrideNodeKey, overrideNodeKeyShorthand,
+		defaultOverrideNodeKey, overrideNodeKeyMsg,
+	)
+	cmd.Flags().
+		String(valPrivateKey, defaultValidatorPrivateKey, valPrivateKeyMsg)
+	cmd.Flags().BoolP(
+		broadcastExit, broadcastExitShorthand,
+		defaultBroadcastExit, broadcastExitMsg,
+	)
+	cmd.Flags().String(nodeAPIURL, defaultNodeAPIURL, nodeAPIURLMsg)
+
+	return cmd
+}
+
+// exitCmd returns a command that builds a signed voluntary exit and
+// optionally submits it to the node API.
+func exitCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		logger := log.NewLogger(os.Stdout)
+
+		broadcast, err := cmd.Flags().GetBool(broadcastExit)
+		if err != nil {
+			return err
+		}
+
+		// Get the BLS signer.
+		blsSigner, err := getBLSSigner(cmd)
+		if err != nil {
+			return err
+		}
+
+		validatorIndex, err := parser.ConvertValidatorIndex(args[0])
+		if err != nil {
+			return err
+		}
+
+		epoch, err := parser.ConvertEpoch(args[1])
+		if err != nil {
+			return err
+		}
+
+		currentVersion, err := parser.ConvertVersion(args[2])
+		if err != nil {
+			return err
+		}
+
+		genesisValidatorRoot, err := parser.ConvertGenesisValidatorRoot(args[3])
+		if err != nil {
+			return err
+		}
+
+		// Create and sign the voluntary exit.
+		forkData := types.NewForkData(currentVersion, genesisValidatorRoot)
+		exit, err := types.CreateAndSignVoluntaryExit(
+			forkData,
+			chainSpec.DomainTypeVoluntaryExit(),
+			blsSigner,
+			epoch,
+			validatorIndex,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Verify the voluntary exit.
+		if err = exit.VerifySignature(
+			forkData,
+			chainSpec.DomainTypeVoluntaryExit(),
+			blsSigner.PublicKey(),
+			signer.BLSSigner{}.VerifySignature,
+		); err != nil {
+			return err
+		}
+
+		// If the broadcast flag is not set, output the signed voluntary exit
+		// and return early.
+		if !broadcast {
+			logger.Info(
+				"Signed Voluntary Exit",
+				"validator index", exit.GetValidatorIndex(),
+				"epoch", exit.GetEpoch(),
+				"signature", exit.Signature.String(),
+			)
+			return nil
+		}
+
+		url, err := cmd.Flags().GetString(nodeAPIURL)
+		if err != nil {
+			return err
+		}
+		if err = submitVoluntaryExit(cmd.Context(), url, exit); err != nil {
+			return err
+		}
+
+		logger.Info(
+			"Voluntary exit submitted",
+			"validator index", exit.GetValidatorIndex(),
+			"epoch", exit.GetEpoch(),
+		)
+		return nil
+	}
+}
+
+// getBLSSigner returns a BLS signer based on the override commands key flag.
+func getBLSSigner(
+	cmd *cobra.Command,
+) (crypto.BLSSigner, error) {
+	var legacyKey components.LegacyKey
+	overrideFlag, err := cmd.Flags().GetBool(overrideNodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the BLS signer.
+	if overrideFlag {
+		var validatorPrivKey string
+		validatorPrivKey, err = cmd.Flags().GetString(valPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		if validatorPrivKey == "" {
+			return nil, ErrValidatorPrivateKeyRequired
+		}
+		legacyKey, err = signer.LegacyKeyFromString(validatorPrivKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return components.ProvideBlsSigner(
+		components.BlsSignerInput{
+			AppOpts: clicontext.GetViperFromCmd(cmd),
+			PrivKey: legacyKey,
+		},
+	)
+}
@@ -21,11 +21,17 @@
 package flags
 
 import (
+	"io"
+
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const (
+	// ChainSpec is the path to a TOML, YAML or JSON chain spec file.
+	ChainSpec = "chain-spec"
+
 	// Beacon Kit Root Flag.
 	beaconKitRoot      = "beacon-kit."
 	BeaconKitAcceptTos = beaconKitRoot + "accept-tos"
@@ -43,12 +49,15 @@ const (
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
 	RPCDialURL              = engineRoot + "rpc-dial-url"
+	RPCFallbackDialURLs     = engineRoot + "rpc-fallback-dial-urls"
+	RPCBroadcast            = engineRoot + "rpc-broadcast"
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	FallbackJWTSecretPaths  = engineRoot + "fallback-jwt-secret-paths"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
@@ -67,13 +76,48 @@ const (
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
 
+	// Light Client Service Config.
+	lightClientServiceRoot    = beaconKitRoot + "light-client-service."
+	LightClientServiceEnabled = lightClientServiceRoot + "enabled"
+
 	// Node API Config.
 	nodeAPIRoot    = beaconKitRoot + "node-api."
 	NodeAPIEnabled = nodeAPIRoot + "enabled"
 	NodeAPIAddress = nodeAPIRoot + "address"
 	NodeAPILogging = nodeAPIRoot + "logging"
+
+	// Tracing Config.
+	tracingRoot        = beaconKitRoot + "tracing."
+	TracingEnabled     = tracingRoot + "enabled"
+	TracingExporter    = tracingRoot + "exporter"
+	TracingEndpoint    = tracingRoot + "endpoint"
+	TracingInsecure    = tracingRoot + "insecure"
+	TracingFilePath    = tracingRoot + "file-path"
+	TracingSampleRatio = tracingRoot + "sample-ratio"
 )
 
+// AddChainSpecFlag adds the chain spec flag to the given root command.
+func AddChainSpecFlag(rootCmd *cobra.Command) {
+	rootCmd.PersistentFlags().String(
+		ChainSpec, "", "path to a TOML, YAML or JSON chain spec file",
+	)
+}
+
+// ChainSpecFromArgs returns the value of the chain spec flag in the given
+// command line arguments. The chain spec is needed to build the commands, so
+// it has to be read before cobra parses the flags.
+func ChainSpecFromArgs(args []string) string {
+	fs := pflag.NewFlagSet(ChainSpec, pflag.ContinueOnError)
+	fs.ParseErrorsWhitelist.UnknownFlags = true
+	fs.SetOutput(io.Discard)
+	fs.Usage = func() {}
+	path := fs.String(ChainSpec, "", "")
+	// Parse errors caused by other flags are ignored here and reported by
+	// cobra once it parses the full command line.
+	_ = fs.Parse(args)
+	return *path
+}
+
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
 func AddBeaconKitFlags(startCmd *cobra.Command) {
 	defaultCfg := config.DefaultConfig()
@@ -82,9 +126,21 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.JWTSecretPath,
 		"path to the execution client secret",
 	)
+	startCmd.Flags().StringSlice(
+		FallbackJWTSecretPaths,
+		defaultCfg.Engine.FallbackJWTSecretPaths,
+		"paths to the fallback execution client secrets",
+	)
 	startCmd.Flags().String(
 		RPCDialURL, defaultCfg.Engine.RPCDialURL.String(), "rpc dial url",
 	)
+	startCmd.Flags().StringSlice(
+		RPCFallbackDialURLs, []string{}, "rpc fallback dial urls",
+	)
+	startCmd.Flags().Bool(
+		RPCBroadcast, defaultCfg.Engine.RPCBroadcast,
+		"broadcast newPayload and forkchoiceUpdated to every endpoint",
+	)
 	startCmd.Flags().Uint64(
 		RPCRetries, defaultCfg.Engine.RPCRetries, "rpc retries",
 	)
@@ -96,6 +152,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCStartupCheckInterval,
 		"rpc startup check interval",
 	)
+	startCmd.Flags().Duration(
+		RPCHealthCheckInteval,
+		defaultCfg.Engine.RPCHealthCheckInterval,
+		"rpc health check interval",
+	)
 	startCmd.Flags().Duration(
 		RPCJWTRefreshInterval,
 		defaultCfg.Engine.RPCJWTRefreshInterval,
@@ -141,6 +202,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().Bool(
+		LightClientServiceEnabled,
+		defaultCfg.LightClientService.Enabled,
+		"light client service enabled",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -156,4 +222,34 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().Bool(
+		TracingEnabled,
+		defaultCfg.Tracing.Enabled,
+		"tracing enabled",
+	)
+	startCmd.Flags().String(
+		TracingExporter,
+		defaultCfg.Tracing.Exporter,
+		"tracing exporter (otlp | file)",
+	)
+	startCmd.Flags().String(
+		TracingEndpoint,
+		defaultCfg.Tracing.Endpoint,
+		"tracing otlp collector endpoint",
+	)
+	startCmd.Flags().Bool(
+		TracingInsecure,
+		defaultCfg.Tracing.Insecure,
+		"tracing otlp insecure connection",
+	)
+	startCmd.Flags().String(
+		TracingFilePath,
+		defaultCfg.Tracing.FilePath,
+		"tracing file exporter path",
+	)
+	startCmd.Flags().Float64(
+		TracingSampleRatio,
+		defaultCfg.Tracing.SampleRatio,
+		"tracing sample ratio",
+	)
 }
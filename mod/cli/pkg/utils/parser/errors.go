@@ -39,6 +39,17 @@ var (
 		"invalid amount",
 	)
 
+	// ErrInvalidValidatorIndex is returned when the validator index is
+	// invalid.
+	ErrInvalidValidatorIndex = errors.New(
+		"invalid validator index",
+	)
+
+	// ErrInvalidEpoch is returned when the epoch is invalid.
+	ErrInvalidEpoch = errors.New(
+		"invalid epoch",
+	)
+
 	// ErrInvalidSignatureLength is returned when the signature is invalid.
 	ErrInvalidSignatureLength = errors.New(
 		"invalid signature length",
@@ -22,6 +22,7 @@ package parser
 
 import (
 	"math/big"
+	"strconv"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -74,6 +75,28 @@ func ConvertAmount(amount string) (math.Gwei, error) {
 	return math.Gwei(amountBigInt.Uint64()), nil
 }
 
+// ConvertValidatorIndex converts a string to a validator index.
+//
+//nolint:mnd // lots of magic numbers
+func ConvertValidatorIndex(index string) (math.ValidatorIndex, error) {
+	i, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidValidatorIndex
+	}
+	return math.ValidatorIndex(i), nil
+}
+
+// ConvertEpoch converts a string to an epoch.
+//
+//nolint:mnd // lots of magic numbers
+func ConvertEpoch(epoch string) (math.Epoch, error) {
+	e, err := strconv.ParseUint(epoch, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidEpoch
+	}
+	return math.Epoch(e), nil
+}
+
 // ConvertSignature converts a string to a signature.
 func ConvertSignature(signature string) (crypto.BLSSignature, error) {
 	// convert the signature to a BLSSignature.
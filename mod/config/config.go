@@ -29,8 +29,11 @@ import (
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	log "github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
+	lightclient "github.com/berachain/beacon-kit/mod/node-api/light_client"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/observability/pkg/tracing"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/statestore"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -43,13 +46,16 @@ type AppOptions interface {
 // DefaultConfig returns the default configuration for a BeaconKit chain.
 func DefaultConfig() *Config {
 	return &Config{
-		Engine:            engineclient.DefaultConfig(),
-		Logger:            log.DefaultConfig(),
-		KZG:               kzg.DefaultConfig(),
-		PayloadBuilder:    builder.DefaultConfig(),
-		Validator:         validator.DefaultConfig(),
-		BlockStoreService: blockstore.DefaultConfig(),
-		NodeAPI:           server.DefaultConfig(),
+		Engine:             engineclient.DefaultConfig(),
+		Logger:             log.DefaultConfig(),
+		KZG:                kzg.DefaultConfig(),
+		PayloadBuilder:     builder.DefaultConfig(),
+		Validator:          validator.DefaultConfig(),
+		BlockStoreService:  blockstore.DefaultConfig(),
+		LightClientService: lightclient.DefaultConfig(),
+		NodeAPI:            server.DefaultConfig(),
+		Tracing:            tracing.DefaultConfig(),
+		StateStore:         statestore.DefaultConfig(),
 	}
 }
 
@@ -67,8 +73,14 @@ type Config struct {
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
+	// LightClientService is the configuration for the light client service.
+	LightClientService lightclient.Config `mapstructure:"light-client-service"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Tracing is the configuration for OpenTelemetry tracing.
+	Tracing tracing.Config `mapstructure:"tracing"`
+	// StateStore is the configuration for the beacon state store.
+	StateStore statestore.Config `mapstructure:"state-store"`
 }
 
 // GetEngine returns the execution client configuration.
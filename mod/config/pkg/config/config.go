@@ -77,10 +77,24 @@ type BaseConfig struct {
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 }
 
+// StateSyncConfig defines the state sync snapshot configuration.
+type StateSyncConfig struct {
+	// SnapshotInterval sets the block interval at which local state sync
+	// snapshots are taken (0 to disable).
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent sets the number of recent state sync snapshots to
+	// keep and serve (0 to keep all).
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+}
+
 // Config defines the server's top level configuration.
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
+	// StateSync defines the state sync snapshot configuration.
+	StateSync StateSyncConfig `mapstructure:"state-sync"`
+
 	// Telemetry defines the application telemetry configuration
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 }
@@ -98,6 +112,11 @@ func DefaultConfig() *Config {
 			IAVLCacheSize:       5000,
 			IAVLDisableFastNode: false,
 		},
+		StateSync: StateSyncConfig{
+			SnapshotInterval: 0,
+			//nolint:mnd // default retention.
+			SnapshotKeepRecent: 2,
+		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
 			GlobalLabels: [][]string{},
@@ -114,16 +133,17 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return *conf, nil
 }
 
-// ValidateBasic returns an error if min-gas-prices field is empty in
-// BaseConfig. Otherwise, it returns nil.
+// ValidateBasic returns an error if state sync snapshots are enabled together
+// with the 'everything' pruning strategy, which would prune the heights the
+// snapshots are taken at. Otherwise, it returns nil.
 func (c Config) ValidateBasic() error {
-	// if c.Pruning == pruningtypes.PruningOptionEverything &&
-	// c.StateSync.SnapshotInterval > 0 {
-	// 	return sdkerrors.ErrAppConfig.Wrapf(
-	// 		"cannot enable state sync snapshots with '%s' pruning setting",
-	// pruningtypes.PruningOptionEverything,
-	// 	)
-	// }
+	if c.Pruning == pruningtypes.PruningOptionEverything &&
+		c.StateSync.SnapshotInterval > 0 {
+		return fmt.Errorf(
+			"cannot enable state sync snapshots with '%s' pruning setting",
+			pruningtypes.PruningOptionEverything,
+		)
+	}
 
 	return nil
 }
@@ -54,6 +54,22 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+###############################################################################
+###                         State Sync Configuration                        ###
+###############################################################################
+
+# State sync snapshots allow other nodes to rapidly join the network without
+# replaying historical blocks, instead downloading and applying a snapshot of
+# the application state at a given height.
+[state-sync]
+
+# snapshot-interval specifies the block interval at which local state sync
+# snapshots are taken (0 to disable).
+snapshot-interval = {{ .StateSync.SnapshotInterval }}
+
+# snapshot-keep-recent specifies the number of recent snapshots to keep and
+# serve (0 to keep all).
+snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
 ###############################################################################
 ###                         Telemetry Configuration                         ###
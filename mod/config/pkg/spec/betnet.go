@@ -33,8 +33,19 @@ func BetnetChainSpec() chain.Spec[
 	common.ExecutionAddress,
 	math.Slot,
 	any,
+] {
+	return chain.NewChainSpec(BetnetChainSpecData())
+}
+
+// BetnetChainSpecData is the chain spec data the BetnetChainSpec is built from.
+func BetnetChainSpecData() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
 ] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = BetnetEth1ChainID
-	return chain.NewChainSpec(testnetSpec)
+	return testnetSpec
 }
@@ -33,8 +33,19 @@ func BoonetChainSpec() chain.Spec[
 	common.ExecutionAddress,
 	math.Slot,
 	any,
+] {
+	return chain.NewChainSpec(BoonetChainSpecData())
+}
+
+// BoonetChainSpecData is the chain spec data the BoonetChainSpec is built from.
+func BoonetChainSpecData() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
 ] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = BoonetEth1ChainID
-	return chain.NewChainSpec(testnetSpec)
+	return testnetSpec
 }
@@ -33,8 +33,19 @@ func DevnetChainSpec() chain.Spec[
 	common.ExecutionAddress,
 	math.Slot,
 	any,
+] {
+	return chain.NewChainSpec(DevnetChainSpecData())
+}
+
+// DevnetChainSpecData is the chain spec data the DevnetChainSpec is built from.
+func DevnetChainSpecData() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
 ] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = DevnetEth1ChainID
-	return chain.NewChainSpec(testnetSpec)
+	return testnetSpec
 }
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+// cometValuesKey is the key of the CometBFT consensus params in the spec,
+// which are not loadable from a spec file.
+const cometValuesKey = "comet-bft-config"
+
+// LoadSpecData reads the chain spec data from the TOML, YAML or JSON file at
+// the given path. Values missing from the file are taken from the base spec,
+// and the resulting spec data is validated for internal consistency.
+func LoadSpecData(path string) (chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+], error) {
+	data := BaseSpec()
+	cometValues := data.CometValues
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return data, fmt.Errorf("failed to read chain spec file: %w", err)
+	}
+
+	if err := v.Unmarshal(&data,
+		viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+			viperlib.StringToExecutionAddressFunc(),
+			viperlib.StringToDomainTypeFunc(),
+		))); err != nil {
+		return data, fmt.Errorf("failed to decode chain spec file: %w", err)
+	}
+	data.CometValues = cometValues
+
+	if err := data.Validate(); err != nil {
+		return data, fmt.Errorf("chain spec file %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// FromFile returns the chain spec loaded from the file at the given path.
+func FromFile(path string) (chain.Spec[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+], error) {
+	data, err := LoadSpecData(path)
+	if err != nil {
+		return nil, err
+	}
+	return chain.NewChainSpec(data), nil
+}
+
+// ToMap flattens the chain spec data into a map keyed by the spec file keys,
+// rendering byte arrays as hex strings. The CometBFT consensus params are
+// omitted since they cannot be set from a spec file.
+func ToMap(data chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+]) (map[string]any, error) {
+	var (
+		value  = reflect.ValueOf(data)
+		fields = make(map[string]any, value.NumField())
+	)
+	for i := range value.NumField() {
+		key := value.Type().Field(i).Tag.Get("mapstructure")
+		if key == cometValuesKey {
+			continue
+		}
+
+		field := value.Field(i)
+		if field.Kind() == reflect.Uint64 {
+			fields[key] = field.Uint()
+			continue
+		}
+
+		marshaler, ok := field.Interface().(encoding.TextMarshaler)
+		if !ok {
+			fields[key] = field.Interface()
+			continue
+		}
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %w", key, err)
+		}
+		fields[key] = string(text)
+	}
+	return fields, nil
+}
@@ -35,10 +35,21 @@ func TestnetChainSpec() chain.Spec[
 	common.ExecutionAddress,
 	math.Slot,
 	any,
+] {
+	return chain.NewChainSpec(TestnetChainSpecData())
+}
+
+// TestnetChainSpecData is the chain spec data the TestnetChainSpec is built from.
+func TestnetChainSpecData() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
 ] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = TestnetEth1ChainID
-	return chain.NewChainSpec(testnetSpec)
+	return testnetSpec
 }
 
 //nolint:mnd // bet.
@@ -65,9 +76,13 @@ func BaseSpec() chain.SpecData[
 		EjectionBalance:           uint64(16e9),
 		EffectiveBalanceIncrement: uint64(1e9),
 		// Time parameters constants.
-		SlotsPerEpoch:                32,
-		MinEpochsToInactivityPenalty: 4,
-		SlotsPerHistoricalRoot:       8,
+		SlotsPerEpoch:                    32,
+		MinEpochsToInactivityPenalty:     4,
+		SlotsPerHistoricalRoot:           8,
+		MinValidatorWithdrawabilityDelay: 256,
+		// Validator cycle constants.
+		MinPerEpochChurnLimit: 4,
+		ChurnLimitQuotient:    1 << 16,
 		// Signature domains.
 		DomainTypeProposer: common.DomainType{
 			0x00, 0x00, 0x00, 0x00,
@@ -109,7 +124,8 @@ func BaseSpec() chain.SpecData[
 		HistoricalRootsLimit:      8,
 		ValidatorRegistryLimit:    1099511627776,
 		// Max operations per block constants.
-		MaxDepositsPerBlock: 16,
+		MaxDepositsPerBlock:       16,
+		MaxVoluntaryExitsPerBlock: 16,
 		// Slashing
 		ProportionalSlashingMultiplier: 1,
 		// Capella values.
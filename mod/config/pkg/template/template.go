@@ -27,10 +27,21 @@ const TomlTemplate = `
 ###############################################################################
 
 [beacon-kit.engine]
-# HTTP url of the execution client JSON-RPC endpoint.
+# HTTP or IPC (ipc:///path/to/engine.ipc) url of the execution client JSON-RPC
+# endpoint.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
 
-# Number of retries before shutting down consensus client.
+# HTTP urls of the standby execution client JSON-RPC endpoints, in order of
+# priority. They are used when the endpoints before them are unhealthy.
+rpc-fallback-dial-urls = [{{ range $i, $url := .BeaconKit.Engine.RPCFallbackDialURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Send newPayload and forkchoiceUpdated calls to every healthy endpoint to keep
+# the standby execution clients in sync. Payloads are only built by the active
+# endpoint.
+rpc-broadcast = {{ .BeaconKit.Engine.RPCBroadcast }}
+
+# Number of times a failed execution client call is retried, with exponential
+# backoff, before giving up or failing over to the next endpoint.
 rpc-retries = "{{.BeaconKit.Engine.RPCRetries}}"
 
 # RPC timeout for execution client requests.
@@ -42,9 +53,17 @@ rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
+# Interval for the health check of the execution client endpoints.
+rpc-health-check-interval = "{{ .BeaconKit.Engine.RPCHealthCheckInterval }}"
+
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Paths to the JWT-secrets of the fallback execution clients, matching
+# rpc-fallback-dial-urls by position. Fallback endpoints without a path use
+# jwt-secret-path.
+fallback-jwt-secret-paths = [{{ range $i, $path := .BeaconKit.Engine.FallbackJWTSecretPaths }}{{ if $i }}, {{ end }}"{{ $path }}"{{ end }}]
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -92,6 +111,10 @@ enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
 # AvailabilityWindow is the number of slots to keep in the store.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+[beacon-kit.light-client-service]
+# Enabled determines if the light client service is enabled.
+enabled = "{{ .BeaconKit.LightClientService.Enabled }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -101,4 +124,28 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+[beacon-kit.tracing]
+# Enabled determines if OpenTelemetry tracing of the block lifecycle is enabled.
+enabled = "{{ .BeaconKit.Tracing.Enabled }}"
+
+# Exporter is the span exporter, either "otlp" or "file".
+exporter = "{{ .BeaconKit.Tracing.Exporter }}"
+
+# Endpoint is the address of the OTLP gRPC collector.
+endpoint = "{{ .BeaconKit.Tracing.Endpoint }}"
+
+# Insecure disables TLS when connecting to the OTLP collector.
+insecure = "{{ .BeaconKit.Tracing.Insecure }}"
+
+# FilePath is the file the spans are written to by the file exporter.
+file-path = "{{ .BeaconKit.Tracing.FilePath }}"
+
+# SampleRatio is the fraction of traces sampled, between 0 and 1.
+sample-ratio = "{{ .BeaconKit.Tracing.SampleRatio }}"
+
+[beacon-kit.state-store]
+# Backend is the backend the beacon state is stored with, either "kv" or "tree".
+# It must not be changed on a node that already has state.
+backend = "{{ .BeaconKit.StateStore.Backend }}"
 `
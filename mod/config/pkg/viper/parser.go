@@ -39,6 +39,18 @@ func StringToExecutionAddressFunc() mapstructure.DecodeHookFunc {
 	)
 }
 
+// StringToDomainTypeFunc returns a DecodeHookFunc that converts
+// string to a `common.DomainType` by parsing the hex string.
+func StringToDomainTypeFunc() mapstructure.DecodeHookFunc {
+	return StringTo(
+		func(s string) (common.DomainType, error) {
+			var domainType common.DomainType
+			err := domainType.UnmarshalText([]byte(s))
+			return domainType, err
+		},
+	)
+}
+
 // StringToDialURLFunc returns a DecodeHookFunc that converts
 // string to *url.URL by parsing the string.
 func StringToDialURLFunc() mapstructure.DecodeHookFunc {
@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/karalabe/ssz"
+)
+
+// BlindedBeaconBlock represents a beacon block whose body carries only the
+// execution payload header instead of the full execution payload. It shares
+// the hash tree root of the BeaconBlock it was blinded from.
+type BlindedBeaconBlock struct {
+	// Slot represents the position of the block in the chain.
+	Slot math.Slot `json:"slot"`
+	// ProposerIndex is the index of the validator who proposed the block.
+	ProposerIndex math.ValidatorIndex `json:"proposer_index"`
+	// ParentRoot is the hash of the parent block
+	ParentRoot common.Root `json:"parent_root"`
+	// StateRoot is the hash of the state at the block.
+	StateRoot common.Root `json:"state_root"`
+	// Body is the blinded body of the block.
+	Body *BlindedBeaconBlockBody `json:"body"`
+}
+
+// BlindedBeaconBlockBody represents the body of a blinded beacon block in the
+// Deneb chain.
+type BlindedBeaconBlockBody struct {
+	// RandaoReveal is the reveal of the RANDAO.
+	RandaoReveal crypto.BLSSignature `json:"randao_reveal"`
+	// Eth1Data is the data from the Eth1 chain.
+	Eth1Data *Eth1Data `json:"eth1_data"`
+	// Graffiti is for a fun message or meme.
+	Graffiti common.Bytes32 `json:"graffiti"`
+	// Deposits is the list of deposits included in the body.
+	Deposits []*Deposit `json:"deposits"`
+	// ExecutionPayloadHeader is the header of the execution payload of the
+	// body.
+	ExecutionPayloadHeader *ExecutionPayloadHeader `json:"execution_payload_header"`
+	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// VoluntaryExits is the list of voluntary exits included in the body,
+	// from Electra on.
+	VoluntaryExits []*SignedVoluntaryExit `json:"voluntary_exits,omitempty"`
+	// ExecutionRequests are the requests made by the execution layer, from
+	// Electra on.
+	ExecutionRequests *engineprimitives.ExecutionRequests `json:"execution_requests,omitempty"`
+
+	// forkVersion is the fork version the body is encoded for.
+	forkVersion uint32
+}
+
+// Blind returns the blinded form of the BeaconBlock, replacing the execution
+// payload in its body with the corresponding execution payload header.
+func (b *BeaconBlock) Blind(eth1ChainID uint64) (*BlindedBeaconBlock, error) {
+	body := b.GetBody()
+	header, err := body.GetExecutionPayload().ToHeader(0, eth1ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlindedBeaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body: &BlindedBeaconBlockBody{
+			RandaoReveal:           body.RandaoReveal,
+			Eth1Data:               body.Eth1Data,
+			Graffiti:               body.Graffiti,
+			Deposits:               body.Deposits,
+			ExecutionPayloadHeader: header,
+			BlobKzgCommitments:     body.BlobKzgCommitments,
+			VoluntaryExits:         body.VoluntaryExits,
+			ExecutionRequests:      body.ExecutionRequests,
+			forkVersion:            body.forkVersion,
+		},
+	}, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the BlindedBeaconBlock object in SSZ encoding.
+func (b *BlindedBeaconBlock) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // todo fix.
+	var size = uint32(8 + 8 + 32 + 32 + 4)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(b.Body)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the BlindedBeaconBlock object.
+func (b *BlindedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint64(codec, &b.Slot)
+	ssz.DefineUint64(codec, &b.ProposerIndex)
+	ssz.DefineStaticBytes(codec, &b.ParentRoot)
+	ssz.DefineStaticBytes(codec, &b.StateRoot)
+	ssz.DefineDynamicObjectOffset(codec, &b.Body)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Body)
+}
+
+// MarshalSSZ marshals the BlindedBeaconBlock object to SSZ format.
+func (b *BlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ unmarshals the BlindedBeaconBlock object from SSZ format.
+func (b *BlindedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot computes the Merkleization of the BlindedBeaconBlock object.
+func (b *BlindedBeaconBlock) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+// SizeSSZ returns the size of the BlindedBeaconBlockBody in SSZ.
+func (b *BlindedBeaconBlockBody) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.forkVersion >= version.Electra {
+		size += 4 + 4
+	}
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(b.Deposits)
+	size += ssz.SizeDynamicObject(b.ExecutionPayloadHeader)
+	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	if b.forkVersion >= version.Electra {
+		size += ssz.SizeSliceOfStaticObjects(b.VoluntaryExits)
+		size += ssz.SizeDynamicObject(b.ExecutionRequests)
+	}
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BlindedBeaconBlockBody.
+//
+//nolint:mnd // TODO: chainspec.
+func (b *BlindedBeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
+	ssz.DefineStaticObject(codec, &b.Eth1Data)
+	ssz.DefineStaticBytes(codec, &b.Graffiti)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
+	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	if b.forkVersion >= version.Electra {
+		ssz.DefineSliceOfStaticObjectsOffset(codec, &b.VoluntaryExits, 16)
+		ssz.DefineDynamicObjectOffset(codec, &b.ExecutionRequests)
+	}
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
+	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	if b.forkVersion >= version.Electra {
+		ssz.DefineSliceOfStaticObjectsContent(codec, &b.VoluntaryExits, 16)
+		ssz.DefineDynamicObjectContent(codec, &b.ExecutionRequests)
+	}
+}
+
+// MarshalSSZ serializes the BlindedBeaconBlockBody to SSZ-encoded bytes.
+func (b *BlindedBeaconBlockBody) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ deserializes the BlindedBeaconBlockBody from SSZ-encoded
+// bytes.
+func (b *BlindedBeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the BlindedBeaconBlockBody.
+func (b *BlindedBeaconBlockBody) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
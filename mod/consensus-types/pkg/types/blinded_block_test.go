@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconBlock_Blind(t *testing.T) {
+	block := generateValidBeaconBlock()
+
+	blinded, err := block.Blind(80087)
+	require.NoError(t, err)
+	require.Equal(t, block.Slot, blinded.Slot)
+	require.Equal(t, block.ProposerIndex, blinded.ProposerIndex)
+	require.Equal(t, block.ParentRoot, blinded.ParentRoot)
+	require.Equal(t, block.StateRoot, blinded.StateRoot)
+	require.Equal(
+		t,
+		block.Body.ExecutionPayload.HashTreeRoot(),
+		blinded.Body.ExecutionPayloadHeader.HashTreeRoot(),
+	)
+
+	// Blinding must not change the root of the block.
+	require.Equal(t, block.HashTreeRoot(), blinded.HashTreeRoot())
+	require.Equal(
+		t,
+		block.Body.HashTreeRoot(),
+		blinded.Body.HashTreeRoot(),
+	)
+}
+
+func TestBlindedBeaconBlock_MarshalUnmarshalSSZ(t *testing.T) {
+	blinded, err := generateValidBeaconBlock().Blind(80087)
+	require.NoError(t, err)
+
+	bz, err := blinded.MarshalSSZ()
+	require.NoError(t, err)
+
+	var unmarshalled types.BlindedBeaconBlock
+	require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+	require.Equal(t, blinded.HashTreeRoot(), unmarshalled.HashTreeRoot())
+	require.Equal(t, blinded.Slot, unmarshalled.Slot)
+}
@@ -59,13 +59,14 @@ func (b *BeaconBlock) NewWithVersion(
 	parentBlockRoot common.Root,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
+	switch forkVersion {
+	case version.Deneb, version.DenebPlus, version.Electra:
 		return &BeaconBlock{
 			Slot:          slot,
 			ProposerIndex: proposerIndex,
 			ParentRoot:    parentBlockRoot,
 			StateRoot:     common.Root{},
-			Body:          &BeaconBlockBody{},
+			Body:          &BeaconBlockBody{forkVersion: forkVersion},
 		}, nil
 	}
 
@@ -80,9 +81,20 @@ func (b *BeaconBlock) NewFromSSZ(
 	bz []byte,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
+	switch forkVersion {
+	case version.Deneb:
 		block := &BeaconBlock{}
 		return block, block.UnmarshalSSZ(bz)
+	case version.DenebPlus, version.Electra:
+		// The body and payload are decoded into, so that they know which
+		// fork version they are encoded for.
+		block := &BeaconBlock{
+			Body: &BeaconBlockBody{
+				ExecutionPayload: &ExecutionPayload{},
+				forkVersion:      forkVersion,
+			},
+		}
+		return block, block.UnmarshalSSZ(bz)
 	}
 
 	return nil, errors.Wrap(
@@ -207,7 +219,7 @@ func (b *BeaconBlock) GetStateRoot() common.Root {
 
 // Version identifies the version of the BeaconBlock.
 func (b *BeaconBlock) Version() uint32 {
-	return version.Deneb
+	return b.GetBody().Version()
 }
 
 // SetStateRoot sets the state root of the BeaconBlock.
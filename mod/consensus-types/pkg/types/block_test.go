@@ -91,6 +91,56 @@ func TestBeaconBlockFromSSZ(t *testing.T) {
 	require.Equal(t, originalBlock, wrappedBlock)
 }
 
+func TestBeaconBlockFromSSZElectra(t *testing.T) {
+	original := generateValidBeaconBlock()
+	block, err := (&types.BeaconBlock{}).NewWithVersion(
+		original.Slot, original.ProposerIndex, original.ParentRoot,
+		version.Electra,
+	)
+	require.NoError(t, err)
+	body := block.GetBody()
+	body.SetEth1Data(original.Body.Eth1Data)
+	body.SetDeposits(original.Body.Deposits)
+	body.SetBlobKzgCommitments(original.Body.BlobKzgCommitments)
+	body.SetExecutionPayload(original.Body.ExecutionPayload)
+	body.SetExecutionRequests(&engineprimitives.ExecutionRequests{
+		Withdrawals: []*engineprimitives.WithdrawalRequest{
+			{SourceAddress: common.ExecutionAddress{1}, Amount: 0},
+		},
+	})
+	body.SetVoluntaryExits([]*types.SignedVoluntaryExit{
+		{Message: &types.VoluntaryExit{Epoch: 1, ValidatorIndex: 2}},
+	})
+
+	bz, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	denebBz, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Greater(t, len(bz), len(denebBz))
+
+	decoded, err := (&types.BeaconBlock{}).NewFromSSZ(bz, version.Electra)
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, decoded.Version())
+	require.Equal(t, block.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Len(t, decoded.GetBody().GetExecutionRequests().Withdrawals, 1)
+	require.Len(t, decoded.GetBody().GetVoluntaryExits(), 1)
+
+	// The voluntary exits and execution requests are part of the body root,
+	// also when proving.
+	require.NotEqual(t, original.HashTreeRoot(), block.HashTreeRoot())
+	tree, err := decoded.GetBody().GetTree()
+	require.NoError(t, err)
+	require.Equal(t,
+		decoded.GetBody().HashTreeRoot(), common.Root(tree.Hash()),
+	)
+	require.Equal(t,
+		types.BodyLengthElectra, decoded.GetBody().Length(),
+	)
+	require.Len(t, decoded.GetBody().GetTopLevelRoots(),
+		int(types.BodyLengthElectra),
+	)
+}
+
 func TestBeaconBlockFromSSZForkVersionNotSupported(t *testing.T) {
 	wrappedBlock := &types.BeaconBlock{}
 	_, err := wrappedBlock.NewFromSSZ([]byte{}, 1)
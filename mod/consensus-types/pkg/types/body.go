@@ -26,6 +26,7 @@
 package types
 
 import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
@@ -40,6 +41,10 @@ const (
 	// struct.
 	BodyLengthDeneb uint64 = 6
 
+	// BodyLengthElectra is the number of fields in the BeaconBlockBody from
+	// Electra on, which adds the voluntary exits and the execution requests.
+	BodyLengthElectra uint64 = 8
+
 	// KZGPositionDeneb is the position of BlobKzgCommitments in the block body.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
@@ -55,12 +60,22 @@ const (
 // for the given fork version.
 func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 	switch forkVersion {
-	case version.Deneb:
+	case version.Deneb, version.DenebPlus:
 		return &BeaconBlockBody{
 			Eth1Data: new(Eth1Data),
 			ExecutionPayload: &ExecutionPayload{
 				ExtraData: make([]byte, ExtraDataSize),
 			},
+			forkVersion: forkVersion,
+		}
+	case version.Electra:
+		return &BeaconBlockBody{
+			Eth1Data: new(Eth1Data),
+			ExecutionPayload: &ExecutionPayload{
+				ExtraData: make([]byte, ExtraDataSize),
+			},
+			ExecutionRequests: new(engineprimitives.ExecutionRequests),
+			forkVersion:       forkVersion,
 		}
 	default:
 		panic(ErrForkVersionNotSupported)
@@ -75,7 +90,9 @@ func BlockBodyKZGOffset(
 	cs common.ChainSpec,
 ) uint64 {
 	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
+	case version.Deneb, version.DenebPlus, version.Electra:
+		// The voluntary exits and execution requests are appended to the
+		// body in Electra, which does not change the depth of its tree.
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
 	default:
 		panic(ErrForkVersionNotSupported)
@@ -83,20 +100,30 @@ func BlockBodyKZGOffset(
 }
 
 // BeaconBlockBody represents the body of a beacon block in the Deneb
-// chain.
+// chain, which carries the voluntary exits and execution requests from
+// Electra on.
 type BeaconBlockBody struct {
 	// RandaoReveal is the reveal of the RANDAO.
-	RandaoReveal crypto.BLSSignature
+	RandaoReveal crypto.BLSSignature `json:"randao_reveal"`
 	// Eth1Data is the data from the Eth1 chain.
-	Eth1Data *Eth1Data
+	Eth1Data *Eth1Data `json:"eth1_data"`
 	// Graffiti is for a fun message or meme.
-	Graffiti [32]byte
+	Graffiti [32]byte `json:"graffiti"`
 	// Deposits is the list of deposits included in the body.
-	Deposits []*Deposit
+	Deposits []*Deposit `json:"deposits"`
 	// ExecutionPayload is the execution payload of the body.
-	ExecutionPayload *ExecutionPayload
+	ExecutionPayload *ExecutionPayload `json:"execution_payload"`
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
-	BlobKzgCommitments []eip4844.KZGCommitment
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// VoluntaryExits is the list of voluntary exits included in the body,
+	// from Electra on.
+	VoluntaryExits []*SignedVoluntaryExit `json:"voluntary_exits,omitempty"`
+	// ExecutionRequests are the requests made by the execution layer, from
+	// Electra on.
+	ExecutionRequests *engineprimitives.ExecutionRequests `json:"execution_requests,omitempty"`
+
+	// forkVersion is the fork version the body is encoded for.
+	forkVersion uint32
 }
 
 /* -------------------------------------------------------------------------- */
@@ -106,6 +133,9 @@ type BeaconBlockBody struct {
 // SizeSSZ returns the size of the BeaconBlockBody in SSZ.
 func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.isElectra() {
+		size += 4 + 4
+	}
 	if fixed {
 		return size
 	}
@@ -113,6 +143,10 @@ func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	size += ssz.SizeSliceOfStaticObjects(b.Deposits)
 	size += ssz.SizeDynamicObject(b.ExecutionPayload)
 	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	if b.isElectra() {
+		size += ssz.SizeSliceOfStaticObjects(b.VoluntaryExits)
+		size += ssz.SizeDynamicObject(b.ExecutionRequests)
+	}
 	return size
 }
 
@@ -127,11 +161,25 @@ func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	if b.isElectra() {
+		ssz.DefineSliceOfStaticObjectsOffset(codec, &b.VoluntaryExits, 16)
+		ssz.DefineDynamicObjectOffset(codec, &b.ExecutionRequests)
+	}
 
 	// Define the dynamic data (fields)
 	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	if b.isElectra() {
+		ssz.DefineSliceOfStaticObjectsContent(codec, &b.VoluntaryExits, 16)
+		ssz.DefineDynamicObjectContent(codec, &b.ExecutionRequests)
+	}
+}
+
+// isElectra returns whether the body is encoded for Electra or later, i.e.
+// whether it carries voluntary exits and execution requests.
+func (b *BeaconBlockBody) isElectra() bool {
+	return b.forkVersion >= version.Electra
 }
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
@@ -221,6 +269,31 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 		hh.MerkleizeWithMixin(subIndx, numItems, 16)
 	}
 
+	// Field (6) 'VoluntaryExits'
+	if b.isElectra() {
+		subIndx := hh.Index()
+		num := uint64(len(b.VoluntaryExits))
+		if num > 16 {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range b.VoluntaryExits {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (7) 'ExecutionRequests'
+	if b.isElectra() {
+		if b.ExecutionRequests == nil {
+			b.ExecutionRequests = new(engineprimitives.ExecutionRequests)
+		}
+		if err := b.ExecutionRequests.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -291,7 +364,7 @@ func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
-	return []common.Root{
+	roots := []common.Root{
 		common.Root(b.GetRandaoReveal().HashTreeRoot()),
 		b.Eth1Data.HashTreeRoot(),
 		common.Root(b.GetGraffiti().HashTreeRoot()),
@@ -300,13 +373,33 @@ func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 		// I think this is a bug.
 		common.Root{},
 	}
+	if b.isElectra() {
+		roots = append(
+			roots,
+			VoluntaryExits(b.GetVoluntaryExits()).HashTreeRoot(),
+			b.GetExecutionRequests().HashTreeRoot(),
+		)
+	}
+	return roots
 }
 
 // Length returns the number of fields in the BeaconBlockBody struct.
 func (b *BeaconBlockBody) Length() uint64 {
+	if b.isElectra() {
+		return BodyLengthElectra
+	}
 	return BodyLengthDeneb
 }
 
+// Version returns the fork version the BeaconBlockBody is encoded for. Bodies
+// that were not created for a fork version are Deneb bodies.
+func (b *BeaconBlockBody) Version() uint32 {
+	if b == nil {
+		return version.Deneb
+	}
+	return max(b.forkVersion, version.Deneb)
+}
+
 // GetRandaoReveal returns the RandaoReveal of the Body.
 func (b *BeaconBlockBody) GetRandaoReveal() crypto.BLSSignature {
 	return b.RandaoReveal
@@ -341,3 +434,29 @@ func (b *BeaconBlockBody) GetDeposits() []*Deposit {
 func (b *BeaconBlockBody) SetDeposits(deposits []*Deposit) {
 	b.Deposits = deposits
 }
+
+// GetVoluntaryExits returns the VoluntaryExits of the BeaconBlockBody, which
+// are nil before Electra.
+func (b *BeaconBlockBody) GetVoluntaryExits() []*SignedVoluntaryExit {
+	return b.VoluntaryExits
+}
+
+// SetVoluntaryExits sets the VoluntaryExits of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetVoluntaryExits(exits []*SignedVoluntaryExit) {
+	b.VoluntaryExits = exits
+}
+
+// GetExecutionRequests returns the ExecutionRequests of the BeaconBlockBody,
+// which are nil before Electra.
+func (
+	b *BeaconBlockBody,
+) GetExecutionRequests() *engineprimitives.ExecutionRequests {
+	return b.ExecutionRequests
+}
+
+// SetExecutionRequests sets the ExecutionRequests of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetExecutionRequests(
+	requests *engineprimitives.ExecutionRequests,
+) {
+	b.ExecutionRequests = requests
+}
@@ -80,6 +80,40 @@ func TestBeaconBlockBody(t *testing.T) {
 	require.Equal(t, types.BodyLengthDeneb, body.Length())
 }
 
+func TestBeaconBlockBody_VoluntaryExitsBeforeElectra(t *testing.T) {
+	body := generateBeaconBlockBody()
+	bz, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	root := body.HashTreeRoot()
+
+	// Deneb bodies do not carry voluntary exits, so their encoding and root
+	// are left unchanged.
+	body.SetVoluntaryExits([]*types.SignedVoluntaryExit{
+		{Message: &types.VoluntaryExit{Epoch: 1, ValidatorIndex: 2}},
+	})
+	exitsBz, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, bz, exitsBz)
+	require.Equal(t, root, body.HashTreeRoot())
+	require.Len(t, body.GetTopLevelRoots(), int(types.BodyLengthDeneb))
+	require.Equal(t, types.BodyLengthDeneb-1, types.KZGPositionDeneb)
+}
+
+func TestBeaconBlockBody_DenebPlus(t *testing.T) {
+	// Deneb+ bodies are encoded as Deneb bodies.
+	deneb := (&types.BeaconBlockBody{}).Empty(version.Deneb)
+	body := (&types.BeaconBlockBody{}).Empty(version.DenebPlus)
+	require.Equal(t, version.DenebPlus, body.Version())
+	require.Equal(t, deneb.Length(), body.Length())
+
+	denebBz, err := deneb.MarshalSSZ()
+	require.NoError(t, err)
+	bz, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, denebBz, bz)
+	require.Equal(t, deneb.HashTreeRoot(), body.HashTreeRoot())
+}
+
 func TestBeaconBlockBody_GetTree(t *testing.T) {
 	body := generateBeaconBlockBody()
 	tree, err := body.GetTree()
@@ -32,6 +32,9 @@ import (
 // DepositSize is the size of the SSZ encoding of a Deposit.
 const DepositSize = 192 // 48 + 32 + 8 + 96 + 8
 
+// depositDataSize is the size of the SSZ encoding of a depositData.
+const depositDataSize = 184 // 48 + 32 + 8 + 96
+
 // Compile-time assertions to ensure Deposit implements necessary interfaces.
 var (
 	_ ssz.StaticObject                    = (*Deposit)(nil)
@@ -141,6 +144,40 @@ func (d *Deposit) HashTreeRoot() common.Root {
 	return ssz.HashSequential(d)
 }
 
+// GetDepositDataRoot returns the hash tree root of the deposit without its
+// index, i.e. of the DepositData leaf the deposit contract appends to its
+// deposit tree.
+func (d *Deposit) GetDepositDataRoot() common.Root {
+	return ssz.HashSequential(&depositData{
+		Pubkey:      d.Pubkey,
+		Credentials: d.Credentials,
+		Amount:      d.Amount,
+		Signature:   d.Signature,
+	})
+}
+
+// depositData is the DepositData of the deposit contract, i.e. a Deposit
+// without its index.
+type depositData struct {
+	Pubkey      crypto.BLSPubkey
+	Credentials WithdrawalCredentials
+	Amount      math.Gwei
+	Signature   crypto.BLSSignature
+}
+
+// DefineSSZ defines the SSZ encoding for the depositData object.
+func (d *depositData) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &d.Pubkey)
+	ssz.DefineStaticBytes(c, &d.Credentials)
+	ssz.DefineUint64(c, &d.Amount)
+	ssz.DefineStaticBytes(c, &d.Signature)
+}
+
+// SizeSSZ returns the SSZ encoded size of the depositData object.
+func (d *depositData) SizeSSZ() uint32 {
+	return depositDataSize
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   FastSSZ                                  */
 /* -------------------------------------------------------------------------- */
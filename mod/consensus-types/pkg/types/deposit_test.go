@@ -21,6 +21,8 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"io"
 	"testing"
 
@@ -91,6 +93,42 @@ func TestDeposit_HashTreeRoot(t *testing.T) {
 	})
 }
 
+func TestDeposit_GetDepositDataRoot(t *testing.T) {
+	deposit := types.NewDeposit(
+		crypto.BLSPubkey{0x01, 0x02},
+		types.WithdrawalCredentials{0x03},
+		math.Gwei(32e9),
+		crypto.BLSSignature{0x04, 95: 0x05},
+		7,
+	)
+
+	// The deposit data root is computed as by the deposit contract.
+	hash := func(parts ...[]byte) []byte {
+		h := sha256.New()
+		for _, part := range parts {
+			h.Write(part)
+		}
+		return h.Sum(nil)
+	}
+	var amount [32]byte
+	binary.LittleEndian.PutUint64(amount[:], uint64(deposit.Amount))
+	pubkeyRoot := hash(deposit.Pubkey[:], make([]byte, 16))
+	signatureRoot := hash(
+		hash(deposit.Signature[:64]),
+		hash(deposit.Signature[64:], make([]byte, 32)),
+	)
+	expected := hash(
+		hash(pubkeyRoot, deposit.Credentials[:]),
+		hash(amount[:], signatureRoot),
+	)
+	require.Equal(t, common.Root(expected), deposit.GetDepositDataRoot())
+
+	// The index is not part of the deposit data.
+	deposit.Index = 8
+	require.Equal(t, common.Root(expected), deposit.GetDepositDataRoot())
+	require.NotEqual(t, deposit.HashTreeRoot(), deposit.GetDepositDataRoot())
+}
+
 func TestDeposit_SizeSSZ(t *testing.T) {
 	deposit := generateValidDeposit()
 
@@ -27,6 +27,10 @@ var (
 	// match.
 	ErrDepositMessage = errors.New("invalid deposit message")
 
+	// ErrVoluntaryExitSignature is an error for when the voluntary exit
+	// signature doesn't match.
+	ErrVoluntaryExitSignature = errors.New("invalid voluntary exit signature")
+
 	// ErrInvalidWithdrawalCredentials is an error for when the.
 	ErrInvalidWithdrawalCredentials = errors.New(
 		"invalid withdrawal credentials",
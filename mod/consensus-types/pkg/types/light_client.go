@@ -0,0 +1,513 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// MaxLightClientBranchLength is the maximum number of roots in a light client
+// Merkle branch. It bounds the depth of the proven generalized indices.
+const MaxLightClientBranchLength = 64
+
+var (
+	_ ssz.StaticObject                    = (*LightClientHeader)(nil)
+	_ ssz.DynamicObject                   = (*LightClientBootstrap)(nil)
+	_ ssz.StaticObject                    = (*CommitSignature)(nil)
+	_ ssz.DynamicObject                   = (*LightClientCommit)(nil)
+	_ ssz.DynamicObject                   = (*LightClientUpdate)(nil)
+	_ ssz.DynamicObject                   = (*LightClientFinalityUpdate)(nil)
+	_ ssz.DynamicObject                   = (*LightClientOptimisticUpdate)(nil)
+	_ constraints.SSZMarshallableRootable = (*LightClientHeader)(nil)
+	_ constraints.SSZMarshallableRootable = (*LightClientBootstrap)(nil)
+	_ constraints.SSZMarshallableRootable = (*LightClientUpdate)(nil)
+	_ constraints.SSZMarshallableRootable = (*LightClientFinalityUpdate)(nil)
+	_ constraints.SSZMarshallableRootable = (*LightClientOptimisticUpdate)(nil)
+)
+
+/* -------------------------------------------------------------------------- */
+/*                                   Header                                   */
+/* -------------------------------------------------------------------------- */
+
+// LightClientHeader is the header of a beacon block as seen by a light client.
+type LightClientHeader struct {
+	// Beacon is the beacon block header.
+	Beacon *BeaconBlockHeader `json:"beacon"`
+}
+
+// NewLightClientHeader creates a new LightClientHeader.
+func NewLightClientHeader(beacon *BeaconBlockHeader) *LightClientHeader {
+	return &LightClientHeader{Beacon: beacon}
+}
+
+// SizeSSZ returns the size of the LightClientHeader in SSZ encoding.
+func (*LightClientHeader) SizeSSZ() uint32 {
+	return BeaconBlockHeaderSize
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientHeader.
+func (h *LightClientHeader) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &h.Beacon)
+}
+
+// MarshalSSZ marshals the LightClientHeader to SSZ format.
+func (h *LightClientHeader) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, h.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, h)
+}
+
+// UnmarshalSSZ unmarshals the LightClientHeader from SSZ format.
+func (h *LightClientHeader) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, h)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the LightClientHeader.
+func (h *LightClientHeader) HashTreeRoot() common.Root {
+	return ssz.HashSequential(h)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  Bootstrap                                 */
+/* -------------------------------------------------------------------------- */
+
+// LightClientBootstrap is the object a light client is initialized from. It
+// carries a trusted header along with the validator set of the state at that
+// header, and a Merkle branch of the validators in the state.
+type LightClientBootstrap struct {
+	// Header is the header of the trusted block.
+	Header *LightClientHeader `json:"header"`
+	// Validators is the validator set of the state at the header.
+	Validators []*Validator `json:"validators"`
+	// ValidatorsBranch is the Merkle branch of the validators in the state
+	// whose root is the state root of the header.
+	ValidatorsBranch []common.Root `json:"validators_branch"`
+}
+
+// Empty creates an empty LightClientBootstrap instance.
+func (*LightClientBootstrap) Empty() *LightClientBootstrap {
+	return &LightClientBootstrap{}
+}
+
+// GetSlot returns the slot of the header of the LightClientBootstrap.
+func (b *LightClientBootstrap) GetSlot() math.Slot {
+	return b.Header.Beacon.GetSlot()
+}
+
+// SizeSSZ returns the size of the LightClientBootstrap in SSZ encoding.
+func (b *LightClientBootstrap) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = BeaconBlockHeaderSize + 4 + 4
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(b.Validators)
+	size += ssz.SizeSliceOfStaticBytes(b.ValidatorsBranch)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientBootstrap.
+func (b *LightClientBootstrap) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &b.Header)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &b.Validators, MaxValidators,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.ValidatorsBranch, MaxLightClientBranchLength,
+	)
+
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &b.Validators, MaxValidators,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.ValidatorsBranch, MaxLightClientBranchLength,
+	)
+}
+
+// MarshalSSZ marshals the LightClientBootstrap to SSZ format.
+func (b *LightClientBootstrap) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, b.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ unmarshals the LightClientBootstrap from SSZ format.
+func (b *LightClientBootstrap) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the LightClientBootstrap.
+func (b *LightClientBootstrap) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Commit                                   */
+/* -------------------------------------------------------------------------- */
+
+// The flags of a commit signature, as defined by CometBFT.
+const (
+	// BlockIDFlagAbsent is the flag of a validator that did not vote.
+	BlockIDFlagAbsent uint64 = 1
+	// BlockIDFlagCommit is the flag of a validator that voted for the block.
+	BlockIDFlagCommit uint64 = 2
+	// BlockIDFlagNil is the flag of a validator that voted for nil.
+	BlockIDFlagNil uint64 = 3
+)
+
+// CommitSignatureSize is the size of the CommitSignature in bytes.
+const CommitSignatureSize = 132
+
+// CommitSignature is the signature of a validator in the CometBFT commit of a
+// block.
+type CommitSignature struct {
+	// BlockIDFlag is the flag of the vote of the validator.
+	BlockIDFlag math.U64 `json:"block_id_flag"`
+	// ValidatorAddress is the CometBFT address of the validator.
+	ValidatorAddress bytes.B20 `json:"validator_address"`
+	// Timestamp is the time of the vote, in Unix nanoseconds.
+	Timestamp math.U64 `json:"timestamp"`
+	// Signature is the signature of the validator over its vote, empty if
+	// the validator did not vote.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// SizeSSZ returns the size of the CommitSignature in SSZ encoding.
+func (*CommitSignature) SizeSSZ() uint32 {
+	return CommitSignatureSize
+}
+
+// DefineSSZ defines the SSZ encoding for the CommitSignature.
+func (s *CommitSignature) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &s.BlockIDFlag)
+	ssz.DefineStaticBytes(codec, &s.ValidatorAddress)
+	ssz.DefineUint64(codec, &s.Timestamp)
+	ssz.DefineStaticBytes(codec, &s.Signature)
+}
+
+// LightClientCommit is the CometBFT commit of a block. Its signatures are
+// verified against the canonical votes of the commit, which CometBFT builds
+// from the chain ID, height, round and block ID of the commit.
+type LightClientCommit struct {
+	// Height is the height of the committed block.
+	Height math.U64 `json:"height"`
+	// Round is the consensus round the block was committed at.
+	Round math.U64 `json:"round"`
+	// BlockHash is the CometBFT hash of the committed block.
+	BlockHash common.Root `json:"block_hash"`
+	// PartSetTotal is the number of parts of the committed block.
+	PartSetTotal math.U64 `json:"part_set_total"`
+	// PartSetHash is the Merkle root of the parts of the committed block.
+	PartSetHash common.Root `json:"part_set_hash"`
+	// Signatures are the signatures of the validators, in the order of the
+	// CometBFT validator set.
+	Signatures []*CommitSignature `json:"signatures"`
+}
+
+// NumCommitted returns the number of validators that signed the commit for
+// the block.
+func (c *LightClientCommit) NumCommitted() int {
+	var n int
+	for _, sig := range c.Signatures {
+		if sig.BlockIDFlag.Unwrap() == BlockIDFlagCommit {
+			n++
+		}
+	}
+	return n
+}
+
+// SizeSSZ returns the size of the LightClientCommit in SSZ encoding.
+func (c *LightClientCommit) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 8 + 8 + 32 + 8 + 32 + 4
+	if fixed {
+		return size
+	}
+	return size + ssz.SizeSliceOfStaticObjects(c.Signatures)
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientCommit.
+func (c *LightClientCommit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &c.Height)
+	ssz.DefineUint64(codec, &c.Round)
+	ssz.DefineStaticBytes(codec, &c.BlockHash)
+	ssz.DefineUint64(codec, &c.PartSetTotal)
+	ssz.DefineStaticBytes(codec, &c.PartSetHash)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &c.Signatures, MaxValidators,
+	)
+
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &c.Signatures, MaxValidators,
+	)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Update                                   */
+/* -------------------------------------------------------------------------- */
+
+// LightClientUpdate is the object a light client advances its finalized
+// header and its validator set with. The finalized header is proven by the
+// finality branch against the attested header, which is signed by the commit
+// of the signing validators. The validators of the attested state, which sign
+// the blocks from two slots after the attested header on, are proven by the
+// next validators branch against the attested header.
+type LightClientUpdate struct {
+	// AttestedHeader is the header of the block that attests to the
+	// finalized header.
+	AttestedHeader *LightClientHeader `json:"attested_header"`
+	// NextValidators is the validator set of the attested state.
+	NextValidators []*Validator `json:"next_validators"`
+	// NextValidatorsBranch is the Merkle branch of the validators in the
+	// state whose root is the state root of the attested header.
+	NextValidatorsBranch []common.Root `json:"next_validators_branch"`
+	// FinalizedHeader is the header of the finalized block.
+	FinalizedHeader *LightClientHeader `json:"finalized_header"`
+	// FinalityBranch is the Merkle branch of the finalized block root in the
+	// attested header.
+	FinalityBranch []common.Root `json:"finality_branch"`
+	// Commit is the commit of the attested header.
+	Commit *LightClientCommit `json:"commit"`
+	// SigningValidators is the validator set that signed the commit.
+	SigningValidators []*Validator `json:"signing_validators"`
+	// SignatureSlot is the slot of the block carrying the commit.
+	SignatureSlot math.Slot `json:"signature_slot"`
+}
+
+// Empty creates an empty LightClientUpdate instance.
+func (*LightClientUpdate) Empty() *LightClientUpdate {
+	return &LightClientUpdate{}
+}
+
+// GetSlot returns the slot of the attested header of the LightClientUpdate.
+func (u *LightClientUpdate) GetSlot() math.Slot {
+	return u.AttestedHeader.Beacon.GetSlot()
+}
+
+// SizeSSZ returns the size of the LightClientUpdate in SSZ encoding.
+func (u *LightClientUpdate) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 2*BeaconBlockHeaderSize + 5*4 + 8
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(u.NextValidators)
+	size += ssz.SizeSliceOfStaticBytes(u.NextValidatorsBranch)
+	size += ssz.SizeSliceOfStaticBytes(u.FinalityBranch)
+	size += ssz.SizeDynamicObject(u.Commit)
+	size += ssz.SizeSliceOfStaticObjects(u.SigningValidators)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientUpdate.
+func (u *LightClientUpdate) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &u.AttestedHeader)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &u.NextValidators, MaxValidators,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &u.NextValidatorsBranch, MaxLightClientBranchLength,
+	)
+	ssz.DefineStaticObject(codec, &u.FinalizedHeader)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &u.FinalityBranch, MaxLightClientBranchLength,
+	)
+	ssz.DefineDynamicObjectOffset(codec, &u.Commit)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &u.SigningValidators, MaxValidators,
+	)
+	ssz.DefineUint64(codec, &u.SignatureSlot)
+
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &u.NextValidators, MaxValidators,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &u.NextValidatorsBranch, MaxLightClientBranchLength,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &u.FinalityBranch, MaxLightClientBranchLength,
+	)
+	ssz.DefineDynamicObjectContent(codec, &u.Commit)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &u.SigningValidators, MaxValidators,
+	)
+}
+
+// MarshalSSZ marshals the LightClientUpdate to SSZ format.
+func (u *LightClientUpdate) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, u.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, u)
+}
+
+// UnmarshalSSZ unmarshals the LightClientUpdate from SSZ format.
+func (u *LightClientUpdate) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, u)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the LightClientUpdate.
+func (u *LightClientUpdate) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(u)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                               Finality Update                              */
+/* -------------------------------------------------------------------------- */
+
+// LightClientFinalityUpdate is the object a light client tracks the latest
+// finalized header with. Unlike the LightClientUpdate, it does not carry the
+// validator sets, which the light client already tracks.
+type LightClientFinalityUpdate struct {
+	// AttestedHeader is the header of the block that attests to the
+	// finalized header.
+	AttestedHeader *LightClientHeader `json:"attested_header"`
+	// FinalizedHeader is the header of the finalized block.
+	FinalizedHeader *LightClientHeader `json:"finalized_header"`
+	// FinalityBranch is the Merkle branch of the finalized block root in the
+	// attested header.
+	FinalityBranch []common.Root `json:"finality_branch"`
+	// Commit is the commit of the attested header.
+	Commit *LightClientCommit `json:"commit"`
+	// SignatureSlot is the slot of the block carrying the commit.
+	SignatureSlot math.Slot `json:"signature_slot"`
+}
+
+// GetSlot returns the slot of the attested header of the
+// LightClientFinalityUpdate.
+func (u *LightClientFinalityUpdate) GetSlot() math.Slot {
+	return u.AttestedHeader.Beacon.GetSlot()
+}
+
+// SizeSSZ returns the size of the LightClientFinalityUpdate in SSZ encoding.
+func (u *LightClientFinalityUpdate) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 2*BeaconBlockHeaderSize + 2*4 + 8
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticBytes(u.FinalityBranch)
+	size += ssz.SizeDynamicObject(u.Commit)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientFinalityUpdate.
+func (u *LightClientFinalityUpdate) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &u.AttestedHeader)
+	ssz.DefineStaticObject(codec, &u.FinalizedHeader)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &u.FinalityBranch, MaxLightClientBranchLength,
+	)
+	ssz.DefineDynamicObjectOffset(codec, &u.Commit)
+	ssz.DefineUint64(codec, &u.SignatureSlot)
+
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &u.FinalityBranch, MaxLightClientBranchLength,
+	)
+	ssz.DefineDynamicObjectContent(codec, &u.Commit)
+}
+
+// MarshalSSZ marshals the LightClientFinalityUpdate to SSZ format.
+func (u *LightClientFinalityUpdate) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, u.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, u)
+}
+
+// UnmarshalSSZ unmarshals the LightClientFinalityUpdate from SSZ format.
+func (u *LightClientFinalityUpdate) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, u)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the
+// LightClientFinalityUpdate.
+func (u *LightClientFinalityUpdate) HashTreeRoot() common.Root {
+	return ssz.HashSequential(u)
+}
+
+// ToOptimisticUpdate returns the optimistic update of the
+// LightClientFinalityUpdate, which only carries its attested header and its
+// commit.
+func (
+	u *LightClientFinalityUpdate,
+) ToOptimisticUpdate() *LightClientOptimisticUpdate {
+	return &LightClientOptimisticUpdate{
+		AttestedHeader: u.AttestedHeader,
+		Commit:         u.Commit,
+		SignatureSlot:  u.SignatureSlot,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              Optimistic Update                             */
+/* -------------------------------------------------------------------------- */
+
+// LightClientOptimisticUpdate is the object a light client tracks the head of
+// the chain with.
+type LightClientOptimisticUpdate struct {
+	// AttestedHeader is the header of the attested block.
+	AttestedHeader *LightClientHeader `json:"attested_header"`
+	// Commit is the commit of the attested header.
+	Commit *LightClientCommit `json:"commit"`
+	// SignatureSlot is the slot of the block carrying the commit.
+	SignatureSlot math.Slot `json:"signature_slot"`
+}
+
+// GetSlot returns the slot of the attested header of the
+// LightClientOptimisticUpdate.
+func (u *LightClientOptimisticUpdate) GetSlot() math.Slot {
+	return u.AttestedHeader.Beacon.GetSlot()
+}
+
+// SizeSSZ returns the size of the LightClientOptimisticUpdate in SSZ
+// encoding.
+func (u *LightClientOptimisticUpdate) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = BeaconBlockHeaderSize + 4 + 8
+	if fixed {
+		return size
+	}
+	return size + ssz.SizeDynamicObject(u.Commit)
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientOptimisticUpdate.
+func (u *LightClientOptimisticUpdate) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &u.AttestedHeader)
+	ssz.DefineDynamicObjectOffset(codec, &u.Commit)
+	ssz.DefineUint64(codec, &u.SignatureSlot)
+
+	ssz.DefineDynamicObjectContent(codec, &u.Commit)
+}
+
+// MarshalSSZ marshals the LightClientOptimisticUpdate to SSZ format.
+func (u *LightClientOptimisticUpdate) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, u.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, u)
+}
+
+// UnmarshalSSZ unmarshals the LightClientOptimisticUpdate from SSZ format.
+func (u *LightClientOptimisticUpdate) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, u)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the
+// LightClientOptimisticUpdate.
+func (u *LightClientOptimisticUpdate) HashTreeRoot() common.Root {
+	return ssz.HashSequential(u)
+}
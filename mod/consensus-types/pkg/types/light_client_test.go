@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func generateLightClientHeader(slot math.Slot) *types.LightClientHeader {
+	return types.NewLightClientHeader(types.NewBeaconBlockHeader(
+		slot, 1, common.Root{1}, common.Root{2}, common.Root{3},
+	))
+}
+
+func TestLightClientBootstrap_MarshalUnmarshalSSZ(t *testing.T) {
+	bootstrap := &types.LightClientBootstrap{
+		Header: generateLightClientHeader(10),
+		Validators: []*types.Validator{
+			{
+				Pubkey:           crypto.BLSPubkey{1},
+				EffectiveBalance: math.Gwei(32e9),
+			},
+			{
+				Pubkey:           crypto.BLSPubkey{2},
+				EffectiveBalance: math.Gwei(16e9),
+				Slashed:          true,
+			},
+		},
+		ValidatorsBranch: []common.Root{{4}, {5}, {6}, {7}},
+	}
+
+	bz, err := bootstrap.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, int(bootstrap.SizeSSZ(false)))
+
+	decoded := (&types.LightClientBootstrap{}).Empty()
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, bootstrap, decoded)
+	require.Equal(t, bootstrap.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t, math.Slot(10), decoded.GetSlot())
+}
+
+func generateLightClientCommit(height uint64) *types.LightClientCommit {
+	return &types.LightClientCommit{
+		Height:       math.U64(height),
+		Round:        1,
+		BlockHash:    common.Root{7},
+		PartSetTotal: 1,
+		PartSetHash:  common.Root{8},
+		Signatures: []*types.CommitSignature{
+			{
+				BlockIDFlag:      math.U64(types.BlockIDFlagCommit),
+				ValidatorAddress: bytes.B20{1},
+				Timestamp:        1,
+				Signature:        crypto.BLSSignature{1},
+			},
+			{
+				BlockIDFlag:      math.U64(types.BlockIDFlagAbsent),
+				ValidatorAddress: bytes.B20{2},
+			},
+		},
+	}
+}
+
+func TestLightClientCommit_NumCommitted(t *testing.T) {
+	commit := generateLightClientCommit(11)
+	require.Equal(t, 1, commit.NumCommitted())
+
+	commit.Signatures[1].BlockIDFlag = math.U64(types.BlockIDFlagCommit)
+	require.Equal(t, 2, commit.NumCommitted())
+
+	commit.Signatures[0].BlockIDFlag = math.U64(types.BlockIDFlagNil)
+	require.Equal(t, 1, commit.NumCommitted())
+}
+
+func TestLightClientUpdate_MarshalUnmarshalSSZ(t *testing.T) {
+	update := &types.LightClientUpdate{
+		AttestedHeader: generateLightClientHeader(11),
+		NextValidators: []*types.Validator{
+			{
+				Pubkey:           crypto.BLSPubkey{1},
+				EffectiveBalance: math.Gwei(32e9),
+			},
+		},
+		NextValidatorsBranch: []common.Root{{1}, {2}},
+		FinalizedHeader:      generateLightClientHeader(10),
+		FinalityBranch:       []common.Root{{4}, {5}, {6}},
+		Commit:               generateLightClientCommit(11),
+		SigningValidators: []*types.Validator{
+			{
+				Pubkey:           crypto.BLSPubkey{1},
+				EffectiveBalance: math.Gwei(32e9),
+			},
+			{
+				Pubkey:           crypto.BLSPubkey{2},
+				EffectiveBalance: math.Gwei(16e9),
+			},
+		},
+		SignatureSlot: 12,
+	}
+
+	bz, err := update.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, int(update.SizeSSZ(false)))
+
+	decoded := (&types.LightClientUpdate{}).Empty()
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, update, decoded)
+	require.Equal(t, update.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t, math.Slot(11), decoded.GetSlot())
+}
+
+func TestLightClientFinalityUpdate_MarshalUnmarshalSSZ(t *testing.T) {
+	update := &types.LightClientFinalityUpdate{
+		AttestedHeader:  generateLightClientHeader(11),
+		FinalizedHeader: generateLightClientHeader(10),
+		FinalityBranch:  []common.Root{{4}, {5}, {6}},
+		Commit:          generateLightClientCommit(11),
+		SignatureSlot:   12,
+	}
+
+	bz, err := update.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, int(update.SizeSSZ(false)))
+
+	decoded := &types.LightClientFinalityUpdate{}
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, update, decoded)
+	require.Equal(t, update.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t, math.Slot(11), decoded.GetSlot())
+}
+
+func TestLightClientFinalityUpdate_ToOptimisticUpdate(t *testing.T) {
+	update := &types.LightClientFinalityUpdate{
+		AttestedHeader:  generateLightClientHeader(11),
+		FinalizedHeader: generateLightClientHeader(10),
+		Commit:          generateLightClientCommit(11),
+		SignatureSlot:   12,
+	}
+
+	optimistic := update.ToOptimisticUpdate()
+	require.Equal(t, update.AttestedHeader, optimistic.AttestedHeader)
+	require.Equal(t, update.Commit, optimistic.Commit)
+	require.Equal(t, update.SignatureSlot, optimistic.SignatureSlot)
+	require.Equal(t, math.Slot(11), optimistic.GetSlot())
+
+	bz, err := optimistic.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, int(optimistic.SizeSSZ(false)))
+
+	decoded := &types.LightClientOptimisticUpdate{}
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, optimistic, decoded)
+}
@@ -24,11 +24,14 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
 
 // BeaconState represents the entire state of the beacon chain.
+//
+//nolint:lll // tags get long
 type BeaconState[
 	BeaconBlockHeaderT constraints.
 		StaticSSZField[BeaconBlockHeaderT, B],
@@ -43,34 +46,40 @@ type BeaconState[
 	B, E, P, F, V any,
 ] struct {
 	// Versioning
-	GenesisValidatorsRoot common.Root
-	Slot                  math.Slot
-	Fork                  ForkT
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+	Slot                  math.Slot   `json:"slot"`
+	Fork                  ForkT       `json:"fork"`
 
 	// History
-	LatestBlockHeader BeaconBlockHeaderT
-	BlockRoots        []common.Root
-	StateRoots        []common.Root
+	LatestBlockHeader BeaconBlockHeaderT `json:"latest_block_header"`
+	BlockRoots        []common.Root      `json:"block_roots"`
+	StateRoots        []common.Root      `json:"state_roots"`
 
 	// Eth1
-	Eth1Data                     Eth1DataT
-	Eth1DepositIndex             uint64
-	LatestExecutionPayloadHeader ExecutionPayloadHeaderT
+	Eth1Data                     Eth1DataT               `json:"eth1_data"`
+	Eth1DepositIndex             math.U64                `json:"eth1_deposit_index"`
+	LatestExecutionPayloadHeader ExecutionPayloadHeaderT `json:"latest_execution_payload_header"`
 
 	// Registry
-	Validators []ValidatorT
-	Balances   []uint64
+	Validators []ValidatorT `json:"validators"`
+	Balances   []math.Gwei  `json:"balances"`
 
 	// Randomness
-	RandaoMixes []common.Bytes32
+	RandaoMixes []common.Bytes32 `json:"randao_mixes"`
 
 	// Withdrawals
-	NextWithdrawalIndex          uint64
-	NextWithdrawalValidatorIndex math.ValidatorIndex
+	NextWithdrawalIndex          math.U64            `json:"next_withdrawal_index"`
+	NextWithdrawalValidatorIndex math.ValidatorIndex `json:"next_withdrawal_validator_index"`
 
 	// Slashing
-	Slashings     []math.Gwei
-	TotalSlashing math.Gwei
+	Slashings     []math.Gwei `json:"slashings"`
+	TotalSlashing math.Gwei   `json:"total_slashing"`
+
+	// Deposit requests, from Electra on.
+	DepositRequestsStartIndex math.U64 `json:"deposit_requests_start_index"`
+
+	// forkVersion is the fork version the state is encoded for.
+	forkVersion uint32
 }
 
 // New creates a new BeaconState.
@@ -82,7 +91,7 @@ func (st *BeaconState[
 	ValidatorT,
 	B, E, P, F, V,
 ]) New(
-	_ uint32,
+	forkVersion uint32,
 	genesisValidatorsRoot common.Root,
 	slot math.Slot,
 	fork ForkT,
@@ -99,6 +108,7 @@ func (st *BeaconState[
 	nextWithdrawalValidatorIndex math.ValidatorIndex,
 	slashings []math.Gwei,
 	totalSlashing math.Gwei,
+	depositRequestsStartIndex uint64,
 ) (*BeaconState[
 	BeaconBlockHeaderT,
 	Eth1DataT,
@@ -107,6 +117,10 @@ func (st *BeaconState[
 	ValidatorT,
 	B, E, P, F, V,
 ], error) {
+	gweis := make([]math.Gwei, len(balances))
+	for i, balance := range balances {
+		gweis[i] = math.Gwei(balance)
+	}
 	return &BeaconState[
 		BeaconBlockHeaderT,
 		Eth1DataT,
@@ -123,17 +137,65 @@ func (st *BeaconState[
 		StateRoots:                   stateRoots,
 		LatestExecutionPayloadHeader: latestExecutionPayloadHeader,
 		Eth1Data:                     eth1Data,
-		Eth1DepositIndex:             eth1DepositIndex,
+		Eth1DepositIndex:             math.U64(eth1DepositIndex),
 		Validators:                   validators,
-		Balances:                     balances,
+		Balances:                     gweis,
 		RandaoMixes:                  randaoMixes,
-		NextWithdrawalIndex:          nextWithdrawalIndex,
+		NextWithdrawalIndex:          math.U64(nextWithdrawalIndex),
 		NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
 		Slashings:                    slashings,
 		TotalSlashing:                totalSlashing,
+		DepositRequestsStartIndex:    math.U64(depositRequestsStartIndex),
+		forkVersion:                  forkVersion,
 	}, nil
 }
 
+// NewFromSSZ returns a new BeaconState of the given fork version from its SSZ
+// encoding.
+func (st *BeaconState[
+	BeaconBlockHeaderT,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	ForkT,
+	ValidatorT,
+	B, E, P, F, V,
+]) NewFromSSZ(
+	bz []byte,
+	forkVersion uint32,
+) (*BeaconState[
+	BeaconBlockHeaderT,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	ForkT,
+	ValidatorT,
+	B, E, P, F, V,
+], error) {
+	st = &BeaconState[
+		BeaconBlockHeaderT,
+		Eth1DataT,
+		ExecutionPayloadHeaderT,
+		ForkT,
+		ValidatorT,
+		B, E, P, F, V,
+	]{forkVersion: forkVersion}
+	return st, st.UnmarshalSSZ(bz)
+}
+
+// Version returns the fork version the state is encoded for.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) Version() uint32 {
+	return st.forkVersion
+}
+
+// isElectra returns whether the state is encoded for Electra or later, i.e.
+// whether it holds the deposit requests start index.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) isElectra() bool {
+	return st.forkVersion >= version.Electra
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
@@ -143,6 +205,9 @@ func (st *BeaconState[
 	_, _, _, _, _, _, _, _, _, _,
 ]) SizeSSZ(fixed bool) uint32 {
 	var size uint32 = 300
+	if st.isElectra() {
+		size += 8
+	}
 
 	if fixed {
 		return size
@@ -196,6 +261,11 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sOffset(codec, &st.Slashings, 1099511627776)
 	ssz.DefineUint64(codec, (*uint64)(&st.TotalSlashing))
 
+	// Deposit requests
+	if st.isElectra() {
+		ssz.DefineUint64(codec, &st.DepositRequestsStartIndex)
+	}
+
 	// Dynamic content
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.BlockRoots, 8192)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.StateRoots, 8192)
@@ -308,7 +378,7 @@ func (st *BeaconState[
 	}
 
 	// Field (7) 'Eth1DepositIndex'
-	hh.PutUint64(st.Eth1DepositIndex)
+	hh.PutUint64(st.Eth1DepositIndex.Unwrap())
 
 	// Field (8) 'LatestExecutionPayloadHeader'
 	if st.LatestExecutionPayloadHeader == nil {
@@ -341,7 +411,7 @@ func (st *BeaconState[
 	}
 	subIndx = hh.Index()
 	for _, i := range st.Balances {
-		hh.AppendUint64(i)
+		hh.AppendUint64(i.Unwrap())
 	}
 	hh.FillUpTo32()
 	numItems = uint64(len(st.Balances))
@@ -363,7 +433,7 @@ func (st *BeaconState[
 	hh.MerkleizeWithMixin(subIndx, numItems, 65536)
 
 	// Field (12) 'NextWithdrawalIndex'
-	hh.PutUint64(st.NextWithdrawalIndex)
+	hh.PutUint64(st.NextWithdrawalIndex.Unwrap())
 
 	// Field (13) 'NextWithdrawalValidatorIndex'
 	hh.PutUint64(uint64(st.NextWithdrawalValidatorIndex))
@@ -391,6 +461,11 @@ func (st *BeaconState[
 	// Field (15) 'TotalSlashing'
 	hh.PutUint64(uint64(st.TotalSlashing))
 
+	// Field (16) 'DepositRequestsStartIndex'
+	if st.isElectra() {
+		hh.PutUint64(st.DepositRequestsStartIndex.Unwrap())
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
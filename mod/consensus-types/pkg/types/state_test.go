@@ -27,12 +27,14 @@
 package types_test
 
 import (
+	"encoding/json"
 	"io"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	karalabessz "github.com/karalabe/ssz"
 	"github.com/stretchr/testify/require"
 )
@@ -98,7 +100,7 @@ func generateValidBeaconState() *types.BeaconState[
 				WithdrawableEpoch:          6,
 			},
 		},
-		Balances:                     []uint64{32000000000, 31000000000},
+		Balances:                     []math.Gwei{32000000000, 31000000000},
 		RandaoMixes:                  generateRandomBytes32(65536),
 		Slashings:                    []math.Gwei{1000000000, 2000000000},
 		NextWithdrawalIndex:          7,
@@ -137,6 +139,53 @@ func generateValidBeaconState() *types.BeaconState[
 	}
 }
 
+// generateValidElectraBeaconState generates a valid Electra beacon state with
+// the given deposit requests start index.
+func generateValidElectraBeaconState(
+	t *testing.T,
+	depositRequestsStartIndex uint64,
+) *types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+] {
+	t.Helper()
+	st := generateValidBeaconState()
+	balances := make([]uint64, len(st.Balances))
+	for i, balance := range st.Balances {
+		balances[i] = balance.Unwrap()
+	}
+	st, err := st.New(
+		version.Electra,
+		st.GenesisValidatorsRoot,
+		st.Slot,
+		st.Fork,
+		st.LatestBlockHeader,
+		st.BlockRoots,
+		st.StateRoots,
+		st.Eth1Data,
+		st.Eth1DepositIndex.Unwrap(),
+		st.LatestExecutionPayloadHeader,
+		st.Validators,
+		balances,
+		st.RandaoMixes,
+		st.NextWithdrawalIndex.Unwrap(),
+		st.NextWithdrawalValidatorIndex,
+		st.Slashings,
+		st.TotalSlashing,
+		depositRequestsStartIndex,
+	)
+	require.NoError(t, err)
+	return st
+}
+
 func generateRandomBytes32(count int) []common.Bytes32 {
 	result := make([]common.Bytes32, count)
 	for i := range result {
@@ -239,3 +288,57 @@ func TestBeaconState_HashTreeRoot(t *testing.T) {
 		"HashTreeRoot and HashSequential should produce the same result",
 	)
 }
+
+func TestBeaconState_MarshalJSON(t *testing.T) {
+	state := generateValidBeaconState()
+
+	bz, err := json.Marshal(state)
+	require.NoError(t, err)
+
+	// Integers are encoded as quoted strings rather than JSON numbers.
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	require.Equal(t, "0x64", fields["eth1_deposit_index"])
+	require.Equal(t, "0x7", fields["next_withdrawal_index"])
+	require.Equal(t,
+		[]any{"0x773594000", "0x737be7600"}, fields["balances"],
+	)
+}
+
+func TestBeaconState_Electra(t *testing.T) {
+	deneb := generateValidBeaconState()
+	electra := generateValidElectraBeaconState(t, 7)
+
+	// The deposit requests start index is a field of the state from Electra
+	// on only.
+	require.Equal(t, uint32(300), deneb.SizeSSZ(true))
+	require.Equal(t, uint32(308), electra.SizeSSZ(true))
+
+	data, err := electra.MarshalSSZ()
+	require.NoError(t, err)
+	decoded, err := electra.NewFromSSZ(data, version.Electra)
+	require.NoError(t, err)
+	require.Equal(t, electra, decoded)
+	_, err = deneb.NewFromSSZ(data, version.Deneb)
+	require.Error(t, err)
+
+	// FastSSZ and karalabe/ssz agree on the root of the Electra state.
+	root := electra.HashTreeRoot()
+	require.Equal(t, root, common.Root(karalabessz.HashSequential(electra)))
+	tree, err := electra.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, root, common.NewRootFromBytes(tree.Hash()))
+	require.NotEqual(t, deneb.HashTreeRoot(), root)
+}
+
+func TestBeaconState_DepositRequestsStartIndexChangesRoot(t *testing.T) {
+	st := generateValidElectraBeaconState(t, 7)
+	root := st.HashTreeRoot()
+
+	st.DepositRequestsStartIndex = 8
+	require.NotEqual(t, root, st.HashTreeRoot())
+
+	tree, err := st.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, st.HashTreeRoot(), common.NewRootFromBytes(tree.Hash()))
+}
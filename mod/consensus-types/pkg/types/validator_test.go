@@ -1008,3 +1008,23 @@ func TestValidator_GetEffectiveBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestValidators_MarshalSSZ(t *testing.T) {
+	vals := types.Validators{
+		{Pubkey: [48]byte{0x01}, EffectiveBalance: 32e9},
+		{Pubkey: [48]byte{0x02}, EffectiveBalance: 31e9, Slashed: true},
+	}
+
+	bz, err := vals.MarshalSSZ()
+	require.NoError(t, err)
+
+	// A list of static objects is encoded as the concatenation of the
+	// encodings of its elements.
+	var expected []byte
+	for _, val := range vals {
+		valBz, valErr := val.MarshalSSZ()
+		require.NoError(t, valErr)
+		expected = append(expected, valBz...)
+	}
+	require.Equal(t, expected, bz)
+}
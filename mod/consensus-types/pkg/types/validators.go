@@ -51,6 +51,12 @@ func (vs Validators) DefineSSZ(c *ssz.Codec) {
 	})
 }
 
+// MarshalSSZ marshals the Validators object to SSZ format.
+func (vs Validators) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(vs))
+	return buf, ssz.EncodeToBytes(buf, vs)
+}
+
 // HashTreeRoot returns the SSZ hash tree root for the Validators object.
 func (vs Validators) HashTreeRoot() common.Root {
 	return ssz.HashSequential(vs)
@@ -0,0 +1,304 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// VoluntaryExitSize is the size of the SSZ encoding of a VoluntaryExit.
+	VoluntaryExitSize = 16 // 8 + 8
+
+	// SignedVoluntaryExitSize is the size of the SSZ encoding of a
+	// SignedVoluntaryExit.
+	SignedVoluntaryExitSize = 112 // 16 + 96
+)
+
+// Compile-time assertions to ensure the voluntary exit types implement the
+// necessary interfaces.
+var (
+	_ ssz.StaticObject                    = (*VoluntaryExit)(nil)
+	_ constraints.SSZMarshallableRootable = (*VoluntaryExit)(nil)
+	_ ssz.StaticObject                    = (*SignedVoluntaryExit)(nil)
+	_ constraints.SSZMarshallableRootable = (*SignedVoluntaryExit)(nil)
+)
+
+// VoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntaryexit
+//
+//nolint:lll
+type VoluntaryExit struct {
+	// Epoch is the earliest epoch at which the exit can be processed.
+	Epoch math.Epoch `json:"epoch"`
+	// ValidatorIndex is the index of the exiting validator.
+	ValidatorIndex math.ValidatorIndex `json:"validator_index"`
+}
+
+// SignedVoluntaryExit is a VoluntaryExit signed by the exiting validator.
+type SignedVoluntaryExit struct {
+	// Message is the voluntary exit.
+	Message *VoluntaryExit `json:"message"`
+	// Signature is the signature of the validator over the message.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// CreateAndSignVoluntaryExit constructs and signs a voluntary exit for the
+// validator at the given index.
+func CreateAndSignVoluntaryExit(
+	forkData *ForkData,
+	domainType common.DomainType,
+	signer crypto.BLSSigner,
+	epoch math.Epoch,
+	validatorIndex math.ValidatorIndex,
+) (*SignedVoluntaryExit, error) {
+	exit := &VoluntaryExit{
+		Epoch:          epoch,
+		ValidatorIndex: validatorIndex,
+	}
+	signingRoot := ComputeSigningRoot(
+		exit, forkData.ComputeDomain(domainType),
+	)
+	signature, err := signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	return &SignedVoluntaryExit{
+		Message:   exit,
+		Signature: signature,
+	}, nil
+}
+
+// Empty creates an empty SignedVoluntaryExit.
+func (*SignedVoluntaryExit) Empty() *SignedVoluntaryExit {
+	return &SignedVoluntaryExit{Message: new(VoluntaryExit)}
+}
+
+// VerifySignature verifies that the voluntary exit was signed by the given
+// validator public key.
+func (e *SignedVoluntaryExit) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		e.Message, forkData.ComputeDomain(domainType),
+	)
+	if err := signatureVerificationFn(
+		pubkey, signingRoot[:], e.Signature,
+	); err != nil {
+		return errors.Join(err, ErrVoluntaryExitSignature)
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the SSZ encoded size of the VoluntaryExit object.
+func (*VoluntaryExit) SizeSSZ() uint32 {
+	return VoluntaryExitSize
+}
+
+// DefineSSZ defines the SSZ encoding for the VoluntaryExit object.
+func (e *VoluntaryExit) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineUint64(c, &e.Epoch)
+	ssz.DefineUint64(c, &e.ValidatorIndex)
+}
+
+// MarshalSSZ marshals the VoluntaryExit object to SSZ format.
+func (e *VoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the VoluntaryExit object from SSZ format.
+func (e *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// HashTreeRoot computes the Merkleization of the VoluntaryExit object.
+func (e *VoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// SizeSSZ returns the SSZ encoded size of the SignedVoluntaryExit object.
+func (*SignedVoluntaryExit) SizeSSZ() uint32 {
+	return SignedVoluntaryExitSize
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedVoluntaryExit object.
+func (e *SignedVoluntaryExit) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticObject(c, &e.Message)
+	ssz.DefineStaticBytes(c, &e.Signature)
+}
+
+// MarshalSSZ marshals the SignedVoluntaryExit object to SSZ format.
+func (e *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the SignedVoluntaryExit object from SSZ format.
+func (e *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// HashTreeRoot computes the Merkleization of the SignedVoluntaryExit object.
+func (e *SignedVoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the VoluntaryExit object into a pre-allocated byte
+// slice.
+func (e *VoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := e.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, bz...), nil
+}
+
+// HashTreeRootWith ssz hashes the VoluntaryExit object with a hasher.
+func (e *VoluntaryExit) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(uint64(e.Epoch))
+
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(uint64(e.ValidatorIndex))
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the VoluntaryExit object.
+func (e *VoluntaryExit) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(e)
+}
+
+// MarshalSSZTo marshals the SignedVoluntaryExit object into a pre-allocated
+// byte slice.
+func (e *SignedVoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := e.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, bz...), nil
+}
+
+// HashTreeRootWith ssz hashes the SignedVoluntaryExit object with a hasher.
+func (e *SignedVoluntaryExit) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if e.Message == nil {
+		e.Message = new(VoluntaryExit)
+	}
+	if err := e.Message.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(e.Signature[:])
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the SignedVoluntaryExit object.
+func (e *SignedVoluntaryExit) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(e)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             Getters and Setters                            */
+/* -------------------------------------------------------------------------- */
+
+// GetEpoch returns the epoch at which the exit can be processed.
+func (e *SignedVoluntaryExit) GetEpoch() math.Epoch {
+	return e.Message.Epoch
+}
+
+// GetValidatorIndex returns the index of the exiting validator.
+func (e *SignedVoluntaryExit) GetValidatorIndex() math.ValidatorIndex {
+	return e.Message.ValidatorIndex
+}
+
+// GetSignature returns the signature over the voluntary exit.
+func (e *SignedVoluntaryExit) GetSignature() crypto.BLSSignature {
+	return e.Signature
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    List                                    */
+/* -------------------------------------------------------------------------- */
+
+// VoluntaryExits is a typealias for a list of SignedVoluntaryExits.
+type VoluntaryExits []*SignedVoluntaryExit
+
+// SizeSSZ returns the SSZ encoded size in bytes for the VoluntaryExits.
+func (ve VoluntaryExits) SizeSSZ(bool) uint32 {
+	return ssz.SizeSliceOfStaticObjects(([]*SignedVoluntaryExit)(ve))
+}
+
+// DefineSSZ defines the SSZ encoding for the VoluntaryExits object.
+func (ve VoluntaryExits) DefineSSZ(c *ssz.Codec) {
+	c.DefineDecoder(func(*ssz.Decoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*SignedVoluntaryExit)(&ve),
+			constants.MaxVoluntaryExitsPerBlock,
+		)
+	})
+	c.DefineEncoder(func(*ssz.Encoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*SignedVoluntaryExit)(&ve),
+			constants.MaxVoluntaryExitsPerBlock,
+		)
+	})
+	c.DefineHasher(func(*ssz.Hasher) {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			c, (*[]*SignedVoluntaryExit)(&ve),
+			constants.MaxVoluntaryExitsPerBlock,
+		)
+	})
+}
+
+// HashTreeRoot returns the hash tree root of the VoluntaryExits.
+func (ve VoluntaryExits) HashTreeRoot() common.Root {
+	return ssz.HashSequential(ve)
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	types "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAndSignVoluntaryExit(t *testing.T) {
+	forkData := types.NewForkData(
+		common.Version{0x04, 0x00, 0x00, 0x00}, common.Root{0x01},
+	)
+	domainType := common.DomainType{0x04, 0x00, 0x00, 0x00}
+	signature := crypto.BLSSignature{0x02}
+
+	// The signature must cover the signing root of the exit message.
+	expectedRoot := types.ComputeSigningRoot(
+		&types.VoluntaryExit{Epoch: 3, ValidatorIndex: 7},
+		forkData.ComputeDomain(domainType),
+	)
+	signer := &mocks.BLSSigner{}
+	signer.On("Sign", expectedRoot[:]).Return(signature, nil)
+
+	exit, err := types.CreateAndSignVoluntaryExit(
+		forkData, domainType, signer, 3, 7,
+	)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(3), exit.GetEpoch())
+	require.Equal(t, math.ValidatorIndex(7), exit.GetValidatorIndex())
+	require.Equal(t, signature, exit.GetSignature())
+
+	pubkey := crypto.BLSPubkey{0x03}
+	require.NoError(t, exit.VerifySignature(
+		forkData, domainType, pubkey,
+		func(pk crypto.BLSPubkey, msg []byte, sig crypto.BLSSignature) error {
+			require.Equal(t, pubkey, pk)
+			require.Equal(t, expectedRoot[:], msg)
+			require.Equal(t, signature, sig)
+			return nil
+		},
+	))
+
+	err = exit.VerifySignature(
+		forkData, domainType, pubkey,
+		func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error {
+			return errors.New("bad signature")
+		},
+	)
+	require.ErrorIs(t, err, types.ErrVoluntaryExitSignature)
+}
+
+func TestCreateAndSignVoluntaryExit_SignError(t *testing.T) {
+	signer := &mocks.BLSSigner{}
+	signer.On("Sign", mock.Anything).
+		Return(crypto.BLSSignature{}, errors.New("sign error"))
+
+	_, err := types.CreateAndSignVoluntaryExit(
+		&types.ForkData{}, common.DomainType{}, signer, 0, 0,
+	)
+	require.Error(t, err)
+}
+
+func TestSignedVoluntaryExit_MarshalUnmarshalSSZ(t *testing.T) {
+	original := &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{
+			Epoch:          10,
+			ValidatorIndex: 20,
+		},
+		Signature: crypto.BLSSignature{0x01, 0x02},
+	}
+
+	data, err := original.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, types.SignedVoluntaryExitSize)
+
+	unmarshalled := new(types.SignedVoluntaryExit).Empty()
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, original, unmarshalled)
+}
+
+func TestSignedVoluntaryExit_HashTreeRoot(t *testing.T) {
+	exit := &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{
+			Epoch:          10,
+			ValidatorIndex: 20,
+		},
+		Signature: crypto.BLSSignature{0x01, 0x02},
+	}
+
+	// The hash tree root must match the one computed by fastssz, which is
+	// used to build the block body tree.
+	tree, err := exit.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, common.Root(tree.Hash()), exit.HashTreeRoot())
+}
+
+func TestVoluntaryExits_HashTreeRoot(t *testing.T) {
+	body := new(types.BeaconBlockBody).Empty(version.Electra)
+	body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+	body.SetVoluntaryExits([]*types.SignedVoluntaryExit{
+		{Message: &types.VoluntaryExit{Epoch: 1, ValidatorIndex: 2}},
+		{Message: &types.VoluntaryExit{Epoch: 3, ValidatorIndex: 4}},
+	})
+
+	// The voluntary exits follow the Deneb fields of the block body.
+	roots := body.GetTopLevelRoots()
+	require.Len(t, roots, int(types.BodyLengthElectra))
+	require.Equal(
+		t,
+		types.VoluntaryExits(body.GetVoluntaryExits()).HashTreeRoot(),
+		roots[types.BodyLengthDeneb],
+	)
+
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, common.Root(tree.Hash()), body.HashTreeRoot())
+}
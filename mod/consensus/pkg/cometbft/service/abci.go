@@ -182,7 +182,7 @@ func (s *Service[LoggerT]) Info(
 // PrepareProposal implements the PrepareProposal ABCI method and returns a
 // ResponsePrepareProposal object to the client.
 func (s *Service[LoggerT]) PrepareProposal(
-	_ context.Context,
+	ctx context.Context,
 	req *cmtabci.PrepareProposalRequest,
 ) (*cmtabci.PrepareProposalResponse, error) {
 	// CometBFT must never call PrepareProposal with a height of 0.
@@ -219,10 +219,14 @@ func (s *Service[LoggerT]) PrepareProposal(
 		// prevBlock.GetTime() + h.minPayloadDelay
 		req.GetTime(),
 	)
+	ctx, endSpan := s.telemetrySink.StartSpan(
+		ctx, "cometbft.PrepareProposal", "slot", req.GetHeight(),
+	)
 	blkBz, sidecarsBz, err := s.Middleware.PrepareProposal(
-		s.prepareProposalState.Context(),
+		s.prepareProposalState.Context().WithContext(ctx),
 		slotData,
 	)
+	endSpan(err)
 	if err != nil {
 		s.logger.Error(
 			"failed to prepare proposal",
@@ -244,7 +248,7 @@ func (s *Service[LoggerT]) PrepareProposal(
 // ProcessProposal implements the ProcessProposal ABCI method and returns a
 // ResponseProcessProposal object to the client.
 func (s *Service[LoggerT]) ProcessProposal(
-	_ context.Context,
+	ctx context.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
 	// CometBFT must never call ProcessProposal with a height of 0.
@@ -275,10 +279,14 @@ func (s *Service[LoggerT]) ProcessProposal(
 		),
 	)
 
+	ctx, endSpan := s.telemetrySink.StartSpan(
+		ctx, "cometbft.ProcessProposal", "slot", req.GetHeight(),
+	)
 	resp, err := s.Middleware.ProcessProposal(
-		s.processProposalState.Context(),
+		s.processProposalState.Context().WithContext(ctx),
 		req,
 	)
+	endSpan(err)
 	if err != nil {
 		s.logger.Error(
 			"failed to process proposal",
@@ -300,6 +308,7 @@ func (s *Service[LoggerT]) ProcessProposal(
 }
 
 func (s *Service[LoggerT]) internalFinalizeBlock(
+	ctx context.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
 	if err := s.validateFinalizeBlockHeight(req); err != nil {
@@ -331,10 +340,14 @@ func (s *Service[LoggerT]) internalFinalizeBlock(
 		})
 	}
 
+	ctx, endSpan := s.telemetrySink.StartSpan(
+		ctx, "cometbft.FinalizeBlock", "slot", req.GetHeight(),
+	)
 	finalizeBlock, err := s.Middleware.FinalizeBlock(
-		s.finalizeBlockState.Context(),
+		s.finalizeBlockState.Context().WithContext(ctx),
 		req,
 	)
+	endSpan(err)
 	if err != nil {
 		return nil, err
 	}
@@ -395,10 +408,10 @@ func (s *Service[_]) validateFinalizeBlockHeight(
 }
 
 func (s *Service[_]) FinalizeBlock(
-	_ context.Context,
+	ctx context.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
-	res, err := s.internalFinalizeBlock(req)
+	res, err := s.internalFinalizeBlock(ctx, req)
 	if res != nil {
 		res.AppHash = s.workingHash()
 	}
@@ -432,6 +445,10 @@ func (s *Service[LoggerT]) Commit(
 
 	s.finalizeBlockState = nil
 
+	// The snapshot manager is nil-safe and takes the snapshot in the
+	// background, so this never blocks the commit.
+	s.snapshotManager.SnapshotIfApplicable(header.Height)
+
 	return &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
 	}, nil
@@ -614,6 +631,18 @@ func (s *Service[_]) GetBlockRetentionHeight(commitHeight int64) int64 {
 	v := commitHeight - int64(s.minRetainBlocks)
 	retentionHeight = minNonZero(retentionHeight, v)
 
+	// Keep the blocks needed by nodes restoring from the oldest snapshot we
+	// still serve.
+	if s.snapshotManager != nil {
+		snapshotRetentionHeights := s.snapshotManager.
+			GetSnapshotBlockRetentionHeights()
+		if snapshotRetentionHeights > 0 {
+			retentionHeight = minNonZero(
+				retentionHeight, commitHeight-snapshotRetentionHeights,
+			)
+		}
+	}
+
 	if retentionHeight <= 0 {
 		// prune nothing in the case of a non-positive height
 		return 0
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// errCommitNotFound is returned when the commit of a block is not stored.
+var errCommitNotFound = errors.New("commit not found")
+
+// BlockCommit returns the commit of the block at the given height. It is
+// stored along with the block at the next height, which carries it.
+func (s *Service[_]) BlockCommit(
+	height int64,
+) (*ctypes.LightClientCommit, error) {
+	if s.node == nil {
+		return nil, fmt.Errorf("%w: height %d", errCommitNotFound, height)
+	}
+	commit := s.node.BlockStore().LoadBlockCommit(height)
+	if commit == nil {
+		return nil, fmt.Errorf("%w: height %d", errCommitNotFound, height)
+	}
+	return newLightClientCommit(commit)
+}
+
+// newLightClientCommit returns the light client commit of the given CometBFT
+// commit.
+func newLightClientCommit(
+	commit *cmttypes.Commit,
+) (*ctypes.LightClientCommit, error) {
+	signatures := make([]*ctypes.CommitSignature, len(commit.Signatures))
+	for i, sig := range commit.Signatures {
+		signature := &ctypes.CommitSignature{
+			BlockIDFlag: math.U64(sig.BlockIDFlag),
+		}
+		if sig.BlockIDFlag == cmttypes.BlockIDFlagAbsent {
+			signatures[i] = signature
+			continue
+		}
+
+		var err error
+		if signature.ValidatorAddress, err = bytes.ToBytes20(
+			sig.ValidatorAddress,
+		); err != nil {
+			return nil, err
+		}
+		if signature.Signature, err = bytes.ToBytes96(
+			sig.Signature,
+		); err != nil {
+			return nil, err
+		}
+		//#nosec:G701 // votes are not timestamped before the Unix epoch.
+		signature.Timestamp = math.U64(sig.Timestamp.UnixNano())
+		signatures[i] = signature
+	}
+
+	//#nosec:G701 // heights, rounds and part counts are never negative.
+	return &ctypes.LightClientCommit{
+		Height:       math.U64(commit.Height),
+		Round:        math.U64(commit.Round),
+		BlockHash:    common.NewRootFromBytes(commit.BlockID.Hash),
+		PartSetTotal: math.U64(commit.BlockID.PartSetHeader.Total),
+		PartSetHash: common.NewRootFromBytes(
+			commit.BlockID.PartSetHeader.Hash,
+		),
+		Signatures: signatures,
+	}, nil
+}
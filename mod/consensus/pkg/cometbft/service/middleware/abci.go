@@ -83,7 +83,13 @@ func (h *ABCIMiddleware[
 	BeaconBlockT, _, BlobSidecarsT, _, SlotDataT]) PrepareProposal(
 	ctx sdk.Context,
 	slotData SlotDataT,
-) ([]byte, []byte, error) {
+) (_ []byte, _ []byte, err error) {
+	spanCtx, endSpan := h.metrics.sink.StartSpan(
+		ctx, "middleware.PrepareProposal",
+	)
+	defer func() { endSpan(err) }()
+	ctx = ctx.WithContext(spanCtx)
+
 	var (
 		startTime        = time.Now()
 		awaitCtx, cancel = context.WithTimeout(ctx, AwaitTimeout)
@@ -103,7 +109,7 @@ func (h *ABCIMiddleware[
 			"num_msgs", numMsgs)
 	}
 
-	if err := h.dispatcher.Publish(
+	if err = h.dispatcher.Publish(
 		async.NewEvent(
 			ctx, async.NewSlot, slotData,
 		),
@@ -184,7 +190,13 @@ func (h *ABCIMiddleware[
 ]) ProcessProposal(
 	ctx sdk.Context,
 	req *cmtabci.ProcessProposalRequest,
-) (*cmtabci.ProcessProposalResponse, error) {
+) (_ *cmtabci.ProcessProposalResponse, err error) {
+	spanCtx, endSpan := h.metrics.sink.StartSpan(
+		ctx, "middleware.ProcessProposal",
+	)
+	defer func() { endSpan(err) }()
+	ctx = ctx.WithContext(spanCtx)
+
 	var (
 		startTime        = time.Now()
 		awaitCtx, cancel = context.WithTimeout(ctx, AwaitTimeout)
@@ -317,7 +329,13 @@ func (h *ABCIMiddleware[
 ]) FinalizeBlock(
 	ctx sdk.Context,
 	req *cmtabci.FinalizeBlockRequest,
-) (transition.ValidatorUpdates, error) {
+) (_ transition.ValidatorUpdates, err error) {
+	spanCtx, endSpan := h.metrics.sink.StartSpan(
+		ctx, "middleware.FinalizeBlock",
+	)
+	defer func() { endSpan(err) }()
+	ctx = ctx.WithContext(spanCtx)
+
 	awaitCtx, cancel := context.WithTimeout(ctx, AwaitTimeout)
 	defer cancel()
 	// flush the channel to ensure that we are not handling old data.
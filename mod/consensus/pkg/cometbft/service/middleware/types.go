@@ -21,6 +21,7 @@
 package middleware
 
 import (
+	"context"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
@@ -41,6 +42,10 @@ type BeaconBlock[BeaconBlockT any, BeaconBlockHeaderT any] interface {
 type TelemetrySink interface {
 	// MeasureSince measures the time since the given time.
 	MeasureSince(key string, start time.Time, args ...string)
+	// StartSpan starts a span, returning a function to end it.
+	StartSpan(
+		ctx context.Context, name string, args ...any,
+	) (context.Context, func(error))
 }
 
 type BlobSidecars[T any] interface {
@@ -22,8 +22,12 @@ package cometbft
 
 import (
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
+	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
 	"github.com/berachain/beacon-kit/mod/log"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
 
 // File for storing in-package cometbft optional functions,
@@ -81,3 +85,55 @@ func SetChainID[
 ](chainID string) func(*Service[LoggerT]) {
 	return func(s *Service[LoggerT]) { s.chainID = chainID }
 }
+
+// SetSnapshot sets the snapshot store and options used to take, serve and
+// restore state sync snapshots of the multistore. Snapshots are disabled if
+// the store is nil. A zero snapshot interval disables taking snapshots, but
+// the node can still restore from the snapshots offered by its peers.
+func SetSnapshot[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	snapshotStore *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		if snapshotStore == nil {
+			return
+		}
+		s.setSnapshotManager(snapshots.NewManager(
+			snapshotStore,
+			opts,
+			s.sm.CommitMultiStore(),
+			nil,
+			servercmtlog.WrapSDKLogger(s.logger),
+		))
+	}
+}
+
+// SetSnapshotRestoredHandler sets the handler called once the node has
+// restored a state sync snapshot, e.g. to bring the stores kept outside of
+// the multistore in line with the restored state.
+func SetSnapshotRestoredHandler[
+	LoggerT log.AdvancedLogger[LoggerT],
+](handler SnapshotRestoredHandler) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setSnapshotRestoredHandler(handler) }
+}
+
+// SetQueryHandlers sets the handlers of the ABCI queries, keyed by the path
+// they are served at.
+func SetQueryHandlers[
+	LoggerT log.AdvancedLogger[LoggerT],
+](handlers map[string]QueryHandler) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setQueryHandlers(handlers) }
+}
+
+// SetApplicationWrapper sets a function wrapping the ABCI application the
+// CometBFT node is started with, e.g. for an in-process network simulator to
+// observe and pace the calls made by consensus.
+func SetApplicationWrapper[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	wrap func(cmtabci.Application) cmtabci.Application,
+) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.wrapApplication = wrap }
+}
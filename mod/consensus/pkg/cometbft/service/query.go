@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+const (
+	// QueryParamEncoding is the query parameter selecting the encoding of
+	// the response value.
+	QueryParamEncoding = "encoding"
+	// QueryEncodingSSZ encodes the response value as SSZ. It is the default.
+	QueryEncodingSSZ = "ssz"
+	// QueryEncodingJSON encodes the response value as JSON.
+	QueryEncodingJSON = "json"
+
+	// queryErrorCode is the code of the responses to failed queries.
+	queryErrorCode uint32 = 1
+)
+
+var (
+	errUnknownQueryPath     = errors.New("unknown query path")
+	errUnknownEncoding      = errors.New("unknown encoding")
+	errNoSSZEncoding        = errors.New("value has no SSZ encoding")
+	errProofNotAvailable    = errors.New("proof not available for query")
+	errStoreNotQueryable    = errors.New("store does not support queries")
+	errQueryStoreNotMounted = errors.New("query store not mounted")
+	errQueryPanic           = errors.New("query panicked")
+)
+
+// QueryHandler handles the ABCI queries for a registered path. It is given a
+// context over the state at the queried height and the parameters of the
+// query path, and returns the value to respond with. If the value is stored
+// under a single key of the store, the key is returned as well so that a
+// proof can be served for it.
+type QueryHandler func(
+	ctx context.Context,
+	params url.Values,
+) (value any, key []byte, err error)
+
+// Query implements the ABCI interface. It routes the query to the handler
+// registered for its path, e.g. /beacon/state/validators?index=1, and
+// responds with the value at the requested height. If a proof is requested,
+// the response holds the value as stored along with its IAVL proof.
+func (s *Service[_]) Query(
+	_ context.Context,
+	req *cmtabci.QueryRequest,
+) (resp *cmtabci.QueryResponse, err error) {
+	// A failing query must not bring the node down.
+	defer func() {
+		if r := recover(); r != nil {
+			resp, err = queryErrorResponse(
+				req, fmt.Errorf("%w: %v", errQueryPanic, r),
+			), nil
+		}
+	}()
+
+	resp, err = s.handleQuery(req)
+	if err != nil {
+		return queryErrorResponse(req, err), nil
+	}
+	return resp, nil
+}
+
+// queryErrorResponse returns the response to a failed query.
+func queryErrorResponse(
+	req *cmtabci.QueryRequest,
+	err error,
+) *cmtabci.QueryResponse {
+	return &cmtabci.QueryResponse{
+		Code:   queryErrorCode,
+		Log:    err.Error(),
+		Height: req.Height,
+	}
+}
+
+// handleQuery runs the handler registered for the path of the query.
+func (s *Service[_]) handleQuery(
+	req *cmtabci.QueryRequest,
+) (*cmtabci.QueryResponse, error) {
+	path, rawParams, _ := strings.Cut(req.Path, "?")
+	handler, ok := s.queryHandlers[path]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnknownQueryPath, path)
+	}
+	params, err := url.ParseQuery(rawParams)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := s.CreateQueryContext(req.Height, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	height := req.Height
+	if height == 0 {
+		height = s.sm.CommitMultiStore().LatestVersion()
+	}
+
+	value, key, err := handler(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Prove {
+		return s.proveQuery(path, key, height)
+	}
+
+	bz, err := encodeQueryValue(value, params.Get(QueryParamEncoding))
+	if err != nil {
+		return nil, err
+	}
+	return &cmtabci.QueryResponse{
+		Key:    key,
+		Value:  bz,
+		Height: height,
+	}, nil
+}
+
+// proveQuery responds with the value stored under the given key along with
+// its proof against the app hash of the given height.
+func (s *Service[_]) proveQuery(
+	path string,
+	key []byte,
+	height int64,
+) (*cmtabci.QueryResponse, error) {
+	if key == nil {
+		return nil, fmt.Errorf(
+			"%w: %s does not resolve to a single stored value",
+			errProofNotAvailable, path,
+		)
+	}
+	if s.queryStoreKey == nil {
+		return nil, errQueryStoreNotMounted
+	}
+
+	queryable, ok := s.sm.CommitMultiStore().(storetypes.Queryable)
+	if !ok {
+		return nil, errStoreNotQueryable
+	}
+	res, err := queryable.Query(&storetypes.RequestQuery{
+		Path:   "/" + s.queryStoreKey.Name() + "/key",
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cmtabci.QueryResponse{
+		Key:      res.Key,
+		Value:    res.Value,
+		ProofOps: res.ProofOps,
+		Height:   res.Height,
+	}, nil
+}
+
+// encodeQueryValue encodes the value of a query response with the given
+// encoding, defaulting to SSZ.
+func encodeQueryValue(value any, encoding string) ([]byte, error) {
+	switch encoding {
+	case "", QueryEncodingSSZ:
+		marshaler, ok := value.(constraints.SSZMarshaler)
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w, use %s=%s", errNoSSZEncoding,
+				QueryParamEncoding, QueryEncodingJSON,
+			)
+		}
+		return marshaler.MarshalSSZ()
+	case QueryEncodingJSON:
+		return json.Marshal(value)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownEncoding, encoding)
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testQueryPath = "/test/value"
+
+// testQueryValue is a query value with an SSZ encoding.
+type testQueryValue []byte
+
+// MarshalSSZ returns the value as is.
+func (v testQueryValue) MarshalSSZ() ([]byte, error) {
+	return v, nil
+}
+
+// newQueryTestService returns a Service serving the value stored under the
+// key given by the index parameter of testQueryPath, with a few committed
+// heights.
+func newQueryTestService(t *testing.T) *Service[*phuslu.Logger] {
+	t.Helper()
+	s, key := newTestService(t, false)
+	s.queryStoreKey = key
+	SetQueryHandlers[*phuslu.Logger](map[string]QueryHandler{
+		testQueryPath: func(
+			ctx context.Context, params url.Values,
+		) (any, []byte, error) {
+			k := []byte(params.Get("index"))
+			store := sdk.UnwrapSDKContext(ctx).KVStore(key)
+			return testQueryValue(store.Get(k)), k, nil
+		},
+	})(s)
+	commitHeights(s, key, 3)
+	return s
+}
+
+func TestQuery(t *testing.T) {
+	s := newQueryTestService(t)
+	ctx := context.Background()
+
+	// The value is served at the latest height by default, and at the
+	// requested height otherwise.
+	resp, err := s.Query(ctx, &cmtabci.QueryRequest{
+		Path: testQueryPath + "?index=%02",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), resp.Code, resp.Log)
+	require.Equal(t, int64(3), resp.Height)
+	require.Equal(t, []byte{3}, resp.Value)
+
+	resp, err = s.Query(ctx, &cmtabci.QueryRequest{
+		Path:   testQueryPath + "?index=%02",
+		Height: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), resp.Code, resp.Log)
+	require.Empty(t, resp.Value)
+
+	resp, err = s.Query(ctx, &cmtabci.QueryRequest{
+		Path: testQueryPath + "?index=%01&encoding=json",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), resp.Code, resp.Log)
+	require.JSONEq(t, `"Ag=="`, string(resp.Value))
+}
+
+func TestQueryProve(t *testing.T) {
+	s := newQueryTestService(t)
+
+	resp, err := s.Query(context.Background(), &cmtabci.QueryRequest{
+		Path:   testQueryPath + "?index=%01",
+		Height: 2,
+		Prove:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), resp.Code, resp.Log)
+	require.Equal(t, int64(2), resp.Height)
+	require.Equal(t, []byte{1}, resp.Key)
+	require.Equal(t, []byte{2}, resp.Value)
+	require.NotNil(t, resp.ProofOps)
+	require.NotEmpty(t, resp.ProofOps.Ops)
+}
+
+func TestQueryErrors(t *testing.T) {
+	s := newQueryTestService(t)
+
+	for name, path := range map[string]string{
+		"unknown path":     "/test/unknown",
+		"unknown encoding": testQueryPath + "?index=%01&encoding=xml",
+		"invalid params":   testQueryPath + "?index=%zz",
+		"handler panic":    testQueryPath,
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := s.Query(
+				context.Background(),
+				&cmtabci.QueryRequest{Path: path},
+			)
+			require.NoError(t, err)
+			require.Equal(t, queryErrorCode, resp.Code)
+			require.NotEmpty(t, resp.Log)
+		})
+	}
+
+	resp, err := s.Query(context.Background(), &cmtabci.QueryRequest{
+		Path:   testQueryPath + "?index=%01",
+		Height: 4,
+	})
+	require.NoError(t, err)
+	require.Equal(t, queryErrorCode, resp.Code)
+}
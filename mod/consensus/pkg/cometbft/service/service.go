@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 
+	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/params"
@@ -32,12 +33,15 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -53,9 +57,10 @@ type Service[
 	node   *node.Node
 	cmtCfg *cmtcfg.Config
 
-	logger     LoggerT
-	sm         *statem.Manager
-	Middleware MiddlewareI
+	logger        LoggerT
+	sm            *statem.Manager
+	Middleware    MiddlewareI
+	telemetrySink TelemetrySink
 
 	// prepareProposalState is used for PrepareProposal, which is set based on
 	// the previous block's state. This state is never committed. In case of
@@ -78,11 +83,29 @@ type Service[
 	interBlockCache storetypes.MultiStorePersistentCache
 	paramStore      *params.ConsensusParamsStore
 
+	// snapshotManager takes periodic snapshots of the CommitMultiStore and
+	// serves and restores them for CometBFT state sync. It is nil when no
+	// snapshot store is configured.
+	snapshotManager *snapshots.Manager
+	// snapshotRestoredHandler is called once a snapshot has been restored,
+	// if set.
+	snapshotRestoredHandler SnapshotRestoredHandler
+
+	// queryHandlers are the handlers of the ABCI queries, keyed by path.
+	queryHandlers map[string]QueryHandler
+	// queryStoreKey is the key of the store that query proofs are served
+	// from.
+	queryStoreKey *storetypes.KVStoreKey
+
 	// initialHeight is the initial height at which we start the node
 	initialHeight   int64
 	minRetainBlocks uint64
 
 	chainID string
+
+	// wrapApplication wraps the ABCI application the CometBFT node is
+	// started with, if set.
+	wrapApplication func(cmtabci.Application) cmtabci.Application
 }
 
 func NewService[
@@ -94,6 +117,7 @@ func NewService[
 	middleware MiddlewareI,
 	cmtCfg *cmtcfg.Config,
 	cs common.ChainSpec,
+	telemetrySink TelemetrySink,
 	options ...func(*Service[LoggerT]),
 ) *Service[LoggerT] {
 	s := &Service[LoggerT]{
@@ -102,9 +126,11 @@ func NewService[
 			db,
 			servercmtlog.WrapSDKLogger(logger),
 		),
-		Middleware: middleware,
-		cmtCfg:     cmtCfg,
-		paramStore: params.NewConsensusParamsStore(cs),
+		Middleware:    middleware,
+		telemetrySink: telemetrySink,
+		cmtCfg:        cmtCfg,
+		paramStore:    params.NewConsensusParamsStore(cs),
+		queryStoreKey: storeKey,
 	}
 
 	s.MountStore(storeKey, storetypes.StoreTypeIAVL)
@@ -135,6 +161,11 @@ func (s *Service[_]) Start(
 		return err
 	}
 
+	var app cmtabci.Application = s
+	if s.wrapApplication != nil {
+		app = s.wrapApplication(app)
+	}
+
 	s.node, err = node.NewNode(
 		ctx,
 		cfg,
@@ -143,7 +174,7 @@ func (s *Service[_]) Start(
 			cfg.PrivValidatorStateFile(),
 		),
 		nodeKey,
-		proxy.NewLocalClientCreator(s),
+		proxy.NewLocalClientCreator(app),
 		GetGenDocProvider(cfg),
 		cmtcfg.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
@@ -156,6 +187,27 @@ func (s *Service[_]) Start(
 	return s.node.Start()
 }
 
+// Stop stops the CometBFT node, so that no further block is decided while
+// the services it drives are stopped. The application database is left open
+// until Close is called.
+func (s *Service[_]) Stop(ctx context.Context) error {
+	if s.node == nil || !s.node.IsRunning() {
+		return nil
+	}
+
+	s.logger.Info("Stopping CometBFT Node")
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.node.Stop()
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Close is called in start cmd to gracefully cleanup resources.
 func (s *Service[_]) Close() error {
 	var errs []error
@@ -166,6 +218,13 @@ func (s *Service[_]) Close() error {
 		_ = s.node.Stop()
 	}
 
+	if s.snapshotManager != nil {
+		s.logger.Info("Closing snapshot manager")
+		if err := s.snapshotManager.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	s.logger.Info("Closing application.db")
 	if err := s.sm.Close(); err != nil {
 		errs = append(errs, err)
@@ -207,10 +266,63 @@ func (s *Service[_]) LastBlockHeight() int64 {
 	return s.sm.CommitMultiStore().LastCommitID().Version
 }
 
+// PeersLastBlockHeight returns the greatest last committed block height
+// among the peers of the CometBFT node, 0 if it has no peers.
+func (s *Service[_]) PeersLastBlockHeight() int64 {
+	if s.node == nil {
+		return 0
+	}
+	var height int64
+	s.node.Switch().Peers().ForEach(func(peer p2p.Peer) {
+		// Peers are at the height they are deciding, one past the last
+		// block they committed.
+		ps, ok := peer.Get(cmttypes.PeerStateKey).(interface {
+			GetHeight() int64
+		})
+		if ok {
+			height = max(height, ps.GetHeight()-1)
+		}
+	})
+	return height
+}
+
+// BlockStore returns the block store of the CometBFT node, nil if the node
+// has not been started.
+func (s *Service[_]) BlockStore() *store.BlockStore {
+	if s.node == nil {
+		return nil
+	}
+	return s.node.BlockStore()
+}
+
+// IsCatchingUp returns true if the CometBFT node is not yet participating in
+// consensus, either because it has not started or because it is still
+// syncing blocks from its peers.
+func (s *Service[_]) IsCatchingUp() bool {
+	if s.node == nil || !s.node.IsRunning() {
+		return true
+	}
+	return s.node.ConsensusReactor().WaitSync()
+}
+
 func (s *Service[_]) setMinRetainBlocks(minRetainBlocks uint64) {
 	s.minRetainBlocks = minRetainBlocks
 }
 
+func (s *Service[_]) setQueryHandlers(handlers map[string]QueryHandler) {
+	s.queryHandlers = handlers
+}
+
+func (s *Service[_]) setSnapshotManager(manager *snapshots.Manager) {
+	s.snapshotManager = manager
+}
+
+func (s *Service[_]) setSnapshotRestoredHandler(
+	handler SnapshotRestoredHandler,
+) {
+	s.snapshotRestoredHandler = handler
+}
+
 func (s *Service[_]) setInterBlockCache(
 	cache storetypes.MultiStorePersistentCache,
 ) {
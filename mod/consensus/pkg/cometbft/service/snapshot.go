@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"errors"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SnapshotRestoredHandler is called once a state sync snapshot has been
+// restored, with a context over the restored state.
+type SnapshotRestoredHandler func(ctx context.Context) error
+
+// ListSnapshots implements the ABCI interface. It returns the snapshots held
+// by the snapshot manager that can be served to state syncing peers.
+func (s *Service[_]) ListSnapshots(
+	context.Context,
+	*cmtabci.ListSnapshotsRequest,
+) (*cmtabci.ListSnapshotsResponse, error) {
+	resp := &cmtabci.ListSnapshotsResponse{
+		Snapshots: []*cmtabci.Snapshot{},
+	}
+	if s.snapshotManager == nil {
+		return resp, nil
+	}
+
+	snapshots, err := s.snapshotManager.List()
+	if err != nil {
+		s.logger.Error("Failed to list snapshots", "error", err)
+		return nil, err
+	}
+
+	for _, snapshot := range snapshots {
+		abciSnapshot, err := snapshot.ToABCI()
+		if err != nil {
+			s.logger.Error(
+				"Failed to convert snapshot to ABCI",
+				"height", snapshot.Height,
+				"format", snapshot.Format,
+				"error", err,
+			)
+			return nil, err
+		}
+		resp.Snapshots = append(resp.Snapshots, &abciSnapshot)
+	}
+	return resp, nil
+}
+
+// LoadSnapshotChunk implements the ABCI interface. It returns the requested
+// chunk of a local snapshot.
+func (s *Service[_]) LoadSnapshotChunk(
+	_ context.Context,
+	req *cmtabci.LoadSnapshotChunkRequest,
+) (*cmtabci.LoadSnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		return &cmtabci.LoadSnapshotChunkResponse{}, nil
+	}
+
+	chunk, err := s.snapshotManager.LoadChunk(
+		req.Height, req.Format, req.Chunk,
+	)
+	if err != nil {
+		s.logger.Error(
+			"Failed to load snapshot chunk",
+			"height", req.Height,
+			"format", req.Format,
+			"chunk", req.Chunk,
+			"error", err,
+		)
+		return nil, err
+	}
+	return &cmtabci.LoadSnapshotChunkResponse{Chunk: chunk}, nil
+}
+
+// OfferSnapshot implements the ABCI interface. It begins restoring the
+// offered snapshot, which must be accepted before CometBFT starts sending its
+// chunks through ApplySnapshotChunk.
+func (s *Service[_]) OfferSnapshot(
+	_ context.Context,
+	req *cmtabci.OfferSnapshotRequest,
+) (*cmtabci.OfferSnapshotResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_ABORT,
+		}, nil
+	}
+
+	if req.Snapshot == nil {
+		s.logger.Error("Received nil snapshot")
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	}
+
+	snapshot, err := snapshottypes.SnapshotFromABCI(req.Snapshot)
+	if err != nil {
+		s.logger.Error("Failed to decode snapshot metadata", "error", err)
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+	}
+
+	err = s.snapshotManager.Restore(snapshot)
+	switch {
+	case err == nil:
+		s.logger.Info(
+			"Restoring snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"chunks", req.Snapshot.Chunks,
+		)
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_ACCEPT,
+		}, nil
+
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_REJECT_FORMAT,
+		}, nil
+
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		s.logger.Error(
+			"Rejecting invalid snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"error", err,
+		)
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_REJECT,
+		}, nil
+
+	default:
+		// CometBFT errors are defined here:
+		// https://github.com/cometbft/cometbft/blob/main/statesync/syncer.go
+		// It may happen that in case of a CometBFT error, such as a timeout
+		// while restoring, the state sync gets stuck. Aborting the restore
+		// lets the node fall back to block sync from genesis.
+		s.logger.Error(
+			"Failed to restore snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"error", err,
+		)
+		return &cmtabci.OfferSnapshotResponse{
+			Result: cmtabci.OFFER_SNAPSHOT_RESULT_ABORT,
+		}, nil
+	}
+}
+
+// ApplySnapshotChunk implements the ABCI interface. It applies a chunk of the
+// snapshot accepted in OfferSnapshot to the CommitMultiStore.
+func (s *Service[_]) ApplySnapshotChunk(
+	_ context.Context,
+	req *cmtabci.ApplySnapshotChunkRequest,
+) (*cmtabci.ApplySnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &cmtabci.ApplySnapshotChunkResponse{
+			Result: cmtabci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		}, nil
+	}
+
+	done, err := s.snapshotManager.RestoreChunk(req.Chunk)
+	switch {
+	case err == nil:
+		if done {
+			s.logger.Info(
+				"Restored snapshot",
+				"height", s.LastBlockHeight(),
+			)
+			s.handleSnapshotRestored()
+		}
+		return &cmtabci.ApplySnapshotChunkResponse{
+			Result: cmtabci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT,
+		}, nil
+
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		s.logger.Error(
+			"Chunk checksum mismatch; rejecting sender and requesting refetch",
+			"chunk", req.Index,
+			"sender", req.Sender,
+			"error", err,
+		)
+		return &cmtabci.ApplySnapshotChunkResponse{
+			Result:        cmtabci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}, nil
+
+	default:
+		s.logger.Error("Failed to restore snapshot chunk", "error", err)
+		return &cmtabci.ApplySnapshotChunkResponse{
+			Result: cmtabci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		}, nil
+	}
+}
+
+// handleSnapshotRestored calls the snapshot restored handler, if any, with a
+// context over the restored state. The snapshot is restored already, so a
+// failure of the handler is only logged.
+func (s *Service[_]) handleSnapshotRestored() {
+	if s.snapshotRestoredHandler == nil {
+		return
+	}
+	ctx := sdk.NewContext(
+		s.sm.CommitMultiStore().CacheMultiStore(),
+		false,
+		servercmtlog.WrapSDKLogger(s.logger),
+	)
+	if err := s.snapshotRestoredHandler(ctx); err != nil {
+		s.logger.Error("Failed to handle restored snapshot", "error", err)
+	}
+}
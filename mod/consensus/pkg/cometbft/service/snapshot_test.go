@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
+	statem "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/state"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testStoreKey = "beacon"
+
+// newTestService returns a Service backed by an in-memory database. If
+// withSnapshots is set, the Service takes, serves and restores snapshots from
+// a temporary snapshot store.
+func newTestService(
+	t *testing.T,
+	withSnapshots bool,
+) (*Service[*phuslu.Logger], *storetypes.KVStoreKey) {
+	t.Helper()
+	cfg := phuslu.DefaultConfig()
+	logger := phuslu.NewLogger(io.Discard, &cfg)
+	s := &Service[*phuslu.Logger]{
+		logger: logger,
+		sm: statem.NewManager(
+			dbm.NewMemDB(),
+			servercmtlog.WrapSDKLogger(logger),
+		),
+	}
+
+	key := storetypes.NewKVStoreKey(testStoreKey)
+	s.MountStore(key, storetypes.StoreTypeIAVL)
+
+	if withSnapshots {
+		snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+		require.NoError(t, err)
+		SetSnapshot[*phuslu.Logger](
+			snapshotStore, snapshottypes.NewSnapshotOptions(1, 2),
+		)(s)
+	}
+
+	require.NoError(t, s.sm.LoadLatestVersion())
+	return s, key
+}
+
+// commitHeights writes a key per height to the store of the Service and
+// commits it, for the given number of heights.
+func commitHeights(
+	s *Service[*phuslu.Logger],
+	key *storetypes.KVStoreKey,
+	numHeights int,
+) {
+	cms := s.CommitMultiStore()
+	for i := range numHeights {
+		cms.GetCommitKVStore(key).Set([]byte{byte(i)}, []byte{byte(i + 1)})
+		cms.Commit()
+	}
+}
+
+func TestSnapshotStateSync(t *testing.T) {
+	ctx := context.Background()
+
+	// The source node commits a few heights and takes a snapshot of the
+	// latest one.
+	source, sourceKey := newTestService(t, true)
+	commitHeights(source, sourceKey, 3)
+	//#nosec:G701 // height is always positive.
+	_, err := source.snapshotManager.Create(uint64(source.LastBlockHeight()))
+	require.NoError(t, err)
+
+	listResp, err := source.ListSnapshots(ctx, &cmtabci.ListSnapshotsRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResp.Snapshots, 1)
+	snapshot := listResp.Snapshots[0]
+	require.Equal(t, uint64(3), snapshot.Height)
+
+	// The fresh node is offered the snapshot and restores it chunk by chunk.
+	target, targetKey := newTestService(t, true)
+	require.Equal(t, int64(0), target.LastBlockHeight())
+	var restored [][]byte
+	SetSnapshotRestoredHandler[*phuslu.Logger](func(ctx context.Context) error {
+		restored = append(
+			restored,
+			sdk.UnwrapSDKContext(ctx).KVStore(targetKey).Get([]byte{2}),
+		)
+		return nil
+	})(target)
+
+	offerResp, err := target.OfferSnapshot(
+		ctx,
+		&cmtabci.OfferSnapshotRequest{Snapshot: snapshot},
+	)
+	require.NoError(t, err)
+	require.Equal(t, cmtabci.OFFER_SNAPSHOT_RESULT_ACCEPT, offerResp.Result)
+
+	for i := range snapshot.Chunks {
+		var loadResp *cmtabci.LoadSnapshotChunkResponse
+		loadResp, err = source.LoadSnapshotChunk(
+			ctx,
+			&cmtabci.LoadSnapshotChunkRequest{
+				Height: snapshot.Height,
+				Format: snapshot.Format,
+				Chunk:  i,
+			},
+		)
+		require.NoError(t, err)
+
+		var applyResp *cmtabci.ApplySnapshotChunkResponse
+		applyResp, err = target.ApplySnapshotChunk(
+			ctx,
+			&cmtabci.ApplySnapshotChunkRequest{
+				Index:  i,
+				Chunk:  loadResp.Chunk,
+				Sender: "source",
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t, cmtabci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT, applyResp.Result,
+		)
+	}
+
+	// The restored node is at the same height and app hash as the source.
+	require.Equal(t, source.LastBlockHeight(), target.LastBlockHeight())
+	require.Equal(
+		t,
+		source.CommitMultiStore().LastCommitID(),
+		target.CommitMultiStore().LastCommitID(),
+	)
+	require.Equal(
+		t,
+		[]byte{3},
+		target.CommitMultiStore().GetCommitKVStore(targetKey).Get([]byte{2}),
+	)
+
+	// The restored handler is called once, over the restored state.
+	require.Equal(t, [][]byte{{3}}, restored)
+}
+
+func TestApplySnapshotChunkMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	source, sourceKey := newTestService(t, true)
+	commitHeights(source, sourceKey, 2)
+	//#nosec:G701 // height is always positive.
+	_, err := source.snapshotManager.Create(uint64(source.LastBlockHeight()))
+	require.NoError(t, err)
+
+	listResp, err := source.ListSnapshots(ctx, &cmtabci.ListSnapshotsRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResp.Snapshots, 1)
+
+	target, _ := newTestService(t, true)
+	offerResp, err := target.OfferSnapshot(
+		ctx,
+		&cmtabci.OfferSnapshotRequest{Snapshot: listResp.Snapshots[0]},
+	)
+	require.NoError(t, err)
+	require.Equal(t, cmtabci.OFFER_SNAPSHOT_RESULT_ACCEPT, offerResp.Result)
+
+	// A chunk that does not match the snapshot metadata is refetched from
+	// another peer.
+	applyResp, err := target.ApplySnapshotChunk(
+		ctx,
+		&cmtabci.ApplySnapshotChunkRequest{
+			Index:  0,
+			Chunk:  []byte("corrupted"),
+			Sender: "source",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, cmtabci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY, applyResp.Result,
+	)
+	require.Equal(t, []uint32{0}, applyResp.RefetchChunks)
+	require.Equal(t, []string{"source"}, applyResp.RejectSenders)
+}
+
+func TestSnapshotsDisabled(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, false)
+
+	listResp, err := s.ListSnapshots(ctx, &cmtabci.ListSnapshotsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, listResp.Snapshots)
+
+	offerResp, err := s.OfferSnapshot(
+		ctx,
+		&cmtabci.OfferSnapshotRequest{Snapshot: &cmtabci.Snapshot{Height: 1}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, cmtabci.OFFER_SNAPSHOT_RESULT_ABORT, offerResp.Result)
+}
@@ -21,6 +21,8 @@
 package cometbft
 
 import (
+	"context"
+
 	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -48,3 +50,12 @@ type MiddlewareI interface {
 		*cmtabci.FinalizeBlockRequest,
 	) (transition.ValidatorUpdates, error)
 }
+
+// TelemetrySink is an interface for tracing the ABCI calls of the service.
+type TelemetrySink interface {
+	// StartSpan starts a span, returning the context carrying it and a
+	// function to end it.
+	StartSpan(
+		ctx context.Context, name string, args ...any,
+	) (context.Context, func(error))
+}
@@ -21,6 +21,8 @@
 package blob
 
 import (
+	"context"
+
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
@@ -95,6 +97,7 @@ func NewProcessor[
 func (sp *Processor[
 	AvailabilityStoreT, _, _, ConsensusSidecarsT, _, _,
 ]) VerifySidecars(
+	ctx context.Context,
 	cs ConsensusSidecarsT,
 ) error {
 	var (
@@ -112,6 +115,7 @@ func (sp *Processor[
 
 	// Verify the blobs and ensure they match the local state.
 	return sp.verifier.verifySidecars(
+		ctx,
 		sidecars,
 		sp.blockBodyOffsetFn(
 			sidecars.Get(0).GetBeaconBlockHeader().GetSlot(),
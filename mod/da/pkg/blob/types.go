@@ -90,4 +90,9 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+	// StartSpan starts a span with the provided attributes, returning a
+	// function ending it with the error of the traced operation.
+	StartSpan(
+		ctx context.Context, name string, args ...any,
+	) (context.Context, func(error))
 }
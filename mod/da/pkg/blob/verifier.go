@@ -61,10 +61,16 @@ func newVerifier[
 // verifySidecars verifies the blobs for both inclusion as well
 // as the KZG proofs.
 func (bv *verifier[BeaconBlockHeaderT, _, BlobSidecarsT]) verifySidecars(
+	ctx context.Context,
 	sidecars BlobSidecarsT,
 	kzgOffset uint64,
 	blkHeader BeaconBlockHeaderT,
-) error {
+) (err error) {
+	ctx, endSpan := bv.metrics.sink.StartSpan(
+		ctx, "blob.VerifySidecars", "num_sidecars", sidecars.Len(),
+	)
+	defer func() { endSpan(err) }()
+
 	defer bv.metrics.measureVerifySidecarsDuration(
 		time.Now(), math.U64(sidecars.Len()),
 		bv.proofVerifier.GetImplementation(),
@@ -90,7 +96,7 @@ func (bv *verifier[BeaconBlockHeaderT, _, BlobSidecarsT]) verifySidecars(
 
 	// Verify the KZG proofs on the blobs concurrently.
 	g.Go(func() error {
-		return bv.verifyKZGProofs(sidecars)
+		return bv.verifyKZGProofs(ctx, sidecars)
 	})
 
 	g.Go(func() error {
@@ -114,8 +120,15 @@ func (bv *verifier[_, _, BlobSidecarsT]) verifyInclusionProofs(
 
 // verifyKZGProofs verifies the sidecars.
 func (bv *verifier[_, _, BlobSidecarsT]) verifyKZGProofs(
+	ctx context.Context,
 	scs BlobSidecarsT,
-) error {
+) (err error) {
+	_, endSpan := bv.metrics.sink.StartSpan(
+		ctx, "blob.VerifyKZGProofs",
+		"kzg_implementation", bv.proofVerifier.GetImplementation(),
+	)
+	defer func() { endSpan(err) }()
+
 	start := time.Now()
 	defer bv.metrics.measureVerifyKZGProofsDuration(
 		start, math.U64(scs.Len()),
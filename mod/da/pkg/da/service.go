@@ -22,6 +22,7 @@ package da
 
 import (
 	"context"
+	"sync"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -49,6 +50,12 @@ type Service[
 	subSidecarsReceived chan async.Event[ConsensusSidecarsT]
 	// subFinalBlobSidecars is a channel holding FinalSidecarsReceived events.
 	subFinalBlobSidecars chan async.Event[BlobSidecarsT]
+	// stopCh is closed when the service is stopped.
+	stopCh chan struct{}
+	// stopOnce ensures stopCh is closed only once.
+	stopOnce sync.Once
+	// wg tracks the event loop.
+	wg sync.WaitGroup
 }
 
 // NewService returns a new DA service.
@@ -78,6 +85,7 @@ func NewService[
 		logger:               logger,
 		subSidecarsReceived:  make(chan async.Event[ConsensusSidecarsT]),
 		subFinalBlobSidecars: make(chan async.Event[BlobSidecarsT]),
+		stopCh:               make(chan struct{}),
 	}
 }
 
@@ -106,20 +114,37 @@ func (s *Service[_, _, _, _]) Start(ctx context.Context) error {
 	}
 
 	// start the main event loop to listen and handle events.
+	s.wg.Add(1)
 	go s.eventLoop(ctx)
 	return nil
 }
 
+// Stop stops the service, waiting for the sidecars being processed to be
+// persisted.
+func (s *Service[_, _, _, _]) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+	return async.WaitContext(ctx, &s.wg)
+}
+
 // eventLoop listens and handles SidecarsReceived and FinalSidecarsReceived
 // events.
 func (s *Service[_, _, _, _]) eventLoop(ctx context.Context) {
+	defer s.wg.Done()
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-s.subSidecarsReceived:
+		case <-s.stopCh:
+			return
+		case event, ok := <-s.subSidecarsReceived:
+			if !ok {
+				return
+			}
 			s.handleSidecarsReceived(event)
-		case event := <-s.subFinalBlobSidecars:
+		case event, ok := <-s.subFinalBlobSidecars:
+			if !ok {
+				return
+			}
 			s.handleFinalSidecarsReceived(event)
 		}
 	}
@@ -150,7 +175,7 @@ func (s *Service[_, ConsensusSidecarsT, _, _]) handleSidecarsReceived(
 	cs async.Event[ConsensusSidecarsT],
 ) {
 	// verify the sidecars.
-	sidecarsErr := s.verifySidecars(cs.Data())
+	sidecarsErr := s.verifySidecars(cs.Context(), cs.Data())
 	if sidecarsErr != nil {
 		s.logger.Error(
 			"Failed to receive blob sidecars",
@@ -189,6 +214,7 @@ func (s *Service[_, _, BlobSidecarsT, _]) processSidecars(
 
 // VerifyIncomingBlobs receives blobs from the network and processes them.
 func (s *Service[_, ConsensusSidecarsT, _, _]) verifySidecars(
+	ctx context.Context,
 	cs ConsensusSidecarsT,
 ) error {
 	sidecars := cs.GetSidecars()
@@ -200,7 +226,7 @@ func (s *Service[_, ConsensusSidecarsT, _, _]) verifySidecars(
 	s.logger.Info("Received incoming blob sidecars")
 
 	// Verify the blobs and ensure they match the local state.
-	if err := s.bp.VerifySidecars(cs); err != nil {
+	if err := s.bp.VerifySidecars(ctx, cs); err != nil {
 		s.logger.Error(
 			"rejecting incoming blob sidecars",
 			"reason", err,
@@ -20,6 +20,8 @@
 
 package da
 
+import "context"
+
 // BlobProcessor is the interface for the blobs processor.
 type BlobProcessor[
 	AvailabilityStoreT,
@@ -32,7 +34,10 @@ type BlobProcessor[
 		sidecars BlobSidecarsT,
 	) error
 	// VerifySidecars verifies the blobs and ensures they match the local state.
-	VerifySidecars(sidecars ConsensusSidecarsT) error
+	VerifySidecars(
+		ctx context.Context,
+		sidecars ConsensusSidecarsT,
+	) error
 }
 
 type ConsensusSidecars[BlobSidecarsT any, BeaconBlockHeaderT any] interface {
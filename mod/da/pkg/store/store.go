@@ -21,7 +21,9 @@
 package store
 
 import (
+	"cmp"
 	"context"
+	"slices"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -71,6 +73,33 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	return true
 }
 
+// GetBlobSidecars returns all the blob sidecars stored for the given slot,
+// ordered by their index in the block.
+func (s *Store[BeaconBlockT]) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	sidecarBzs, err := s.IndexDB.GetByIndex(slot.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := &types.BlobSidecars{
+		Sidecars: make([]*types.BlobSidecar, 0, len(sidecarBzs)),
+	}
+	for _, bz := range sidecarBzs {
+		sidecar := new(types.BlobSidecar)
+		if err = sidecar.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+		sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+	}
+
+	slices.SortFunc(sidecars.Sidecars, func(a, b *types.BlobSidecar) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return sidecars, nil
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store[BeaconBlockT]) Persist(
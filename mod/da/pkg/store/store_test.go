@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// mockIndexDB is an in-memory IndexDB used for testing.
+type mockIndexDB struct {
+	data map[uint64]map[string][]byte
+}
+
+func newMockIndexDB() *mockIndexDB {
+	return &mockIndexDB{data: make(map[uint64]map[string][]byte)}
+}
+
+func (db *mockIndexDB) GetByIndex(index uint64) ([][]byte, error) {
+	keys := make([]string, 0, len(db.data[index]))
+	for key := range db.data[index] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, db.data[index][key])
+	}
+	return values, nil
+}
+
+func (db *mockIndexDB) Has(index uint64, key []byte) (bool, error) {
+	_, ok := db.data[index][string(key)]
+	return ok, nil
+}
+
+func (db *mockIndexDB) Set(index uint64, key []byte, value []byte) error {
+	if db.data[index] == nil {
+		db.data[index] = make(map[string][]byte)
+	}
+	db.data[index][string(key)] = value
+	return nil
+}
+
+func (db *mockIndexDB) Prune(start uint64, end uint64) error {
+	for ; start < end; start++ {
+		delete(db.data, start)
+	}
+	return nil
+}
+
+func TestStore_GetBlobSidecars(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			bytes.B4, math.U64, common.ExecutionAddress, math.U64, any,
+		]{
+			SlotsPerEpoch:                    32,
+			MinEpochsForBlobsSidecarsRequest: 5,
+		},
+	)
+	s := store.New[*ctypes.BeaconBlockBody](
+		newMockIndexDB(), noop.NewLogger[any](), cs,
+	)
+
+	slot := math.Slot(10)
+	header := &ctypes.BeaconBlockHeader{Slot: slot}
+	sidecars := &types.BlobSidecars{}
+	// Commitments are chosen so that the key order differs from the index
+	// order of the sidecars.
+	for i := range uint64(3) {
+		sidecars.Sidecars = append(sidecars.Sidecars, &types.BlobSidecar{
+			Index:             i,
+			KzgCommitment:     eip4844.KZGCommitment{byte(3 - i)},
+			BeaconBlockHeader: header,
+			InclusionProof:    make([]common.Root, 8),
+		})
+	}
+	require.NoError(t, s.Persist(slot, sidecars))
+
+	stored, err := s.GetBlobSidecars(slot)
+	require.NoError(t, err)
+	require.Equal(t, 3, stored.Len())
+	for i, sidecar := range stored.Sidecars {
+		require.Equal(t, uint64(i), sidecar.GetIndex())
+		require.Equal(
+			t, sidecars.Sidecars[i].KzgCommitment, sidecar.KzgCommitment,
+		)
+	}
+
+	stored, err = s.GetBlobSidecars(slot + 1)
+	require.NoError(t, err)
+	require.Equal(t, 0, stored.Len())
+}
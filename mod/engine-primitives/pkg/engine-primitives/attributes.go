@@ -100,6 +100,28 @@ func (
 	return p.SuggestedFeeRecipient
 }
 
+// GetTimestamp returns the timestamp at which the block will be built at.
+func (p *PayloadAttributes[WithdrawalT]) GetTimestamp() math.U64 {
+	return p.Timestamp
+}
+
+// GetPrevRandao returns the previous Randao value.
+func (p *PayloadAttributes[WithdrawalT]) GetPrevRandao() common.Bytes32 {
+	return p.PrevRandao
+}
+
+// GetWithdrawals returns the withdrawals to be included in the block.
+func (p *PayloadAttributes[WithdrawalT]) GetWithdrawals() []WithdrawalT {
+	return p.Withdrawals
+}
+
+// GetParentBeaconBlockRoot returns the root of the parent beacon block.
+func (
+	p *PayloadAttributes[WithdrawalT],
+) GetParentBeaconBlockRoot() common.Root {
+	return p.ParentBeaconBlockRoot
+}
+
 // Version returns the version of the PayloadAttributes.
 func (p *PayloadAttributes[WithdrawalT]) Version() uint32 {
 	return p.version
@@ -127,3 +149,23 @@ func (p *PayloadAttributes[WithdrawalT]) Validate() error {
 
 	return nil
 }
+
+// PayloadBuildData holds the payload attributes sent to the execution client
+// in a forkchoice update to start a payload build, along with the beacon
+// chain context of the build.
+type PayloadBuildData[PayloadAttributesT any] struct {
+	// ForkVersion is the fork version of the payload being built.
+	ForkVersion uint32
+	// ProposalSlot is the slot the payload is built for.
+	ProposalSlot math.Slot
+	// ProposerIndex is the index of the validator proposing the payload.
+	ProposerIndex math.ValidatorIndex
+	// ParentBlockRoot is the root of the parent beacon block.
+	ParentBlockRoot common.Root
+	// ParentBlockNumber is the number of the parent execution block.
+	ParentBlockNumber math.U64
+	// ParentBlockHash is the hash of the parent execution block.
+	ParentBlockHash common.ExecutionHash
+	// PayloadAttributes are the attributes sent to the execution client.
+	PayloadAttributes PayloadAttributesT
+}
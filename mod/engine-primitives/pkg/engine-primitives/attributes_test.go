@@ -118,6 +118,14 @@ func TestPayloadAttributes(t *testing.T) {
 					in.suggestedFeeRecipient,
 					got.GetSuggestedFeeRecipient(),
 				)
+				require.Equal(t, in.timestamp, got.GetTimestamp().Unwrap())
+				require.Equal(t, in.prevRandao, got.GetPrevRandao())
+				require.Equal(t, in.withdrawals, got.GetWithdrawals())
+				require.Equal(
+					t,
+					in.parentBeaconBlockRoot,
+					got.GetParentBeaconBlockRoot(),
+				)
 				require.Equal(t, in.forkVersion, got.Version())
 			}
 		})
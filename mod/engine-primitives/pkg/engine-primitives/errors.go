@@ -52,4 +52,24 @@ var (
 	ErrPayloadBlockHashMismatch = errors.New(
 		"block hash in payload does not match assembled block",
 	)
+
+	// ErrEmptyExecutionRequest indicates that an execution request carries no
+	// requests.
+	ErrEmptyExecutionRequest = errors.New("empty execution request")
+
+	// ErrInvalidExecutionRequestsOrder indicates that the execution requests
+	// are not in strictly ascending order of type.
+	ErrInvalidExecutionRequestsOrder = errors.New(
+		"execution requests not in ascending order of type",
+	)
+
+	// ErrUnknownExecutionRequestType indicates that an execution request is
+	// of an unknown type.
+	ErrUnknownExecutionRequestType = errors.New(
+		"unknown execution request type",
+	)
+
+	// ErrInvalidExecutionRequests indicates that the serialized execution
+	// requests of a type could not be decoded.
+	ErrInvalidExecutionRequests = errors.New("invalid execution requests")
 )
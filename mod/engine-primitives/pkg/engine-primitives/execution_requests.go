@@ -0,0 +1,463 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// Execution request types as per EIP-7685, ordered as they are passed to and
+// from the execution client.
+const (
+	// DepositRequestType is the type of deposit requests (EIP-6110).
+	DepositRequestType byte = 0x00
+	// WithdrawalRequestType is the type of withdrawal requests (EIP-7002).
+	WithdrawalRequestType byte = 0x01
+	// ConsolidationRequestType is the type of consolidation requests
+	// (EIP-7251).
+	ConsolidationRequestType byte = 0x02
+)
+
+const (
+	// MaxDepositRequestsPerPayload is the maximum number of deposit requests
+	// in an execution payload.
+	MaxDepositRequestsPerPayload = 8192
+	// MaxWithdrawalRequestsPerPayload is the maximum number of withdrawal
+	// requests in an execution payload.
+	MaxWithdrawalRequestsPerPayload = 16
+	// MaxConsolidationRequestsPerPayload is the maximum number of
+	// consolidation requests in an execution payload.
+	MaxConsolidationRequestsPerPayload = 2
+
+	// DepositRequestSize is the size of the SSZ encoding of a DepositRequest.
+	DepositRequestSize = 192 // 48 + 32 + 8 + 96 + 8
+	// WithdrawalRequestSize is the size of the SSZ encoding of a
+	// WithdrawalRequest.
+	WithdrawalRequestSize = 76 // 20 + 48 + 8
+	// ConsolidationRequestSize is the size of the SSZ encoding of a
+	// ConsolidationRequest.
+	ConsolidationRequestSize = 116 // 20 + 48 + 48
+)
+
+// FullExitRequestAmount is the amount of a withdrawal request asking for the
+// full exit of the validator.
+const FullExitRequestAmount math.Gwei = 0
+
+var (
+	_ ssz.StaticObject  = (*DepositRequest)(nil)
+	_ ssz.StaticObject  = (*WithdrawalRequest)(nil)
+	_ ssz.StaticObject  = (*ConsolidationRequest)(nil)
+	_ ssz.DynamicObject = (*ExecutionRequests)(nil)
+)
+
+/* -------------------------------------------------------------------------- */
+/*                               DepositRequest                               */
+/* -------------------------------------------------------------------------- */
+
+// DepositRequest is a deposit made to the deposit contract, as passed to the
+// consensus layer by the execution client as per EIP-6110.
+type DepositRequest struct {
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// Credentials are the withdrawal credentials of the validator.
+	Credentials common.Bytes32 `json:"withdrawal_credentials"`
+	// Amount is the deposit amount in gwei.
+	Amount math.Gwei `json:"amount"`
+	// Signature is the signature of the deposit data.
+	Signature crypto.BLSSignature `json:"signature"`
+	// Index is the index of the deposit in the deposit contract.
+	Index math.U64 `json:"index"`
+}
+
+// SizeSSZ returns the size of the DepositRequest in SSZ.
+func (*DepositRequest) SizeSSZ() uint32 {
+	return DepositRequestSize
+}
+
+// DefineSSZ defines the SSZ serialization of the DepositRequest.
+func (d *DepositRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &d.Pubkey)
+	ssz.DefineStaticBytes(c, &d.Credentials)
+	ssz.DefineUint64(c, &d.Amount)
+	ssz.DefineStaticBytes(c, &d.Signature)
+	ssz.DefineUint64(c, &d.Index)
+}
+
+// HashTreeRoot returns the hash tree root of the DepositRequest.
+func (d *DepositRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
+
+// HashTreeRootWith ssz hashes the DepositRequest object with a hasher.
+func (d *DepositRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutBytes(d.Pubkey[:])
+	hh.PutBytes(d.Credentials[:])
+	hh.PutUint64(uint64(d.Amount))
+	hh.PutBytes(d.Signature[:])
+	hh.PutUint64(uint64(d.Index))
+	hh.Merkleize(indx)
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              WithdrawalRequest                             */
+/* -------------------------------------------------------------------------- */
+
+// WithdrawalRequest is a withdrawal triggered from the execution layer by the
+// withdrawal address of a validator as per EIP-7002.
+type WithdrawalRequest struct {
+	// SourceAddress is the address that sent the request.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// ValidatorPubkey is the public key of the withdrawing validator.
+	ValidatorPubkey crypto.BLSPubkey `json:"validator_pubkey"`
+	// Amount is the amount to withdraw in gwei, FullExitRequestAmount
+	// requests the exit of the validator.
+	Amount math.Gwei `json:"amount"`
+}
+
+// SizeSSZ returns the size of the WithdrawalRequest in SSZ.
+func (*WithdrawalRequest) SizeSSZ() uint32 {
+	return WithdrawalRequestSize
+}
+
+// DefineSSZ defines the SSZ serialization of the WithdrawalRequest.
+func (w *WithdrawalRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &w.SourceAddress)
+	ssz.DefineStaticBytes(c, &w.ValidatorPubkey)
+	ssz.DefineUint64(c, &w.Amount)
+}
+
+// HashTreeRoot returns the hash tree root of the WithdrawalRequest.
+func (w *WithdrawalRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(w)
+}
+
+// HashTreeRootWith ssz hashes the WithdrawalRequest object with a hasher.
+func (w *WithdrawalRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutBytes(w.SourceAddress[:])
+	hh.PutBytes(w.ValidatorPubkey[:])
+	hh.PutUint64(uint64(w.Amount))
+	hh.Merkleize(indx)
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                            ConsolidationRequest                            */
+/* -------------------------------------------------------------------------- */
+
+// ConsolidationRequest is a request to consolidate the balance of a source
+// validator into a target validator as per EIP-7251.
+type ConsolidationRequest struct {
+	// SourceAddress is the address that sent the request.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// SourcePubkey is the public key of the source validator.
+	SourcePubkey crypto.BLSPubkey `json:"source_pubkey"`
+	// TargetPubkey is the public key of the target validator.
+	TargetPubkey crypto.BLSPubkey `json:"target_pubkey"`
+}
+
+// SizeSSZ returns the size of the ConsolidationRequest in SSZ.
+func (*ConsolidationRequest) SizeSSZ() uint32 {
+	return ConsolidationRequestSize
+}
+
+// DefineSSZ defines the SSZ serialization of the ConsolidationRequest.
+func (c *ConsolidationRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &c.SourceAddress)
+	ssz.DefineStaticBytes(codec, &c.SourcePubkey)
+	ssz.DefineStaticBytes(codec, &c.TargetPubkey)
+}
+
+// HashTreeRoot returns the hash tree root of the ConsolidationRequest.
+func (c *ConsolidationRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
+
+// HashTreeRootWith ssz hashes the ConsolidationRequest object with a hasher.
+func (c *ConsolidationRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	hh.PutBytes(c.SourceAddress[:])
+	hh.PutBytes(c.SourcePubkey[:])
+	hh.PutBytes(c.TargetPubkey[:])
+	hh.Merkleize(indx)
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              ExecutionRequests                             */
+/* -------------------------------------------------------------------------- */
+
+// ExecutionRequests are the requests made by the execution layer to the
+// consensus layer in an execution payload, as included in the block body
+// from Electra on.
+type ExecutionRequests struct {
+	// Deposits are the deposit requests.
+	Deposits []*DepositRequest `json:"deposits"`
+	// Withdrawals are the withdrawal requests.
+	Withdrawals []*WithdrawalRequest `json:"withdrawals"`
+	// Consolidations are the consolidation requests.
+	Consolidations []*ConsolidationRequest `json:"consolidations"`
+}
+
+// SizeSSZ returns the size of the ExecutionRequests in SSZ.
+func (e *ExecutionRequests) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 4 + 4 + 4
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(e.Deposits)
+	size += ssz.SizeSliceOfStaticObjects(e.Withdrawals)
+	size += ssz.SizeSliceOfStaticObjects(e.Consolidations)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the ExecutionRequests.
+func (e *ExecutionRequests) DefineSSZ(c *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		c, &e.Deposits, MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		c, &e.Withdrawals, MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		c, &e.Consolidations, MaxConsolidationRequestsPerPayload,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		c, &e.Deposits, MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		c, &e.Withdrawals, MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		c, &e.Consolidations, MaxConsolidationRequestsPerPayload,
+	)
+}
+
+// MarshalSSZ serializes the ExecutionRequests to SSZ-encoded bytes.
+func (e *ExecutionRequests) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ deserializes the ExecutionRequests from SSZ-encoded bytes.
+func (e *ExecutionRequests) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// HashTreeRoot returns the hash tree root of the ExecutionRequests.
+func (e *ExecutionRequests) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionRequests object with a hasher.
+func (e *ExecutionRequests) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+	if err := hashRequestsWith(
+		hh, e.Deposits, MaxDepositRequestsPerPayload,
+	); err != nil {
+		return err
+	}
+	if err := hashRequestsWith(
+		hh, e.Withdrawals, MaxWithdrawalRequestsPerPayload,
+	); err != nil {
+		return err
+	}
+	if err := hashRequestsWith(
+		hh, e.Consolidations, MaxConsolidationRequestsPerPayload,
+	); err != nil {
+		return err
+	}
+	hh.Merkleize(indx)
+	return nil
+}
+
+// hashRequestsWith ssz hashes a list of requests with a hasher.
+func hashRequestsWith[
+	RequestT interface {
+		HashTreeRootWith(fastssz.HashWalker) error
+	},
+](hh fastssz.HashWalker, requests []RequestT, limit uint64) error {
+	num := uint64(len(requests))
+	if num > limit {
+		return fastssz.ErrIncorrectListSize
+	}
+	subIndx := hh.Index()
+	for _, req := range requests {
+		if err := req.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+	hh.MerkleizeWithMixin(subIndx, num, limit)
+	return nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  Encoding                                  */
+/* -------------------------------------------------------------------------- */
+
+// Encode encodes the execution requests as passed to the execution client as
+// per EIP-7685: each non-empty list of requests is serialized and prefixed
+// with its type, in ascending order of type.
+func (e *ExecutionRequests) Encode() ([]bytes.Bytes, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	var (
+		encoded = make([]bytes.Bytes, 0)
+		err     error
+	)
+	if encoded, err = appendRequests(
+		encoded, DepositRequestType, e.Deposits,
+	); err != nil {
+		return nil, err
+	}
+	if encoded, err = appendRequests(
+		encoded, WithdrawalRequestType, e.Withdrawals,
+	); err != nil {
+		return nil, err
+	}
+	return appendRequests(
+		encoded, ConsolidationRequestType, e.Consolidations,
+	)
+}
+
+// appendRequests appends the encoding of the given requests to encoded, if
+// there are any.
+func appendRequests[RequestT ssz.StaticObject](
+	encoded []bytes.Bytes, requestType byte, requests []RequestT,
+) ([]bytes.Bytes, error) {
+	if len(requests) == 0 {
+		return encoded, nil
+	}
+	buf := make([]byte, 1+ssz.SizeSliceOfStaticObjects(requests))
+	buf[0] = requestType
+	for i, req := range requests {
+		size := req.SizeSSZ()
+		//#nosec:G115 // the number of requests is bounded.
+		offset := 1 + uint32(i)*size
+		if err := ssz.EncodeToBytes(buf[offset:offset+size], req); err != nil {
+			return nil, err
+		}
+	}
+	return append(encoded, buf), nil
+}
+
+// DecodeExecutionRequests decodes the execution requests as returned by the
+// execution client. The requests must be non-empty and in strictly ascending
+// order of type.
+func DecodeExecutionRequests(
+	encoded []bytes.Bytes,
+) (*ExecutionRequests, error) {
+	var (
+		requests = &ExecutionRequests{
+			Deposits:       make([]*DepositRequest, 0),
+			Withdrawals:    make([]*WithdrawalRequest, 0),
+			Consolidations: make([]*ConsolidationRequest, 0),
+		}
+		prevType = -1
+		err      error
+	)
+	for _, bz := range encoded {
+		if len(bz) <= 1 {
+			return nil, ErrEmptyExecutionRequest
+		}
+		if int(bz[0]) <= prevType {
+			return nil, errors.Wrapf(
+				ErrInvalidExecutionRequestsOrder, "type %d", bz[0],
+			)
+		}
+		prevType = int(bz[0])
+
+		switch bz[0] {
+		case DepositRequestType:
+			requests.Deposits, err = decodeRequests[*DepositRequest](
+				bz[1:], MaxDepositRequestsPerPayload,
+			)
+		case WithdrawalRequestType:
+			requests.Withdrawals, err = decodeRequests[*WithdrawalRequest](
+				bz[1:], MaxWithdrawalRequestsPerPayload,
+			)
+		case ConsolidationRequestType:
+			requests.Consolidations, err = decodeRequests[
+				*ConsolidationRequest, ConsolidationRequest,
+			](bz[1:], MaxConsolidationRequestsPerPayload)
+		default:
+			err = errors.Wrapf(
+				ErrUnknownExecutionRequestType, "type %d", bz[0],
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return requests, nil
+}
+
+// decodeRequests decodes a serialized list of requests.
+func decodeRequests[
+	RequestT interface {
+		*R
+		ssz.StaticObject
+	},
+	R any,
+](bz []byte, limit int) ([]RequestT, error) {
+	size := int(RequestT(new(R)).SizeSSZ())
+	if len(bz)%size != 0 || len(bz)/size > limit {
+		return nil, errors.Wrapf(
+			ErrInvalidExecutionRequests, "length %d", len(bz),
+		)
+	}
+	requests := make([]RequestT, len(bz)/size)
+	for i := range requests {
+		requests[i] = new(R)
+		if err := ssz.DecodeFromBytes(
+			bz[i*size:(i+1)*size], requests[i],
+		); err != nil {
+			return nil, err
+		}
+	}
+	return requests, nil
+}
+
+// RequestsHash computes the commitment to the encoded execution requests
+// included in the execution block header as per EIP-7685.
+func RequestsHash(encoded []bytes.Bytes) common.ExecutionHash {
+	hashes := make([]byte, 0, len(encoded)*constants.RootLength)
+	for _, bz := range encoded {
+		h := sha256.Hash(bz)
+		hashes = append(hashes, h[:]...)
+	}
+	return sha256.Hash(hashes)
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func testExecutionRequests() *engineprimitives.ExecutionRequests {
+	return &engineprimitives.ExecutionRequests{
+		Deposits: []*engineprimitives.DepositRequest{
+			{
+				Pubkey:      crypto.BLSPubkey{0x01},
+				Credentials: common.Bytes32{0x01},
+				Amount:      math.Gwei(32e9),
+				Signature:   crypto.BLSSignature{0x01},
+				Index:       0,
+			},
+			{
+				Pubkey:      crypto.BLSPubkey{0x02},
+				Credentials: common.Bytes32{0x02},
+				Amount:      math.Gwei(1e9),
+				Signature:   crypto.BLSSignature{0x02},
+				Index:       1,
+			},
+		},
+		Withdrawals: []*engineprimitives.WithdrawalRequest{},
+		Consolidations: []*engineprimitives.ConsolidationRequest{
+			{
+				SourceAddress: common.ExecutionAddress{0x03},
+				SourcePubkey:  crypto.BLSPubkey{0x03},
+				TargetPubkey:  crypto.BLSPubkey{0x04},
+			},
+		},
+	}
+}
+
+func TestExecutionRequestsEncode(t *testing.T) {
+	requests := testExecutionRequests()
+
+	encoded, err := requests.Encode()
+	require.NoError(t, err)
+
+	// Empty lists of requests are left out.
+	require.Len(t, encoded, 2)
+	require.Equal(t, engineprimitives.DepositRequestType, encoded[0][0])
+	require.Len(t, encoded[0], 1+2*engineprimitives.DepositRequestSize)
+	require.Equal(t, engineprimitives.ConsolidationRequestType, encoded[1][0])
+	require.Len(t, encoded[1], 1+engineprimitives.ConsolidationRequestSize)
+
+	decoded, err := engineprimitives.DecodeExecutionRequests(encoded)
+	require.NoError(t, err)
+	require.Equal(t, requests, decoded)
+
+	// Nil requests, as before Electra, encode to nothing.
+	encoded, err = (*engineprimitives.ExecutionRequests)(nil).Encode()
+	require.NoError(t, err)
+	require.Empty(t, encoded)
+}
+
+func TestDecodeExecutionRequestsErrors(t *testing.T) {
+	encoded, err := testExecutionRequests().Encode()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		encoded []bytes.Bytes
+		err     error
+	}{
+		{
+			name:    "empty request",
+			encoded: []bytes.Bytes{{engineprimitives.DepositRequestType}},
+			err:     engineprimitives.ErrEmptyExecutionRequest,
+		},
+		{
+			name:    "descending order",
+			encoded: []bytes.Bytes{encoded[1], encoded[0]},
+			err:     engineprimitives.ErrInvalidExecutionRequestsOrder,
+		},
+		{
+			name:    "duplicate type",
+			encoded: []bytes.Bytes{encoded[0], encoded[0]},
+			err:     engineprimitives.ErrInvalidExecutionRequestsOrder,
+		},
+		{
+			name:    "unknown type",
+			encoded: []bytes.Bytes{{0x03, 0x00}},
+			err:     engineprimitives.ErrUnknownExecutionRequestType,
+		},
+		{
+			name:    "truncated request",
+			encoded: []bytes.Bytes{encoded[0][:len(encoded[0])-1]},
+			err:     engineprimitives.ErrInvalidExecutionRequests,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err = engineprimitives.DecodeExecutionRequests(tt.encoded)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestExecutionRequestsSSZ(t *testing.T) {
+	requests := testExecutionRequests()
+
+	data, err := requests.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, int(requests.SizeSSZ(false)))
+
+	decoded := new(engineprimitives.ExecutionRequests)
+	require.NoError(t, decoded.UnmarshalSSZ(data))
+	require.Equal(t, requests.Deposits, decoded.Deposits)
+	require.Empty(t, decoded.Withdrawals)
+	require.Equal(t, requests.Consolidations, decoded.Consolidations)
+
+	// The fastssz hasher, used for proofs, agrees on the root.
+	hh := fastssz.DefaultHasherPool.Get()
+	defer fastssz.DefaultHasherPool.Put(hh)
+	require.NoError(t, requests.HashTreeRootWith(hh))
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+	require.Equal(t, requests.HashTreeRoot(), common.Root(root))
+}
+
+func TestRequestsHash(t *testing.T) {
+	// Without any requests, the hash is the hash of the empty string.
+	require.Equal(t,
+		common.ExecutionHash(sha256.Sum256(nil)),
+		engineprimitives.RequestsHash(nil),
+	)
+
+	encoded, err := testExecutionRequests().Encode()
+	require.NoError(t, err)
+	first, second := sha256.Sum256(encoded[0]), sha256.Sum256(encoded[1])
+	require.Equal(t,
+		common.ExecutionHash(sha256.Sum256(append(first[:], second[:]...))),
+		engineprimitives.RequestsHash(encoded),
+	)
+}
@@ -69,6 +69,11 @@ type NewPayloadRequest[
 	VersionedHashes []common.ExecutionHash
 	// ParentBeaconBlockRoot is the root of the parent beacon block.
 	ParentBeaconBlockRoot *common.Root
+	// ExecutionRequests are the execution requests of the payload, which are
+	// only set from Electra on.
+	ExecutionRequests *ExecutionRequests
+	// ForkVersion is the fork version of the block carrying the payload.
+	ForkVersion uint32
 	// Optimistic is a flag that indicates if the payload should be
 	// optimistically deemed valid. This is useful during syncing.
 	Optimistic bool
@@ -111,12 +116,16 @@ func BuildNewPayloadRequest[
 	executionPayload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests *ExecutionRequests,
+	forkVersion uint32,
 	optimistic bool,
 ) *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT] {
 	return &NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]{
 		ExecutionPayload:      executionPayload,
 		VersionedHashes:       versionedHashes,
 		ParentBeaconBlockRoot: parentBeaconBlockRoot,
+		ExecutionRequests:     executionRequests,
+		ForkVersion:           forkVersion,
 		Optimistic:            optimistic,
 	}
 }
@@ -181,7 +190,7 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]) HasValidVersionedAn
 
 	// Verify that the payload is telling the truth about it's block hash.
 	//#nosec:G103 // its okay.
-	if block := gethprimitives.NewBlockWithHeader(
+	block := gethprimitives.NewBlockWithHeader(
 		&gethprimitives.Header{
 			ParentHash:       gethprimitives.ExecutionHash(payload.GetParentHash()),
 			UncleHash:        gethprimitives.EmptyUncleHash,
@@ -205,10 +214,28 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]) HasValidVersionedAn
 		},
 	).WithBody(gethprimitives.Body{
 		Transactions: txs, Uncles: nil, Withdrawals: *(*gethprimitives.Withdrawals)(unsafe.Pointer(&wds)),
-	}); common.ExecutionHash(block.Hash()) != payload.GetBlockHash() {
+	})
+	blockHash := block.Hash()
+
+	// From Prague on, the header also commits to the execution requests.
+	if n.ExecutionRequests != nil {
+		requests, err := n.ExecutionRequests.Encode()
+		if err != nil {
+			return err
+		}
+		if blockHash, err = gethprimitives.HeaderHashWithRequests(
+			block.Header(), gethprimitives.ExecutionHash(
+				RequestsHash(requests),
+			),
+		); err != nil {
+			return err
+		}
+	}
+
+	if common.ExecutionHash(blockHash) != payload.GetBlockHash() {
 		return errors.Wrapf(ErrPayloadBlockHashMismatch,
 			"%x, got %x",
-			payload.GetBlockHash(), block.Hash(),
+			payload.GetBlockHash(), blockHash,
 		)
 	}
 	return nil
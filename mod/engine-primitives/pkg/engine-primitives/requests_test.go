@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -107,6 +108,8 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
+		version.Deneb,
 		optimistic,
 	)
 
@@ -114,6 +117,8 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 	require.Equal(t, executionPayload, request.ExecutionPayload)
 	require.Equal(t, versionedHashes, request.VersionedHashes)
 	require.Equal(t, &parentBeaconBlockRoot, request.ParentBeaconBlockRoot)
+	require.Nil(t, request.ExecutionRequests)
+	require.Equal(t, version.Deneb, request.ForkVersion)
 	require.Equal(t, optimistic, request.Optimistic)
 }
 
@@ -155,6 +160,8 @@ func TestHasValidVersionedAndBlockHashesPayloadError(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
+		version.Deneb,
 		optimistic,
 	)
 
@@ -174,6 +181,8 @@ func TestHasValidVersionedAndBlockHashesMismatchedHashes(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
+		version.Deneb,
 		optimistic,
 	)
 
@@ -24,23 +24,30 @@ import (
 	"context"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
 	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
-	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 )
 
-// EngineClient is a struct that holds a pointer to an Eth1Client.
+// EngineClient is a struct that holds the Engine API endpoints of the
+// execution clients, failing over between them in order of priority.
 type EngineClient[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 	PayloadAttributesT PayloadAttributes,
 ] struct {
+	// Client is the client of the primary endpoint, serving all the calls
+	// that are not part of the Engine API.
 	*ethclient.Client[ExecutionPayloadT]
+	// endpoints are the Engine API endpoints, in order of priority.
+	endpoints []*endpoint[ExecutionPayloadT]
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
 	// logger is the logger for the engine client.
@@ -49,13 +56,24 @@ type EngineClient[
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
-	// capabilities is a map of capabilities that the execution client has.
-	capabilities map[string]struct{}
+	// cancel stops the background routines of the engine client.
+	cancel context.CancelFunc
+	// wg tracks the background routines and the broadcasts to the standby
+	// endpoints.
+	wg sync.WaitGroup
+	// pinnedMu protects the pinned payloads.
+	pinnedMu sync.Mutex
+	// pinned maps the ids of the payloads being built to the endpoint
+	// building them, which is the only one able to serve them.
+	pinned map[engineprimitives.PayloadID]*endpoint[ExecutionPayloadT]
+	// pinnedOrder holds the pinned payload ids, oldest first.
+	pinnedOrder []engineprimitives.PayloadID
 }
 
 // New creates a new engine client EngineClient.
-// It takes an Eth1Client as an argument and returns a pointer  to an
-// EngineClient.
+// It dials the primary endpoint with the given JWT secret and each of the
+// configured fallback endpoints with the fallback JWT secret at the same
+// position. Endpoints dialed over IPC do not need a secret.
 func New[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 	PayloadAttributesT PayloadAttributes,
@@ -63,25 +81,38 @@ func New[
 	cfg *Config,
 	logger log.Logger,
 	jwtSecret *jwt.Secret,
+	fallbackJWTSecrets []*jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
+	endpoints := make(
+		[]*endpoint[ExecutionPayloadT], 0, len(cfg.RPCFallbackDialURLs)+1,
+	)
+	endpoints = append(endpoints, newEndpoint[ExecutionPayloadT](
+		cfg.RPCDialURL, jwtSecret, cfg.RPCJWTRefreshInterval,
+	))
+	for i, dialURL := range cfg.RPCFallbackDialURLs {
+		var secret *jwt.Secret
+		if i < len(fallbackJWTSecrets) {
+			secret = fallbackJWTSecrets[i]
+		}
+		endpoints = append(endpoints, newEndpoint[ExecutionPayloadT](
+			dialURL, secret, cfg.RPCJWTRefreshInterval,
+		))
+	}
+
 	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
-		cfg:    cfg,
-		logger: logger,
-		Client: ethclient.New[ExecutionPayloadT](
-			ethclientrpc.NewClient(
-				cfg.RPCDialURL.String(),
-				ethclientrpc.WithJWTSecret(jwtSecret),
-				ethclientrpc.WithJWTRefreshInterval(
-					cfg.RPCJWTRefreshInterval,
-				),
-			)),
-		capabilities: make(map[string]struct{}),
-		eth1ChainID:  eth1ChainID,
-		metrics:      newClientMetrics(telemetrySink, logger),
+		Client:      endpoints[0].Client,
+		endpoints:   endpoints,
+		cfg:         cfg,
+		logger:      logger,
+		eth1ChainID: eth1ChainID,
+		metrics:     newClientMetrics(telemetrySink, logger),
+		pinned: make(
+			map[engineprimitives.PayloadID]*endpoint[ExecutionPayloadT],
+		),
 	}
 }
 
@@ -92,23 +123,32 @@ func (s *EngineClient[
 	return "engine-client"
 }
 
-// Start the engine client.
+// Start the engine client. It blocks until at least one of the endpoints is
+// connected, then keeps checking the health of every endpoint in the
+// background.
 func (s *EngineClient[
 	_, _,
 ]) Start(
 	ctx context.Context,
 ) error {
-	// Start the Client.
-	go s.Client.Start(ctx)
+	// The background routines run until the engine client is stopped.
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	// Start the Clients.
+	for _, e := range s.endpoints {
+		s.spawn(func() { e.Client.Start(ctx) })
+	}
 
 	s.logger.Info(
 		"Initializing connection to the execution client...",
 		"dial_url", s.cfg.RPCDialURL.String(),
+		"num_fallbacks", len(s.cfg.RPCFallbackDialURLs),
 	)
 
 	// If the connection connection succeeds, we can skip the
 	// connection initialization loop.
-	if err := s.verifyChainIDAndConnection(ctx); err == nil {
+	if s.connectEndpoints(ctx) {
+		s.spawn(func() { s.checkHealth(ctx) })
 		return nil
 	}
 
@@ -124,83 +164,138 @@ func (s *EngineClient[
 				"Waiting for execution client to start... 🍺🕔",
 				"dial_url", s.cfg.RPCDialURL,
 			)
-			if err := s.verifyChainIDAndConnection(ctx); err != nil {
-				if errors.Is(err, ErrMismatchedEth1ChainID) {
-					s.logger.Error(err.Error())
-				}
+			if !s.connectEndpoints(ctx) {
 				continue
 			}
+			s.spawn(func() { s.checkHealth(ctx) })
 			return nil
 		}
 	}
 }
 
+// Stop stops the background routines of the engine client, waits for the
+// broadcasts to the standby endpoints to complete and closes the endpoints.
+func (s *EngineClient[
+	_, _,
+]) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	errs := []error{async.WaitContext(ctx, &s.wg)}
+	for _, e := range s.endpoints {
+		errs = append(errs, e.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// Health returns an error if none of the endpoints is healthy.
+func (s *EngineClient[
+	_, _,
+]) Health() error {
+	if !s.IsConnected() {
+		return ErrNoHealthyEndpoint
+	}
+	return nil
+}
+
+// spawn runs f in a goroutine tracked by the engine client.
+func (s *EngineClient[
+	_, _,
+]) spawn(f func()) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		f()
+	}()
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
 
-// verifyChainID dials the execution client and
-// ensures the chain ID is correct.
+// connectEndpoints verifies the connection to every endpoint, returning true
+// if at least one of them is healthy.
 func (s *EngineClient[
 	_, _,
+]) connectEndpoints(ctx context.Context) bool {
+	var connected bool
+	for _, e := range s.endpoints {
+		err := s.verifyChainIDAndConnection(ctx, e)
+		if errors.Is(err, ErrMismatchedEth1ChainID) {
+			s.logger.Error(err.Error())
+		}
+		s.markHealth(e, err == nil)
+		connected = connected || err == nil
+	}
+	return connected
+}
+
+// verifyChainIDAndConnection dials the endpoint, ensures the chain ID is
+// correct and exchanges capabilities with it.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
 ]) verifyChainIDAndConnection(
 	ctx context.Context,
+	e *endpoint[ExecutionPayloadT],
 ) error {
-	var (
-		err     error
-		chainID math.U64
+	chainID, err := s.verifyChainID(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	// Log the chain ID.
+	s.logger.Info(
+		"Connected to execution client 🔌",
+		"dial_url",
+		e.dialURL.String(),
+		"chain_id",
+		chainID.Unwrap(),
+		"required_chain_id",
+		s.eth1ChainID,
 	)
 
-	defer func() {
-		if err != nil {
-			err = s.Client.Close()
-		}
-	}()
+	// Exchange capabilities with the execution client.
+	if _, err = s.exchangeCapabilities(ctx, e); err != nil {
+		s.logger.Error("failed to exchange capabilities", "err", err)
+		return err
+	}
+	return nil
+}
 
+// verifyChainID dials the endpoint and ensures the chain ID is correct. The
+// client of the endpoint is left open on failure: the caller marks the
+// endpoint unhealthy and the calls fail over to the other endpoints.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) verifyChainID(
+	ctx context.Context,
+	e *endpoint[ExecutionPayloadT],
+) (math.U64, error) {
 	// After the initial dial, check to make sure the chain ID is correct.
-	chainID, err = s.Client.ChainID(ctx)
+	chainID, err := e.Client.ChainID(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "401 Unauthorized") {
 			// We always log this error as it is a critical error.
 			s.logger.Error(UnauthenticatedConnectionErrorStr)
 		}
-		return err
+		return 0, err
 	}
 
 	// TODO: consider validating once when config is set or
 	// client is initialized
 	if !s.eth1ChainID.IsUint64() {
-		err = errors.Wrapf(
+		return 0, errors.Wrapf(
 			errors.New("provided chain ID is not uint64"),
 			s.eth1ChainID.String(),
 		)
-		return err
 	}
 	if chainID.Unwrap() != s.eth1ChainID.Uint64() {
-		err = errors.Wrapf(
+		return 0, errors.Wrapf(
 			ErrMismatchedEth1ChainID,
 			"wanted chain ID %d, got %d",
 			s.eth1ChainID,
 			chainID,
 		)
-		return err
 	}
-
-	// Log the chain ID.
-	s.logger.Info(
-		"Connected to execution client 🔌",
-		"dial_url",
-		s.cfg.RPCDialURL.String(),
-		"chain_id",
-		chainID.Unwrap(),
-		"required_chain_id",
-		s.eth1ChainID,
-	)
-
-	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
-		s.logger.Error("failed to exchange capabilities", "err", err)
-		return err
-	}
-	return nil
+	return chainID, nil
 }
@@ -0,0 +1,329 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// testPayloadID is the id of the payloads built by the mock engines.
+var testPayloadID = engineprimitives.PayloadID{0x01}
+
+type testPayload struct {
+	BlockHash common.ExecutionHash `json:"blockHash"`
+}
+
+func (p *testPayload) Empty(uint32) *testPayload { return &testPayload{} }
+
+func (p *testPayload) Version() uint32 { return version.Deneb }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	type payload testPayload
+	return json.Marshal((*payload)(p))
+}
+
+func (p *testPayload) UnmarshalJSON(input []byte) error {
+	type payload testPayload
+	return json.Unmarshal(input, (*payload)(p))
+}
+
+type testAttributes struct {
+	Timestamp string `json:"timestamp"`
+}
+
+func (a *testAttributes) IsNil() bool { return a == nil }
+
+func (a *testAttributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{0x01}
+}
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func (noopSink) SetGauge(string, int64, ...string) {}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+func (noopSink) StartSpan(
+	ctx context.Context, _ string, _ ...any,
+) (context.Context, func(error)) {
+	return ctx, func(error) {}
+}
+
+// mockEngine serves the Engine API over HTTP, standing in for an execution
+// client. It counts the calls it receives and fails every call while down.
+type mockEngine struct {
+	down   atomic.Bool
+	mu     sync.Mutex
+	calls  map[string]int
+	params map[string][]json.RawMessage
+}
+
+func (m *mockEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if m.down.Load() {
+		http.Error(w, "down", http.StatusServiceUnavailable)
+		return
+	}
+	var req struct {
+		ID     int               `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	m.calls[req.Method]++
+	m.params[req.Method] = req.Params
+	m.mu.Unlock()
+
+	valid := engineprimitives.PayloadStatusV1{Status: "VALID"}
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	switch req.Method {
+	case "eth_chainId":
+		resp["result"] = "0x1"
+	case ethclient.ExchangeCapabilities:
+		resp["result"] = ethclient.BeaconKitSupportedCapabilities()
+	case ethclient.NewPayloadMethodV3:
+		resp["result"] = valid
+	case ethclient.ForkchoiceUpdatedMethodV3:
+		resp["result"] = map[string]any{
+			"payloadStatus": valid, "payloadId": testPayloadID,
+		}
+	case ethclient.GetPayloadMethodV3:
+		resp["result"] = map[string]any{
+			"executionPayload": map[string]any{
+				"blockHash": common.ExecutionHash{0x01},
+			},
+			"blockValue": "0x0",
+			"blobsBundle": map[string]any{
+				"commitments": []string{}, "proofs": []string{},
+				"blobs": []string{},
+			},
+		}
+	default:
+		resp["error"] = map[string]any{"code": -32601, "message": "not found"}
+	}
+	//nolint:errcheck // the client reports missing responses.
+	json.NewEncoder(w).Encode(resp)
+}
+
+// count returns the number of calls of the given method received.
+func (m *mockEngine) count(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[method]
+}
+
+// lastParams returns the params of the last call of the given method.
+func (m *mockEngine) lastParams(method string) []json.RawMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.params[method]
+}
+
+// newTestEngineClient starts an engine client dialing the given mock engines,
+// the first one being the primary endpoint.
+func newTestEngineClient(
+	t *testing.T, broadcast bool, engines ...*mockEngine,
+) *client.EngineClient[*testPayload, *testAttributes] {
+	t.Helper()
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	dialURLs := make([]*url.ConnectionURL, len(engines))
+	for i, engine := range engines {
+		engine.calls = make(map[string]int)
+		engine.params = make(map[string][]json.RawMessage)
+		server := httptest.NewServer(engine)
+		t.Cleanup(server.Close)
+		dialURLs[i], err = url.NewFromRaw(server.URL)
+		require.NoError(t, err)
+	}
+
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = dialURLs[0]
+	cfg.RPCFallbackDialURLs = dialURLs[1:]
+	cfg.RPCRetries = 0
+	cfg.RPCTimeout = time.Second
+	cfg.RPCHealthCheckInterval = 20 * time.Millisecond
+	cfg.RPCBroadcast = broadcast
+
+	fallbackSecrets := make([]*jwt.Secret, len(engines)-1)
+	for i := range fallbackSecrets {
+		fallbackSecrets[i] = secret
+	}
+	ec := client.New[*testPayload, *testAttributes](
+		&cfg, noop.NewLogger[any](), secret, fallbackSecrets, noopSink{},
+		big.NewInt(1),
+	)
+	// The context outlives Start, as it stops the health checks.
+	require.NoError(t, ec.Start(context.Background()))
+	t.Cleanup(func() {
+		//nolint:errcheck // the endpoints are closed with the servers.
+		ec.Stop(context.Background())
+	})
+	return ec
+}
+
+func TestEngineClient_Failover(t *testing.T) {
+	primary, fallback := &mockEngine{}, &mockEngine{}
+	ec := newTestEngineClient(t, false, primary, fallback)
+	ctx := context.Background()
+
+	// The primary endpoint serves the calls while it is healthy.
+	_, err := ec.NewPayload(
+		ctx, &testPayload{}, nil, &common.Root{}, nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, primary.count(ethclient.NewPayloadMethodV3))
+	require.Zero(t, fallback.count(ethclient.NewPayloadMethodV3))
+
+	// The fallback endpoint takes over when the primary one fails.
+	primary.down.Store(true)
+	_, err = ec.NewPayload(
+		ctx, &testPayload{}, nil, &common.Root{}, nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, fallback.count(ethclient.NewPayloadMethodV3))
+	require.NoError(t, ec.Health())
+
+	// The call fails once no endpoint is left.
+	fallback.down.Store(true)
+	_, err = ec.NewPayload(
+		ctx, &testPayload{}, nil, &common.Root{}, nil, version.Deneb,
+	)
+	require.Error(t, err)
+	require.ErrorIs(t, ec.Health(), client.ErrNoHealthyEndpoint)
+	require.False(t, ec.IsConnected())
+}
+
+func TestEngineClient_HealthRecovery(t *testing.T) {
+	primary, fallback := &mockEngine{}, &mockEngine{}
+	ec := newTestEngineClient(t, false, primary, fallback)
+	ctx := context.Background()
+
+	primary.down.Store(true)
+	_, err := ec.NewPayload(
+		ctx, &testPayload{}, nil, &common.Root{}, nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, fallback.count(ethclient.NewPayloadMethodV3))
+
+	// Once the health check sees the primary endpoint again, it is
+	// preferred over the fallback one.
+	primary.down.Store(false)
+	require.Eventually(t, func() bool {
+		_, err = ec.NewPayload(
+			ctx, &testPayload{}, nil, &common.Root{}, nil, version.Deneb,
+		)
+		return err == nil && primary.count(ethclient.NewPayloadMethodV3) > 0
+	}, 2*time.Second, 20*time.Millisecond)
+}
+
+func TestEngineClient_Broadcast(t *testing.T) {
+	primary, fallback := &mockEngine{}, &mockEngine{}
+	ec := newTestEngineClient(t, true, primary, fallback)
+	ctx := context.Background()
+
+	_, err := ec.NewPayload(
+		ctx, &testPayload{}, nil, &common.Root{}, nil, version.Deneb,
+	)
+	require.NoError(t, err)
+	_, _, err = ec.ForkchoiceUpdated(
+		ctx, &engineprimitives.ForkchoiceStateV1{},
+		&testAttributes{Timestamp: "0x1"}, version.Deneb,
+	)
+	require.NoError(t, err)
+	// Stopping waits for the broadcasts to complete.
+	require.NoError(t, ec.Stop(ctx))
+
+	for _, engine := range []*mockEngine{primary, fallback} {
+		require.Equal(t, 1, engine.count(ethclient.NewPayloadMethodV3))
+		require.Equal(t, 1, engine.count(ethclient.ForkchoiceUpdatedMethodV3))
+	}
+	// Only the active endpoint is asked to build a payload.
+	params := primary.lastParams(ethclient.ForkchoiceUpdatedMethodV3)
+	require.JSONEq(t, `{"timestamp":"0x1"}`, string(params[1]))
+	params = fallback.lastParams(ethclient.ForkchoiceUpdatedMethodV3)
+	require.JSONEq(t, "null", string(params[1]))
+}
+
+func TestEngineClient_GetPayloadPinned(t *testing.T) {
+	primary, fallback := &mockEngine{}, &mockEngine{}
+	ec := newTestEngineClient(t, false, primary, fallback)
+	ctx := context.Background()
+
+	// The payload is built by the fallback endpoint.
+	primary.down.Store(true)
+	payloadID, _, err := ec.ForkchoiceUpdated(
+		ctx, &engineprimitives.ForkchoiceStateV1{},
+		&testAttributes{Timestamp: "0x1"}, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, testPayloadID, *payloadID)
+	require.Equal(t, 1, fallback.count(ethclient.ForkchoiceUpdatedMethodV3))
+
+	// Wait for the primary endpoint to be healthy again.
+	primary.down.Store(false)
+	require.Eventually(t, func() bool {
+		_, err = ec.NewPayload(
+			ctx, &testPayload{}, nil, &common.Root{}, nil, version.Deneb,
+		)
+		return err == nil && primary.count(ethclient.NewPayloadMethodV3) > 0
+	}, 2*time.Second, 20*time.Millisecond)
+
+	// The payload is still retrieved from the endpoint which built it.
+	_, err = ec.GetPayload(ctx, *payloadID, version.Deneb)
+	require.NoError(t, err)
+	require.Equal(t, 1, fallback.count(ethclient.GetPayloadMethodV3))
+	require.Zero(t, primary.count(ethclient.GetPayloadMethodV3))
+
+	// Payloads built before a restart are requested with failover.
+	_, err = ec.GetPayload(
+		ctx, engineprimitives.PayloadID{0x02}, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, primary.count(ethclient.GetPayloadMethodV3))
+}
@@ -32,6 +32,7 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultRPCHealthCheckInterval  = 5 * time.Second
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -46,7 +47,11 @@ func DefaultConfig() Config {
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCHealthCheckInterval:  defaultRPCHealthCheckInterval,
+		RPCFallbackDialURLs:     []*url.ConnectionURL{},
+		RPCBroadcast:            false,
 		JWTSecretPath:           defaultJWTSecretPath,
+		FallbackJWTSecretPaths:  []string{},
 	}
 }
 
@@ -54,10 +59,11 @@ func DefaultConfig() Config {
 //
 //nolint:lll // struct tags.
 type Config struct {
-	// RPCDialURL is the HTTP url of the execution client JSON-RPC endpoint.
+	// RPCDialURL is the HTTP or IPC url of the execution client JSON-RPC
+	// endpoint.
 	RPCDialURL *url.ConnectionURL `mapstructure:"rpc-dial-url"`
-	// RPCRetries is the number of retries before shutting down consensus
-	// client.
+	// RPCRetries is the number of times a call to the execution client is
+	// retried after failing with a transient error.
 	RPCRetries uint64 `mapstructure:"rpc-retries"`
 	// RPCTimeout is the RPC timeout for execution client calls.
 	RPCTimeout time.Duration `mapstructure:"rpc-timeout"`
@@ -65,6 +71,21 @@ type Config struct {
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCHealthCheckInterval is the interval at which the health of every
+	// execution client endpoint is checked.
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
+	// RPCFallbackDialURLs are the urls of the standby execution client
+	// JSON-RPC endpoints, in order of priority. They are used when the
+	// endpoints before them are unhealthy.
+	RPCFallbackDialURLs []*url.ConnectionURL `mapstructure:"rpc-fallback-dial-urls"`
+	// RPCBroadcast determines if newPayload and forkchoiceUpdated calls are
+	// sent to every healthy endpoint to keep the standby execution clients in
+	// sync. Payloads are only built by the active endpoint.
+	RPCBroadcast bool `mapstructure:"rpc-broadcast"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// FallbackJWTSecretPaths are the paths to the JWT secrets of the fallback
+	// endpoints, matching RPCFallbackDialURLs by position. HTTP fallback
+	// endpoints without a path use the JWT secret at JWTSecretPath.
+	FallbackJWTSecretPaths []string `mapstructure:"fallback-jwt-secret-paths"`
 }
@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/http"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+)
+
+// maxPinnedPayloads is the number of payloads being built for which the
+// endpoint building them is remembered.
+const maxPinnedPayloads = 32
+
+// endpoint is an Engine API endpoint of an execution client.
+type endpoint[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+] struct {
+	*ethclient.Client[ExecutionPayloadT]
+	// dialURL is the url of the endpoint.
+	dialURL *url.ConnectionURL
+	// healthy is true if the endpoint passed its last health check.
+	healthy atomic.Bool
+}
+
+// newEndpoint creates a new endpoint dialing the given url.
+func newEndpoint[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+](
+	dialURL *url.ConnectionURL,
+	jwtSecret *jwt.Secret,
+	jwtRefreshInterval time.Duration,
+) *endpoint[ExecutionPayloadT] {
+	return &endpoint[ExecutionPayloadT]{
+		Client: ethclient.New[ExecutionPayloadT](
+			ethclientrpc.NewClient(
+				dialURL.String(),
+				ethclientrpc.WithJWTSecret(jwtSecret),
+				ethclientrpc.WithJWTRefreshInterval(jwtRefreshInterval),
+			)),
+		dialURL: dialURL,
+	}
+}
+
+// isEndpointFailure returns true if the error is caused by the endpoint being
+// unreachable or unresponsive, rather than by the request itself, in which
+// case the request can be retried against another endpoint.
+func isEndpointFailure(err error) bool {
+	if http.IsTimeoutError(err) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var rpcErr jsonrpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// withFailover calls fn against the healthy endpoints in order of priority,
+// retrying transient errors and failing over to the next endpoint if one is
+// unreachable. It returns the
+// result along with the endpoint that served the request. If no endpoint is
+// known to be healthy, every endpoint is tried.
+func withFailover[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	PayloadAttributesT PayloadAttributes,
+	ResultT any,
+](
+	ctx context.Context,
+	s *EngineClient[ExecutionPayloadT, PayloadAttributesT],
+	method string,
+	fn func(context.Context, *endpoint[ExecutionPayloadT]) (ResultT, error),
+) (ResultT, *endpoint[ExecutionPayloadT], error) {
+	var (
+		result ResultT
+		err    error
+	)
+	for _, e := range s.candidateEndpoints() {
+		result, err = withRetries(ctx, s, method, func(
+			cctx context.Context,
+		) (ResultT, error) {
+			return fn(cctx, e)
+		})
+		if err == nil || !isEndpointFailure(err) || ctx.Err() != nil {
+			return result, e, err
+		}
+
+		s.logger.Warn(
+			"Execution client endpoint failed, failing over",
+			"method", method, "dial_url", e.dialURL.String(), "err", err,
+		)
+		s.markHealth(e, false)
+		s.metrics.incrementFailover(method, e.dialURL.String())
+	}
+	return result, nil, err
+}
+
+// broadcast calls fn against every healthy endpoint other than the given one
+// in the background, keeping the standby execution clients in sync. Errors
+// from standby endpoints are only logged.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) broadcast(
+	ctx context.Context,
+	served *endpoint[ExecutionPayloadT],
+	method string,
+	fn func(context.Context, *endpoint[ExecutionPayloadT]) error,
+) {
+	if !s.cfg.RPCBroadcast {
+		return
+	}
+	// The standby calls must not be cancelled when the request that
+	// triggered them completes.
+	ctx = context.WithoutCancel(ctx)
+	for _, e := range s.endpoints {
+		if e == served || !e.healthy.Load() {
+			continue
+		}
+		s.spawn(func() {
+			cctx, cancel := s.createContextWithTimeout(ctx)
+			defer cancel()
+			if err := fn(cctx, e); err != nil {
+				s.logger.Debug(
+					"Failed to broadcast to standby execution client",
+					"method", method, "dial_url", e.dialURL.String(),
+					"err", err,
+				)
+			}
+		})
+	}
+}
+
+// pinPayload records that the payload with the given id is being built by
+// the given endpoint, forgetting the oldest payloads past maxPinnedPayloads.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) pinPayload(
+	payloadID engineprimitives.PayloadID,
+	e *endpoint[ExecutionPayloadT],
+) {
+	s.pinnedMu.Lock()
+	defer s.pinnedMu.Unlock()
+	if _, ok := s.pinned[payloadID]; !ok {
+		s.pinnedOrder = append(s.pinnedOrder, payloadID)
+	}
+	s.pinned[payloadID] = e
+	for len(s.pinnedOrder) > maxPinnedPayloads {
+		delete(s.pinned, s.pinnedOrder[0])
+		s.pinnedOrder = s.pinnedOrder[1:]
+	}
+}
+
+// pinnedEndpoint returns the endpoint building the payload with the given
+// id, or nil if it is unknown.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) pinnedEndpoint(
+	payloadID engineprimitives.PayloadID,
+) *endpoint[ExecutionPayloadT] {
+	s.pinnedMu.Lock()
+	defer s.pinnedMu.Unlock()
+	return s.pinned[payloadID]
+}
+
+// candidateEndpoints returns the healthy endpoints in order of priority, or
+// every endpoint if none of them is healthy.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) candidateEndpoints() []*endpoint[ExecutionPayloadT] {
+	healthy := make([]*endpoint[ExecutionPayloadT], 0, len(s.endpoints))
+	for _, e := range s.endpoints {
+		if e.healthy.Load() {
+			healthy = append(healthy, e)
+		}
+	}
+	if len(healthy) == 0 {
+		return s.endpoints
+	}
+	return healthy
+}
+
+// IsConnected returns true if at least one of the endpoints is healthy.
+func (s *EngineClient[
+	_, _,
+]) IsConnected() bool {
+	for _, e := range s.endpoints {
+		if e.healthy.Load() {
+			return true
+		}
+	}
+	return false
+}
+
+// markHealth records the health of the endpoint, reporting it through the
+// metrics and logging when the endpoint becomes unhealthy.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) markHealth(e *endpoint[ExecutionPayloadT], healthy bool) {
+	if e.healthy.Swap(healthy) && !healthy {
+		s.logger.Warn(
+			"Execution client endpoint is unhealthy",
+			"dial_url", e.dialURL.String(),
+		)
+	}
+	s.metrics.setEndpointHealth(e.dialURL.String(), healthy)
+}
+
+// checkHealth periodically verifies the connection to every endpoint until
+// the context is cancelled, so that recovered endpoints are used again.
+func (s *EngineClient[
+	_, _,
+]) checkHealth(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.RPCHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, e := range s.endpoints {
+				// Endpoints coming back are verified in full, as they may
+				// have been restarted with a different configuration.
+				var err error
+				if e.healthy.Load() {
+					_, err = s.verifyChainID(ctx, e)
+				} else {
+					err = s.verifyChainIDAndConnection(ctx, e)
+				}
+				s.markHealth(e, err == nil)
+			}
+		}
+	}
+}
@@ -28,6 +28,7 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
 	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
@@ -43,18 +44,27 @@ func (s *EngineClient[
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
-) (*common.ExecutionHash, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+	executionRequests []bytes.Bytes,
+	forkVersion uint32,
+) (_ *common.ExecutionHash, err error) {
+	ctx, endSpan := s.metrics.sink.StartSpan(
+		ctx, "engine.NewPayload", "num_blobs", len(versionedHashes),
 	)
+	defer func() { endSpan(err) }()
+
+	startTime := time.Now()
 	defer s.metrics.measureNewPayloadDuration(startTime)
-	defer cancel()
 
-	// Call the appropriate RPC method based on the payload version.
-	result, err := s.Client.NewPayload(
-		cctx, payload, versionedHashes, parentBeaconBlockRoot,
-	)
+	// Call the appropriate RPC method based on the fork version.
+	newPayload := func(
+		cctx context.Context, e *endpoint[ExecutionPayloadT],
+	) (*engineprimitives.PayloadStatusV1, error) {
+		return e.NewPayload(
+			cctx, payload, versionedHashes, parentBeaconBlockRoot,
+			executionRequests, forkVersion,
+		)
+	}
+	result, served, err := withFailover(ctx, s, "new_payload", newPayload)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementNewPayloadTimeout()
@@ -64,6 +74,12 @@ func (s *EngineClient[
 	if result == nil {
 		return nil, engineerrors.ErrNilPayloadStatus
 	}
+	s.broadcast(ctx, served, "new_payload", func(
+		cctx context.Context, e *endpoint[ExecutionPayloadT],
+	) error {
+		_, bErr := newPayload(cctx, e)
+		return bErr
+	})
 
 	// This case is only true when the payload is invalid, so
 	// `processPayloadStatusResult` below will return an error.
@@ -84,19 +100,22 @@ func (s *EngineClient[
 
 // ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
 func (s *EngineClient[
-	_, PayloadAttributesT,
+	ExecutionPayloadT, PayloadAttributesT,
 ]) ForkchoiceUpdated(
 	ctx context.Context,
 	state *engineprimitives.ForkchoiceStateV1,
 	attrs PayloadAttributesT,
 	forkVersion uint32,
-) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+) (_ *engineprimitives.PayloadID, _ *common.ExecutionHash, err error) {
+	ctx, endSpan := s.metrics.sink.StartSpan(
+		ctx, "engine.ForkchoiceUpdated",
+		"head_block_hash", state.HeadBlockHash,
+		"with_attributes", !attrs.IsNil(),
 	)
+	defer func() { endSpan(err) }()
+
+	startTime := time.Now()
 	defer s.metrics.measureForkchoiceUpdateDuration(startTime)
-	defer cancel()
 
 	// If the suggested fee recipient is not set, log a warning.
 	if !attrs.IsNil() &&
@@ -107,10 +126,11 @@ func (s *EngineClient[
 		)
 	}
 
-	result, err := s.Client.ForkchoiceUpdated(
-		cctx, state, attrs, forkVersion,
-	)
-
+	result, served, err := withFailover(ctx, s, "forkchoice_updated", func(
+		cctx context.Context, e *endpoint[ExecutionPayloadT],
+	) (*engineprimitives.ForkchoiceResponseV1, error) {
+		return e.ForkchoiceUpdated(cctx, state, attrs, forkVersion)
+	})
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementForkchoiceUpdateTimeout()
@@ -120,6 +140,18 @@ func (s *EngineClient[
 	if result == nil {
 		return nil, nil, engineerrors.ErrNilForkchoiceResponse
 	}
+	// The payload can only be retrieved from the endpoint building it.
+	if !attrs.IsNil() && result.PayloadID != nil {
+		s.pinPayload(*result.PayloadID, served)
+	}
+	// Standby execution clients only follow the forkchoice, payloads are
+	// built by the endpoint that served the request.
+	s.broadcast(ctx, served, "forkchoice_updated", func(
+		cctx context.Context, e *endpoint[ExecutionPayloadT],
+	) error {
+		_, bErr := e.ForkchoiceUpdated(cctx, state, nil, forkVersion)
+		return bErr
+	})
 
 	latestValidHash, err := processPayloadStatusResult(&result.PayloadStatus)
 	if err != nil {
@@ -140,16 +172,33 @@ func (s *EngineClient[
 	ctx context.Context,
 	payloadID engineprimitives.PayloadID,
 	forkVersion uint32,
-) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
+) (_ engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], err error) {
+	ctx, endSpan := s.metrics.sink.StartSpan(
+		ctx, "engine.GetPayload", "payload_id", payloadID,
 	)
+	defer func() { endSpan(err) }()
+
+	startTime := time.Now()
 	defer s.metrics.measureGetPayloadDuration(startTime)
-	defer cancel()
 
-	// Call and check for errors.
-	result, err := s.Client.GetPayload(cctx, payloadID, forkVersion)
+	getPayload := func(
+		cctx context.Context, e *endpoint[ExecutionPayloadT],
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+		return e.GetPayload(cctx, payloadID, forkVersion)
+	}
+
+	// The payload is requested from the endpoint building it, failing over
+	// would only ask endpoints which do not know about it.
+	var result engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT]
+	if e := s.pinnedEndpoint(payloadID); e != nil {
+		result, err = withRetries(ctx, s, "get_payload", func(
+			cctx context.Context,
+		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+			return getPayload(cctx, e)
+		})
+	} else {
+		result, _, err = withFailover(ctx, s, "get_payload", getPayload)
+	}
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementGetPayloadTimeout()
@@ -167,13 +216,29 @@ func (s *EngineClient[
 }
 
 // ExchangeCapabilities calls the engine_exchangeCapabilities method via
-// JSON-RPC.
+// JSON-RPC on the active endpoint.
 func (s *EngineClient[
-	_, _,
+	ExecutionPayloadT, _,
 ]) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Client.ExchangeCapabilities(
+	result, _, err := withFailover(ctx, s, "exchange_capabilities", func(
+		cctx context.Context, e *endpoint[ExecutionPayloadT],
+	) ([]string, error) {
+		return s.exchangeCapabilities(cctx, e)
+	})
+	return result, err
+}
+
+// exchangeCapabilities exchanges capabilities with the endpoint, logging the
+// capabilities it does not support.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) exchangeCapabilities(
+	ctx context.Context,
+	e *endpoint[ExecutionPayloadT],
+) ([]string, error) {
+	result, err := e.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
@@ -181,16 +246,18 @@ func (s *EngineClient[
 	}
 
 	// Capture and log the capabilities that the execution client has.
+	capabilities := make(map[string]struct{}, len(result))
 	for _, capability := range result {
 		s.logger.Info("Exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+		capabilities[capability] = struct{}{}
 	}
 
 	// Log the capabilities that the execution client does not have.
 	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if _, exists := s.capabilities[capability]; !exists {
+		if _, exists := capabilities[capability]; !exists {
 			s.logger.Warn(
 				"Your execution client may require an update 🚸",
+				"dial_url", e.dialURL.String(),
 				"unsupported_capability", capability,
 			)
 		}
@@ -50,6 +50,10 @@ var (
 	// ErrFailedToRefreshJWT indicates that the JWT could not be refreshed.
 	ErrFailedToRefreshJWT = errors.New("failed to refresh auth token")
 
+	// ErrNoHealthyEndpoint indicates that none of the endpoints of the
+	// execution clients is healthy.
+	ErrNoHealthyEndpoint = errors.New("no healthy execution client endpoint")
+
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -33,20 +34,28 @@ import (
 /*                                 NewPayload                                 */
 /* -------------------------------------------------------------------------- */
 
-// NewPayload calls the engine_newPayloadV3 method via JSON-RPC.
+// NewPayload is a helper function to call the appropriate version of the
+// engine_newPayload method, as selected by the given fork version.
 func (s *Client[ExecutionPayloadT]) NewPayload(
 	ctx context.Context,
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+	forkVersion uint32,
 ) (*engineprimitives.PayloadStatusV1, error) {
-	if payload.Version() < version.Deneb {
+	switch {
+	case forkVersion < version.Deneb:
 		return nil, ErrInvalidVersion
+	case forkVersion < version.Electra:
+		return s.NewPayloadV3(
+			ctx, payload, versionedHashes, parentBlockRoot,
+		)
+	default:
+		return s.NewPayloadV4(
+			ctx, payload, versionedHashes, parentBlockRoot, executionRequests,
+		)
 	}
-
-	return s.NewPayloadV3(
-		ctx, payload, versionedHashes, parentBlockRoot,
-	)
 }
 
 // NewPayloadV3 is used to call the underlying JSON-RPC method for newPayload.
@@ -65,6 +74,29 @@ func (s *Client[ExecutionPayloadT]) NewPayloadV3(
 	return result, nil
 }
 
+// NewPayloadV4 calls the engine_newPayloadV4 method via JSON-RPC.
+func (s *Client[ExecutionPayloadT]) NewPayloadV4(
+	ctx context.Context,
+	payload ExecutionPayloadT,
+	versionedHashes []common.ExecutionHash,
+	parentBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+) (*engineprimitives.PayloadStatusV1, error) {
+	// The execution requests are required from Prague on, even if empty.
+	if executionRequests == nil {
+		executionRequests = make([]bytes.Bytes, 0)
+	}
+
+	result := &engineprimitives.PayloadStatusV1{}
+	if err := s.Call(
+		ctx, result, NewPayloadMethodV4, payload, versionedHashes,
+		parentBlockRoot, executionRequests,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                              ForkchoiceUpdated                             */
 /* -------------------------------------------------------------------------- */
@@ -127,16 +159,38 @@ func (s *Client[ExecutionPayloadT]) GetPayload(
 	payloadID engineprimitives.PayloadID,
 	forkVersion uint32,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	if forkVersion < version.Deneb {
+	switch {
+	case forkVersion < version.Deneb:
 		return nil, ErrInvalidVersion
+	case forkVersion < version.Electra:
+		return s.GetPayloadV3(ctx, payloadID)
+	default:
+		return s.GetPayloadV4(ctx, payloadID)
 	}
-
-	return s.GetPayloadV3(ctx, payloadID)
 }
 
 // GetPayloadV3 calls the engine_getPayloadV3 method via JSON-RPC.
 func (s *Client[ExecutionPayloadT]) GetPayloadV3(
 	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return s.getPayload(ctx, GetPayloadMethodV3, payloadID, version.Deneb)
+}
+
+// GetPayloadV4 calls the engine_getPayloadV4 method via JSON-RPC. Along with
+// the payload, it returns the execution requests of the payload.
+func (s *Client[ExecutionPayloadT]) GetPayloadV4(
+	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return s.getPayload(ctx, GetPayloadMethodV4, payloadID, version.Electra)
+}
+
+// getPayload is a helper function to call any version of the getPayload
+// method, decoding the payload for the given fork version.
+func (s *Client[ExecutionPayloadT]) getPayload(
+	ctx context.Context,
+	method string,
+	payloadID engineprimitives.PayloadID,
+	forkVersion uint32,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	var t ExecutionPayloadT
 	result := &engineprimitives.ExecutionPayloadEnvelope[
@@ -145,12 +199,10 @@ func (s *Client[ExecutionPayloadT]) GetPayloadV3(
 			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
 		],
 	]{
-		ExecutionPayload: t.Empty(version.Deneb),
+		ExecutionPayload: t.Empty(forkVersion),
 	}
 
-	if err := s.Call(
-		ctx, result, GetPayloadMethodV3, payloadID,
-	); err != nil {
+	if err := s.Call(ctx, result, method, payloadID); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ethclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// testPayload is a minimal execution payload which keeps track of the fork
+// version it was created for.
+type testPayload struct {
+	forkVersion uint32
+	BlockHash   common.ExecutionHash `json:"blockHash"`
+}
+
+func (p *testPayload) Empty(forkVersion uint32) *testPayload {
+	return &testPayload{forkVersion: forkVersion}
+}
+
+func (p *testPayload) Version() uint32 { return p.forkVersion }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	type payload testPayload
+	return json.Marshal((*payload)(p))
+}
+
+func (p *testPayload) UnmarshalJSON(input []byte) error {
+	type payload testPayload
+	return json.Unmarshal(input, (*payload)(p))
+}
+
+// mockEngine serves the Engine API over HTTP, standing in for an execution
+// client. It records the calls it receives and answers getPayload calls
+// with the given execution requests.
+type mockEngine struct {
+	mu       sync.Mutex
+	method   string
+	params   []json.RawMessage
+	requests []bytes.Bytes
+}
+
+func (m *mockEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     int               `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	m.method, m.params = req.Method, req.Params
+	m.mu.Unlock()
+
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	envelope := map[string]any{
+		"executionPayload": map[string]any{"blockHash": common.ExecutionHash{0x01}},
+		"blockValue":       "0x0",
+		"blobsBundle": map[string]any{
+			"commitments": []string{}, "proofs": []string{}, "blobs": []string{},
+		},
+	}
+	switch req.Method {
+	case ethclient.NewPayloadMethodV3, ethclient.NewPayloadMethodV4:
+		resp["result"] = engineprimitives.PayloadStatusV1{Status: "VALID"}
+	case ethclient.GetPayloadMethodV3:
+		resp["result"] = envelope
+	case ethclient.GetPayloadMethodV4:
+		envelope["executionRequests"] = m.requests
+		resp["result"] = envelope
+	default:
+		resp["error"] = rpc.Error{Code: -32601, Message: "not found"}
+	}
+	//nolint:errcheck // the client reports missing responses.
+	json.NewEncoder(w).Encode(resp)
+}
+
+// lastCall returns the method and params of the last call received.
+func (m *mockEngine) lastCall() (string, []json.RawMessage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.method, m.params
+}
+
+func newTestClient(
+	t *testing.T, engine *mockEngine,
+) *ethclient.Client[*testPayload] {
+	t.Helper()
+	server := httptest.NewServer(engine)
+	t.Cleanup(server.Close)
+	return ethclient.New[*testPayload](rpc.NewClient(server.URL))
+}
+
+func testEncodedRequests(t *testing.T) []bytes.Bytes {
+	t.Helper()
+	encoded, err := (&engineprimitives.ExecutionRequests{
+		Withdrawals: []*engineprimitives.WithdrawalRequest{{
+			SourceAddress:   common.ExecutionAddress{0x01},
+			ValidatorPubkey: crypto.BLSPubkey{0x02},
+		}},
+	}).Encode()
+	require.NoError(t, err)
+	return encoded
+}
+
+func TestNewPayload(t *testing.T) {
+	var (
+		engine   = &mockEngine{}
+		client   = newTestClient(t, engine)
+		requests = testEncodedRequests(t)
+		root     = common.Root{0x01}
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Before Electra, there are no execution requests to send.
+	status, err := client.NewPayload(
+		ctx, (*testPayload)(nil).Empty(version.Deneb), nil, &root, nil,
+		version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "VALID", status.Status)
+	method, params := engine.lastCall()
+	require.Equal(t, ethclient.NewPayloadMethodV3, method)
+	require.Len(t, params, 3)
+
+	// From Electra on, they are sent as the last param.
+	_, err = client.NewPayload(
+		ctx, (*testPayload)(nil).Empty(version.Electra), nil, &root, requests,
+		version.Electra,
+	)
+	require.NoError(t, err)
+	method, params = engine.lastCall()
+	require.Equal(t, ethclient.NewPayloadMethodV4, method)
+	require.Len(t, params, 4)
+	var sent []bytes.Bytes
+	require.NoError(t, json.Unmarshal(params[3], &sent))
+	require.Equal(t, requests, sent)
+
+	// Empty execution requests are sent as an empty list.
+	_, err = client.NewPayload(
+		ctx, (*testPayload)(nil).Empty(version.Electra), nil, &root,
+		[]bytes.Bytes{}, version.Electra,
+	)
+	require.NoError(t, err)
+	_, params = engine.lastCall()
+	require.JSONEq(t, "[]", string(params[3]))
+
+	// The version is selected by the fork version, not by the
+	// presence of execution requests.
+	_, err = client.NewPayload(
+		ctx, (*testPayload)(nil).Empty(version.Electra), nil, &root, nil,
+		version.Electra,
+	)
+	require.NoError(t, err)
+	method, params = engine.lastCall()
+	require.Equal(t, ethclient.NewPayloadMethodV4, method)
+	require.JSONEq(t, "[]", string(params[3]))
+	_, err = client.NewPayload(
+		ctx, (*testPayload)(nil).Empty(version.DenebPlus), nil, &root,
+		[]bytes.Bytes{}, version.DenebPlus,
+	)
+	require.NoError(t, err)
+	method, _ = engine.lastCall()
+	require.Equal(t, ethclient.NewPayloadMethodV3, method)
+
+	// Payloads before Deneb are not supported.
+	_, err = client.NewPayload(
+		ctx, (*testPayload)(nil).Empty(version.Capella), nil, &root, nil,
+		version.Capella,
+	)
+	require.ErrorIs(t, err, ethclient.ErrInvalidVersion)
+}
+
+func TestGetPayload(t *testing.T) {
+	var (
+		requests = testEncodedRequests(t)
+		engine   = &mockEngine{requests: requests}
+		client   = newTestClient(t, engine)
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	env, err := client.GetPayload(
+		ctx, engineprimitives.PayloadID{}, version.Deneb,
+	)
+	require.NoError(t, err)
+	method, _ := engine.lastCall()
+	require.Equal(t, ethclient.GetPayloadMethodV3, method)
+	require.Equal(t, version.Deneb, env.GetExecutionPayload().Version())
+	require.Equal(t,
+		common.ExecutionHash{0x01}, env.GetExecutionPayload().BlockHash,
+	)
+	require.Empty(t, env.GetExecutionRequests())
+
+	env, err = client.GetPayload(
+		ctx, engineprimitives.PayloadID{}, version.Electra,
+	)
+	require.NoError(t, err)
+	method, _ = engine.lastCall()
+	require.Equal(t, ethclient.GetPayloadMethodV4, method)
+	require.Equal(t, version.Electra, env.GetExecutionPayload().Version())
+	require.Equal(t, requests, env.GetExecutionRequests())
+
+	decoded, err := engineprimitives.DecodeExecutionRequests(
+		env.GetExecutionRequests(),
+	)
+	require.NoError(t, err)
+	require.Len(t, decoded.Withdrawals, 1)
+	require.Equal(t,
+		common.ExecutionAddress{0x01}, decoded.Withdrawals[0].SourceAddress,
+	)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 )
@@ -44,8 +45,52 @@ func (ec *Client[ExecutionPayloadT]) ChainID(
 	return result, nil
 }
 
+// HeaderByNumber returns the header of the block with the given number, or
+// the latest header if number is nil.
+func (ec *Client[ExecutionPayloadT]) HeaderByNumber(
+	ctx context.Context,
+	number *big.Int,
+) (*types.Header, error) {
+	var head *types.Header
+	if err := ec.Call(
+		ctx, &head, BlockByNumberMethod, toBlockNumArg(number), false,
+	); err != nil {
+		return nil, err
+	}
+	if head == nil {
+		return nil, ethereum.NotFound
+	}
+	return head, nil
+}
+
 // TODO: Figure out how to unhood all this.
 
+// CodeAt returns the code of the given account as of the given block
+// number, or the latest block if number is nil.
+func (ec *Client[ExecutionPayloadT]) CodeAt(
+	ctx context.Context,
+	account gethcommon.Address,
+	number *big.Int,
+) ([]byte, error) {
+	var result hexutil.Bytes
+	return result, ec.Call(
+		ctx, &result, "eth_getCode", account, toBlockNumArg(number),
+	)
+}
+
+// CallContract executes a message call as of the given block number, or the
+// latest block if number is nil, without creating a transaction.
+func (ec *Client[ExecutionPayloadT]) CallContract(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	number *big.Int,
+) ([]byte, error) {
+	var result hexutil.Bytes
+	return result, ec.Call(
+		ctx, &result, "eth_call", toCallArg(msg), toBlockNumArg(number),
+	)
+}
+
 // FilterLogs executes a filter query.
 func (ec *Client[ExecutionPayloadT]) FilterLogs(
 	ctx context.Context,
@@ -93,6 +138,26 @@ func toFilterArg(q ethereum.FilterQuery) (interface{}, error) {
 	return arg, nil
 }
 
+func toCallArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["input"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	return arg
+}
+
 func toBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
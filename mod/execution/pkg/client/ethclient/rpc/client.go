@@ -25,13 +25,18 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 )
 
+// ipcScheme is the URL scheme of endpoints served over a Unix domain socket.
+const ipcScheme = "ipc"
+
 // Client is an Ethereum RPC client that provides a
 // convenient way to interact with an Ethereum node.
 type Client struct {
@@ -39,6 +44,11 @@ type Client struct {
 	url string
 	// client is the HTTP client used to make RPC calls.
 	client *http.Client
+	// ipc is the connection used to make RPC calls if the endpoint is
+	// served over a Unix domain socket, nil otherwise.
+	ipc *ipcConn
+	// nextID is the ID of the next request.
+	nextID atomic.Int64
 	// reqPool is a sync.Pool for reusing RPC request objects.
 	reqPool *sync.Pool
 	// jwtSecret is the JWT secret used for authentication.
@@ -54,15 +64,16 @@ type Client struct {
 	header http.Header
 }
 
-// New create new rpc client with given url.
-func NewClient(url string, options ...func(rpc *Client)) *Client {
+// New create new rpc client with given url. Endpoints with an ipc:// url are
+// called over the Unix domain socket at the url path, without JWT
+// authentication.
+func NewClient(rawURL string, options ...func(rpc *Client)) *Client {
 	rpc := &Client{
-		url:    url,
+		url:    rawURL,
 		client: http.DefaultClient,
 		reqPool: &sync.Pool{
 			New: func() any {
 				return &Request{
-					ID:      1,
 					JSONRPC: "2.0",
 				}
 			},
@@ -70,6 +81,10 @@ func NewClient(url string, options ...func(rpc *Client)) *Client {
 		header: http.Header{"Content-Type": {"application/json"}},
 	}
 
+	if u, err := url.Parse(rawURL); err == nil && u.Scheme == ipcScheme {
+		rpc.ipc = newIPCConn(u.Path)
+	}
+
 	for _, option := range options {
 		option(rpc)
 	}
@@ -77,8 +92,14 @@ func NewClient(url string, options ...func(rpc *Client)) *Client {
 	return rpc
 }
 
-// Start starts the rpc client.
+// Start starts the rpc client, refreshing the JWT token of HTTP endpoints
+// until the context is cancelled.
 func (rpc *Client) Start(ctx context.Context) {
+	// Unix domain sockets are not authenticated.
+	if rpc.ipc != nil {
+		return
+	}
+
 	ticker := time.NewTicker(rpc.jwtRefreshInterval)
 	defer ticker.Stop()
 
@@ -100,6 +121,9 @@ func (rpc *Client) Start(ctx context.Context) {
 
 // Close closes the RPC client.
 func (rpc *Client) Close() error {
+	if rpc.ipc != nil {
+		return rpc.ipc.close()
+	}
 	rpc.client.CloseIdleConnections()
 	return nil
 }
@@ -125,15 +149,27 @@ func (rpc *Client) CallRaw(
 	ctx context.Context, method string, params ...any,
 ) (json.RawMessage, error) {
 	// Pull a request from the pool, we know that it already has the correct
-	// JSONRPC version and ID set.
+	// JSONRPC version set.
 	//nolint:errcheck // this is safe.
 	request := rpc.reqPool.Get().(*Request)
 	defer rpc.reqPool.Put(request)
 
-	// Update the request with the method and params.
+	// Update the request with a unique ID, the method and params.
+	request.ID = int(rpc.nextID.Add(1))
 	request.Method = method
 	request.Params = params
 
+	if rpc.ipc != nil {
+		resp, err := rpc.ipc.call(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Error != nil {
+			return nil, *resp.Error
+		}
+		return resp.Result, nil
+	}
+
 	body, err := json.Marshal(request)
 	if err != nil {
 		return nil, err
@@ -167,8 +203,20 @@ func (rpc *Client) CallRaw(
 		return nil, err
 	}
 
+	failed := response.StatusCode < http.StatusOK ||
+		response.StatusCode >= http.StatusMultipleChoices
 	resp := new(Response)
-	if err = json.Unmarshal(data, resp); err != nil {
+	if err = json.Unmarshal(data, resp); err != nil ||
+		(resp.Error == nil && failed) {
+		// Report the HTTP status of failed requests rather than failing
+		// to decode a response that is not JSON-RPC.
+		if failed {
+			return nil, &HTTPError{
+				StatusCode: response.StatusCode,
+				Status:     response.Status,
+				Body:       data,
+			}
+		}
 		return nil, err
 	}
 
@@ -20,6 +20,25 @@
 
 package rpc
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var ErrNilResponse = errors.New("nil response")
+
+// HTTPError is returned when the RPC endpoint replies with a non 2xx status
+// code and no JSON-RPC error.
+type HTTPError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Status is the HTTP status of the response, e.g. "401 Unauthorized".
+	Status string
+	// Body is the body of the response.
+	Body []byte
+}
+
+// Error returns a formatted error string.
+func (err *HTTPError) Error() string {
+	return fmt.Sprintf("%s: %s", err.Status, err.Body)
+}
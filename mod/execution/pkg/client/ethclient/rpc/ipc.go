@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+)
+
+// ErrIPCConnectionClosed is returned to the pending requests when the IPC
+// connection is closed before they are answered.
+var ErrIPCConnectionClosed = errors.New("ipc connection closed")
+
+// ipcConn multiplexes JSON-RPC requests over a Unix domain socket, matching
+// the responses to the pending requests by ID. The socket is dialed lazily
+// and redialed on the next request after the connection is lost.
+type ipcConn struct {
+	// path is the path of the Unix domain socket.
+	path string
+
+	// mu protects conn and pending.
+	mu sync.Mutex
+	// conn is the current connection, nil if not connected.
+	conn net.Conn
+	// pending are the channels awaiting the response to each request ID.
+	pending map[int]chan *Response
+
+	// writeMu serializes the writes of the requests to the connection.
+	writeMu sync.Mutex
+}
+
+// newIPCConn creates a new IPC connection to the socket at the given path.
+func newIPCConn(path string) *ipcConn {
+	return &ipcConn{
+		path:    path,
+		pending: make(map[int]chan *Response),
+	}
+}
+
+// call sends the request over the socket and waits for its response.
+func (c *ipcConn) call(
+	ctx context.Context, request *Request,
+) (*Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	respCh := make(chan *Response, 1)
+	conn, err := c.register(ctx, request.ID, respCh)
+	if err != nil {
+		return nil, err
+	}
+	defer c.unregister(request.ID)
+
+	c.writeMu.Lock()
+	if deadline, ok := ctx.Deadline(); ok {
+		//nolint:errcheck // the write below reports any failure.
+		conn.SetWriteDeadline(deadline)
+	}
+	_, err = conn.Write(body)
+	c.writeMu.Unlock()
+	if err != nil {
+		c.closeConn(conn)
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	case resp, ok := <-respCh:
+		if !ok {
+			return nil, ErrIPCConnectionClosed
+		}
+		return resp, nil
+	}
+}
+
+// register records the channel awaiting the response to the request ID,
+// dialing the socket if not connected, and returns the connection to write
+// the request to.
+func (c *ipcConn) register(
+	ctx context.Context, id int, respCh chan *Response,
+) (net.Conn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "unix", c.path)
+		if err != nil {
+			return nil, err
+		}
+		c.conn = conn
+		go c.read(conn)
+	}
+	c.pending[id] = respCh
+	return c.conn, nil
+}
+
+// unregister removes the channel awaiting the response to the request ID.
+func (c *ipcConn) unregister(id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.pending, id)
+}
+
+// read decodes the responses from the connection and delivers them to the
+// pending requests until the connection fails.
+func (c *ipcConn) read(conn net.Conn) {
+	defer c.closeConn(conn)
+	decoder := json.NewDecoder(conn)
+	for {
+		resp := new(Response)
+		if err := decoder.Decode(resp); err != nil {
+			return
+		}
+		c.mu.Lock()
+		if respCh, ok := c.pending[resp.ID]; ok {
+			respCh <- resp
+			delete(c.pending, resp.ID)
+		}
+		c.mu.Unlock()
+	}
+}
+
+// closeConn closes the connection if it is still the current one, failing
+// the requests pending on it.
+func (c *ipcConn) closeConn(conn net.Conn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn != conn {
+		return
+	}
+	//nolint:errcheck // the connection is discarded either way.
+	conn.Close()
+	c.conn = nil
+	for id, respCh := range c.pending {
+		close(respCh)
+		delete(c.pending, id)
+	}
+}
+
+// close closes the current connection, if any.
+func (c *ipcConn) close() error {
+	c.mu.Lock()
+	conn := c.conn
+	c.mu.Unlock()
+	if conn != nil {
+		c.closeConn(conn)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+)
+
+// serveIPC serves JSON-RPC requests on a Unix domain socket, standing in for
+// an execution client. Requests are answered in pairs, in reverse order of
+// arrival, so that responses come back out of order.
+func serveIPC(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "engine.ipc")
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go handleIPC(conn)
+		}
+	}()
+	return path
+}
+
+func handleIPC(conn net.Conn) {
+	defer conn.Close()
+	var (
+		decoder = json.NewDecoder(conn)
+		encoder = json.NewEncoder(conn)
+		queued  []rpc.Request
+	)
+	for {
+		var req rpc.Request
+		if err := decoder.Decode(&req); err != nil {
+			return
+		}
+		if queued = append(queued, req); len(queued) < 2 {
+			continue
+		}
+		for i := len(queued) - 1; i >= 0; i-- {
+			resp := map[string]any{"jsonrpc": "2.0", "id": queued[i].ID}
+			switch queued[i].Method {
+			case "echo":
+				resp["result"] = queued[i].Params
+			default:
+				resp["error"] = rpc.Error{Code: -32601, Message: "not found"}
+			}
+			//nolint:errcheck // the client reports missing responses.
+			encoder.Encode(resp)
+		}
+		queued = queued[:0]
+	}
+}
+
+func TestClient_IPC(t *testing.T) {
+	client := rpc.NewClient("ipc://" + serveIPC(t))
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Issue concurrent calls, which the server answers out of order.
+	var (
+		wg      sync.WaitGroup
+		results = make([][]string, 4)
+		errs    = make([]error, 4)
+	)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = client.Call(
+				ctx, &results[i], "echo", string(rune('a'+i)),
+			)
+		}()
+	}
+	wg.Wait()
+	for i := range results {
+		if errs[i] != nil {
+			t.Fatalf("call %d failed: %v", i, errs[i])
+		}
+		if want := string(rune('a' + i)); len(results[i]) != 1 ||
+			results[i][0] != want {
+			t.Fatalf("call %d: got %v, want [%s]", i, results[i], want)
+		}
+	}
+
+	// JSON-RPC errors are returned as such.
+	errCh := make(chan error, 2)
+	for _, method := range []string{"unknown", "echo"} {
+		go func() {
+			var result []string
+			errCh <- client.Call(ctx, &result, method, "x")
+		}()
+	}
+	var numNotFound int
+	for range 2 {
+		err := <-errCh
+		if err == nil {
+			continue
+		}
+		//nolint:errorlint // the client returns the error as is.
+		if rpcErr, ok := err.(rpc.Error); !ok || rpcErr.Code != -32601 {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		numNotFound++
+	}
+	if numNotFound != 1 {
+		t.Fatalf("got %d method not found errors, want 1", numNotFound)
+	}
+}
+
+func TestClient_IPCReconnects(t *testing.T) {
+	path := serveIPC(t)
+	client := rpc.NewClient("ipc://" + path)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	call := func() {
+		errCh := make(chan error, 2)
+		for range 2 {
+			go func() {
+				var result []string
+				errCh <- client.Call(ctx, &result, "echo", "x")
+			}()
+		}
+		for range 2 {
+			if err := <-errCh; err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	call()
+	// Dropping the connection makes the next call redial the socket.
+	if err := client.Close(); err != nil {
+		t.Fatal(err)
+	}
+	call()
+}
+
+func TestClient_IPCContextCancelled(t *testing.T) {
+	client := rpc.NewClient("ipc://" + serveIPC(t))
+	defer client.Close()
+
+	// A lone request is never answered by the test server.
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 50*time.Millisecond,
+	)
+	defer cancel()
+	var result []string
+	if err := client.Call(ctx, &result, "echo", "x"); err == nil {
+		t.Fatal("expected the call to time out")
+	}
+}
@@ -177,6 +177,34 @@ func (cm *clientMetrics) incrementInternalServerErrorCounter() {
 	)
 }
 
+// incrementFailover increments the failover counter for the given method,
+// labelled with the endpoint that failed.
+func (cm *clientMetrics) incrementFailover(method, dialURL string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.failover",
+		"method", method, "endpoint", dialURL,
+	)
+}
+
+// incrementRetry increments the retry counter for the given method.
+func (cm *clientMetrics) incrementRetry(method string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.retry", "method", method,
+	)
+}
+
+// setEndpointHealth reports whether the endpoint is healthy.
+func (cm *clientMetrics) setEndpointHealth(dialURL string, healthy bool) {
+	var value int64
+	if healthy {
+		value = 1
+	}
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.endpoint_healthy",
+		value, "endpoint", dialURL,
+	)
+}
+
 // incrementErrorCounter increments the error counter for
 // the given metric.
 func (cm *clientMetrics) incrementErrorCounter(metricName string) {
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"syscall"
+	"time"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	nethttp "github.com/berachain/beacon-kit/mod/primitives/pkg/net/http"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
+)
+
+const (
+	// retryBaseDelay is the delay before the first retry of a failed call.
+	retryBaseDelay = 100 * time.Millisecond
+	// retryMaxDelay caps the delay between two retries of a failed call.
+	retryMaxDelay = 2 * time.Second
+	// internalErrorCode is the JSON-RPC code of internal errors.
+	internalErrorCode = -32603
+)
+
+// withRetries calls fn, retrying up to the configured number of RPC retries
+// while it fails with a retryable error. Retries are spaced by an exponential
+// backoff with full jitter and never outlive the deadline of the context.
+func withRetries[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	PayloadAttributesT PayloadAttributes,
+	ResultT any,
+](
+	ctx context.Context,
+	s *EngineClient[ExecutionPayloadT, PayloadAttributesT],
+	method string,
+	fn func(context.Context) (ResultT, error),
+) (ResultT, error) {
+	var (
+		result ResultT
+		err    error
+	)
+	for attempt := uint64(0); ; attempt++ {
+		result, err = func() (ResultT, error) {
+			cctx, cancel := s.createContextWithTimeout(ctx)
+			defer cancel()
+			return fn(cctx)
+		}()
+		if err == nil || attempt >= s.cfg.RPCRetries ||
+			!isRetryable(ctx, err) {
+			return result, err
+		}
+
+		delay := backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return result, err
+		}
+		s.metrics.incrementRetry(method)
+		select {
+		case <-ctx.Done():
+			return result, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// backoff returns the delay before the given retry attempt, picked at random
+// between zero and an exponentially growing cap.
+func backoff(attempt uint64) time.Duration {
+	maxDelay := retryBaseDelay
+	for i := uint64(0); i < attempt && maxDelay < retryMaxDelay; i++ {
+		maxDelay <<= 1
+	}
+	maxDelay = min(maxDelay, retryMaxDelay)
+	//#nosec:G404 // the jitter does not need to be cryptographically secure.
+	return rand.N(maxDelay) + 1
+}
+
+// isRetryable returns true if the call failed with a transient error that is
+// safe to retry, i.e. a timeout, a 5xx response, a reset connection or a
+// JSON-RPC internal error. Errors describing an invalid request, such as an
+// invalid forkchoice state, are never retried.
+func isRetryable(ctx context.Context, err error) bool {
+	// The caller gave up, there is no point in retrying.
+	if ctx.Err() != nil {
+		return false
+	}
+	switch {
+	case nethttp.IsTimeoutError(err),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, engineerrors.ErrEngineAPITimeout),
+		errors.Is(err, syscall.ECONNRESET):
+		return true
+	}
+
+	var httpErr *ethclientrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	var rpcErr jsonrpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode() == internalErrorCode
+	}
+	return false
+}
@@ -0,0 +1,280 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+type retryPayload struct{}
+
+func (p *retryPayload) Empty(uint32) *retryPayload { return &retryPayload{} }
+
+func (p *retryPayload) Version() uint32 { return version.Deneb }
+
+func (p *retryPayload) IsNil() bool { return p == nil }
+
+func (p *retryPayload) MarshalJSON() ([]byte, error) {
+	return json.Marshal(nil)
+}
+
+func (p *retryPayload) UnmarshalJSON([]byte) error { return nil }
+
+type retryAttributes struct{}
+
+func (a *retryAttributes) IsNil() bool { return a == nil }
+
+func (a *retryAttributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+
+// retrySink counts the retries reported by the engine client.
+type retrySink struct {
+	retries atomic.Int64
+}
+
+func (s *retrySink) IncrementCounter(string, ...string) { s.retries.Add(1) }
+
+func (s *retrySink) SetGauge(string, int64, ...string) {}
+
+func (s *retrySink) MeasureSince(string, time.Time, ...string) {}
+
+func (s *retrySink) StartSpan(
+	ctx context.Context, _ string, _ ...any,
+) (context.Context, func(error)) {
+	return ctx, func(error) {}
+}
+
+// timeoutError is a network error reporting a timeout.
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "i/o timeout" }
+
+func (timeoutError) Timeout() bool { return true }
+
+// rpcError is a JSON-RPC error with the given code.
+type rpcError int
+
+func (e rpcError) Error() string { return fmt.Sprintf("rpc error %d", e) }
+
+func (e rpcError) ErrorCode() int { return int(e) }
+
+var errFatal = errors.New("fatal")
+
+func newRetryClient(
+	retries uint64,
+) (*EngineClient[*retryPayload, *retryAttributes], *retrySink) {
+	sink := &retrySink{}
+	return &EngineClient[*retryPayload, *retryAttributes]{
+		cfg:     &Config{RPCRetries: retries, RPCTimeout: time.Second},
+		logger:  noop.NewLogger[any](),
+		metrics: newClientMetrics(sink, noop.NewLogger[any]()),
+	}, sink
+}
+
+func TestIsRetryable(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{name: "timeout", err: timeoutError{}, want: true},
+		{
+			name: "deadline exceeded",
+			err:  fmt.Errorf("call: %w", context.DeadlineExceeded),
+			want: true,
+		},
+		{
+			name: "engine API timeout",
+			err:  fmt.Errorf("call: %w", engineerrors.ErrEngineAPITimeout),
+			want: true,
+		},
+		{
+			name: "connection reset",
+			err:  fmt.Errorf("read: %w", syscall.ECONNRESET),
+			want: true,
+		},
+		{
+			name: "5xx response",
+			err: &ethclientrpc.HTTPError{
+				StatusCode: http.StatusServiceUnavailable,
+			},
+			want: true,
+		},
+		{
+			name: "4xx response",
+			err: &ethclientrpc.HTTPError{
+				StatusCode: http.StatusUnauthorized,
+			},
+		},
+		{name: "internal error", err: rpcError(internalErrorCode), want: true},
+		{name: "invalid forkchoice state", err: rpcError(-38002)},
+		{name: "invalid params", err: rpcError(-32602)},
+		{name: "other error", err: errFatal},
+		{name: "caller gave up", ctx: canceled, err: timeoutError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			require.Equal(t, tt.want, isRetryable(ctx, tt.err))
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt  uint64
+		maxDelay time.Duration
+	}{
+		{attempt: 0, maxDelay: retryBaseDelay},
+		{attempt: 1, maxDelay: 2 * retryBaseDelay},
+		{attempt: 2, maxDelay: 4 * retryBaseDelay},
+		{attempt: 4, maxDelay: 16 * retryBaseDelay},
+		{attempt: 5, maxDelay: retryMaxDelay},
+		{attempt: 64, maxDelay: retryMaxDelay},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("attempt %d", tt.attempt), func(t *testing.T) {
+			for range 100 {
+				delay := backoff(tt.attempt)
+				require.Positive(t, delay)
+				require.LessOrEqual(t, delay, tt.maxDelay)
+			}
+		})
+	}
+}
+
+func TestWithRetries(t *testing.T) {
+	retryable := fmt.Errorf("call: %w", engineerrors.ErrEngineAPITimeout)
+	tests := []struct {
+		name    string
+		retries uint64
+		// errs are the errors returned by the successive calls, the calls
+		// after the last one succeed.
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{name: "success", retries: 3, wantCalls: 1},
+		{
+			name:      "retryable then success",
+			retries:   3,
+			errs:      []error{retryable, retryable},
+			wantCalls: 3,
+		},
+		{
+			name:      "fatal",
+			retries:   3,
+			errs:      []error{errFatal},
+			wantCalls: 1,
+			wantErr:   errFatal,
+		},
+		{
+			name:      "fatal after retryable",
+			retries:   3,
+			errs:      []error{retryable, errFatal},
+			wantCalls: 2,
+			wantErr:   errFatal,
+		},
+		{
+			name:      "attempt cap",
+			retries:   2,
+			errs:      []error{retryable, retryable, retryable, retryable},
+			wantCalls: 3,
+			wantErr:   engineerrors.ErrEngineAPITimeout,
+		},
+		{
+			name:      "no retries",
+			retries:   0,
+			errs:      []error{retryable},
+			wantCalls: 1,
+			wantErr:   engineerrors.ErrEngineAPITimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, sink := newRetryClient(tt.retries)
+			var calls int
+			result, err := withRetries(
+				context.Background(), s, "test",
+				func(context.Context) (int, error) {
+					calls++
+					if calls <= len(tt.errs) {
+						return 0, tt.errs[calls-1]
+					}
+					return calls, nil
+				},
+			)
+			require.Equal(t, tt.wantCalls, calls)
+			require.Equal(t, int64(tt.wantCalls-1), sink.retries.Load())
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, calls, result)
+		})
+	}
+}
+
+func TestWithRetries_CanceledDuringBackoff(t *testing.T) {
+	s, _ := newRetryClient(100)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Cancel the context once the first call has failed, while the client
+	// waits to retry it.
+	var calls atomic.Int64
+	start := time.Now()
+	_, err := withRetries(ctx, s, "test",
+		func(context.Context) (int, error) {
+			if calls.Add(1) == 1 {
+				time.AfterFunc(time.Millisecond, cancel)
+			}
+			return 0, engineerrors.ErrEngineAPITimeout
+		},
+	)
+	require.ErrorIs(t, err, engineerrors.ErrEngineAPITimeout)
+	require.Less(t, time.Since(start), retryMaxDelay)
+	require.Less(t, calls.Load(), int64(10))
+}
@@ -21,6 +21,7 @@
 package client
 
 import (
+	"context"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -38,7 +39,15 @@ type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
 	// keys.
 	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+	// StartSpan starts a span for an engine API call, returning the context
+	// carrying it and a function to end it.
+	StartSpan(
+		ctx context.Context, name string, args ...any,
+	) (context.Context, func(error))
 }
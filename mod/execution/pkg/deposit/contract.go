@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 
 	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
 	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/bind"
@@ -40,6 +41,11 @@ type WrappedBeaconDepositContract[
 ] struct {
 	// BeaconDepositContractFilterer is a pointer to the codegen ABI binding.
 	deposit.BeaconDepositContractFilterer
+	// BeaconDepositContractCaller is the codegen binding for the read-only
+	// methods of the contract.
+	deposit.BeaconDepositContractCaller
+	// client is the execution client the contract is read through.
+	client ExecutionClient
 }
 
 // NewWrappedBeaconDepositContract creates a new BeaconDepositContract.
@@ -48,7 +54,7 @@ func NewWrappedBeaconDepositContract[
 	WithdrawalCredentialsT ~[32]byte,
 ](
 	address common.ExecutionAddress,
-	client bind.ContractFilterer,
+	client ExecutionClient,
 ) (*WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
@@ -63,14 +69,49 @@ func NewWrappedBeaconDepositContract[
 		return nil, errors.New("contract must not be nil")
 	}
 
+	caller, err := deposit.NewBeaconDepositContractCaller(
+		gethprimitives.ExecutionAddress(address), client,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &WrappedBeaconDepositContract[
 		DepositT,
 		WithdrawalCredentialsT,
 	]{
 		BeaconDepositContractFilterer: *contract,
+		BeaconDepositContractCaller:   *caller,
+		client:                        client,
 	}, nil
 }
 
+// ReadEth1Data reads the number of deposits made to the deposit contract as
+// of the given execution block, along with the hash of that block.
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) ReadEth1Data(
+	ctx context.Context,
+	blkNum math.U64,
+) (math.U64, common.ExecutionHash, error) {
+	header, err := dc.client.HeaderByNumber(
+		ctx, new(big.Int).SetUint64(blkNum.Unwrap()),
+	)
+	if err != nil {
+		return 0, common.ExecutionHash{}, err
+	}
+
+	count, err := dc.DepositCount(&bind.CallOpts{
+		Context:     ctx,
+		BlockNumber: header.Number,
+	})
+	if err != nil {
+		return 0, common.ExecutionHash{}, err
+	}
+	return math.U64(count), common.ExecutionHash(header.Hash()), nil
+}
+
 // ReadDeposits reads deposits from the deposit contract.
 func (dc *WrappedBeaconDepositContract[
 	DepositT,
@@ -78,12 +119,24 @@ func (dc *WrappedBeaconDepositContract[
 ]) ReadDeposits(
 	ctx context.Context,
 	blkNum math.U64,
+) ([]DepositT, error) {
+	return dc.ReadDepositsInRange(ctx, blkNum, blkNum)
+}
+
+// ReadDepositsInRange reads deposits from the deposit contract for the
+// blocks in [from, to].
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) ReadDepositsInRange(
+	ctx context.Context,
+	from, to math.U64,
 ) ([]DepositT, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
-			Start:   blkNum.Unwrap(),
-			End:     (*uint64)(&blkNum),
+			Start:   from.Unwrap(),
+			End:     (*uint64)(&to),
 		},
 	)
 	if err != nil {
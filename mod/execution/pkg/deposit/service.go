@@ -27,6 +27,7 @@ import (
 	"sync"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -55,11 +56,27 @@ type Service[
 	subFinalizedBlockEvents chan async.Event[BeaconBlockT]
 	// metrics is the metrics for the deposit service.
 	metrics *metrics
-	// mu protects failedBlocks for concurrent access.
+	// mu protects failedBlocks and the restored deposit index for concurrent
+	// access.
 	mu sync.RWMutex
 	// failedBlocks is a map of blocks that failed to be processed
 	// and should be retried.
 	failedBlocks map[math.U64]struct{}
+	// restored is set if the node was restored from a state sync snapshot,
+	// whose state had processed the deposits before restoredDepositIndex.
+	restored bool
+	// restoredDepositIndex is the index of the first deposit not processed
+	// by the restored state.
+	restoredDepositIndex math.U64
+	// backfillOnce ensures the deposit store is backfilled only once, on the
+	// first finalized block after a snapshot restore.
+	backfillOnce sync.Once
+	// stopCh is closed when the service is stopped.
+	stopCh chan struct{}
+	// stopOnce ensures stopCh is closed only once.
+	stopOnce sync.Once
+	// wg tracks the goroutines fetching deposits.
+	wg sync.WaitGroup
 }
 
 // NewService creates a new instance of the Service struct.
@@ -92,6 +109,7 @@ func NewService[
 		subFinalizedBlockEvents: make(chan async.Event[BeaconBlockT]),
 		logger:                  logger,
 		metrics:                 newMetrics(telemetrySink),
+		stopCh:                  make(chan struct{}),
 	}
 }
 
@@ -108,6 +126,7 @@ func (s *Service[
 		return err
 	}
 
+	s.wg.Add(2)
 	// Listen for finalized block events and fetch deposits for the block.
 	go s.eventLoop(ctx)
 
@@ -116,16 +135,65 @@ func (s *Service[
 	return nil
 }
 
+// Stop stops the service, waiting for the deposits being fetched to be
+// stored.
+func (s *Service[
+	_, _, _, _, _,
+]) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+	return async.WaitContext(ctx, &s.wg)
+}
+
+// Health returns an error if the deposits of some blocks failed to be
+// fetched and are waiting to be retried.
+func (s *Service[
+	_, _, _, _, _,
+]) Health() error {
+	if failed := s.getFailedBlocks(); len(failed) > 0 {
+		return errors.Wrapf(errFailedBlocks, "%d blocks", len(failed))
+	}
+	return nil
+}
+
+// SetRestoredDepositIndex records that the node was restored from a state
+// sync snapshot whose state had processed the deposits before the given
+// index. The deposit store of a restored node starts empty, so the deposits
+// from that index on are backfilled on the next finalized block.
+func (s *Service[
+	_, _, _, _, _,
+]) SetRestoredDepositIndex(index math.U64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.restored, s.restoredDepositIndex = true, index
+}
+
+// getRestoredDepositIndex returns the index of the first deposit not
+// processed by the restored state, and false if the node was not restored
+// from a snapshot.
+func (s *Service[
+	_, _, _, _, _,
+]) getRestoredDepositIndex() (math.U64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.restoredDepositIndex, s.restored
+}
+
 // eventLoop starts the main event loop to listen and handle
 // BeaconBlockFinalized events.
 func (s *Service[
 	_, _, _, _, _,
 ]) eventLoop(ctx context.Context) {
+	defer s.wg.Done()
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-s.subFinalizedBlockEvents:
+		case <-s.stopCh:
+			return
+		case event, ok := <-s.subFinalizedBlockEvents:
+			if !ok {
+				return
+			}
 			s.depositFetcher(ctx, event)
 		}
 	}
@@ -161,3 +229,15 @@ func (s *Service[
 	defer s.mu.RUnlock()
 	return slices.Collect(maps.Keys(s.failedBlocks))
 }
+
+// isStopped returns true if the service has been stopped.
+func (s *Service[
+	_, _, _, _, _,
+]) isStopped() bool {
+	select {
+	case <-s.stopCh:
+		return true
+	default:
+		return false
+	}
+}
@@ -22,14 +22,20 @@ package deposit
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
+const (
+	// defaultRetryInterval processes a deposit event.
+	defaultRetryInterval = 20 * time.Second
+	// backfillBlockRange is the number of execution blocks read in a single
+	// request while backfilling the deposit store.
+	backfillBlockRange = 10_000
+)
 
 // depositFetcher returns a function that retrieves the block number from the
 // event and fetches and stores the deposits for that block.
@@ -37,7 +43,92 @@ func (s *Service[
 	BeaconBlockT, _, _, _, _,
 ]) depositFetcher(ctx context.Context, event async.Event[BeaconBlockT]) {
 	blockNum := event.Data().GetBody().GetExecutionPayload().GetNumber()
-	s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+
+	// The blocks within the follow distance of the execution genesis have no
+	// block to follow yet.
+	if blockNum < s.eth1FollowDistance {
+		return
+	}
+	head := blockNum - s.eth1FollowDistance
+
+	// A node restored from a state sync snapshot starts with an empty deposit
+	// store, so the deposits not yet processed by the restored state are
+	// backfilled once in the background.
+	if index, ok := s.getRestoredDepositIndex(); ok {
+		s.backfillOnce.Do(func() {
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				s.backfillDeposits(ctx, head, index)
+			}()
+		})
+	}
+
+	s.fetchAndStoreDeposits(ctx, head)
+}
+
+// backfillDeposits stores the deposits of the execution blocks before head,
+// walking backwards until it reaches deposits that are already stored or the
+// deposit with the given index, from which on the deposits are stored. It
+// stops early, between two ranges, if the service is stopped.
+func (s *Service[
+	_, _, DepositT, _, _,
+]) backfillDeposits(ctx context.Context, head, fromIndex math.U64) {
+	var numDeposits int
+	for end := head; end > 0 && !s.isStopped(); {
+		start := end - min(end, backfillBlockRange)
+		deposits, err := s.dc.ReadDepositsInRange(ctx, start, end-1)
+		if err != nil {
+			s.logger.Error(
+				"Failed to backfill deposits, retrying...",
+				"from", start, "to", end-1, "error", err,
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case <-time.After(defaultRetryInterval):
+				continue
+			}
+		}
+
+		// Deposits are ordered by index, so once the oldest deposit in the
+		// range is known every older block was processed already, either by
+		// the restored state or by the deposit store.
+		if len(deposits) > 0 {
+			oldest := deposits[0].GetIndex()
+			stored := s.isDepositStored(oldest)
+			deposits = slices.DeleteFunc(deposits, func(d DepositT) bool {
+				return d.GetIndex() < fromIndex
+			})
+			if err = s.ds.EnqueueDeposits(deposits); err != nil {
+				s.logger.Error("Failed to store deposits", "error", err)
+				return
+			}
+			numDeposits += len(deposits)
+			if stored || oldest <= fromIndex {
+				break
+			}
+		}
+		end = start
+	}
+
+	if numDeposits > 0 {
+		s.logger.Info(
+			"Backfilled deposits from execution layer",
+			"head", head, "from_index", fromIndex, "deposits", numDeposits,
+		)
+	}
+}
+
+// isDepositStored returns true if the deposit with the given index is in the
+// deposit store.
+func (s *Service[
+	_, _, _, _, _,
+]) isDepositStored(index math.U64) bool {
+	deposits, err := s.ds.GetDepositsByIndex(index.Unwrap(), 1)
+	return err == nil && len(deposits) > 0
 }
 
 // depositCatchupFetcher fetches deposits for blocks that failed to be
@@ -45,12 +136,15 @@ func (s *Service[
 func (s *Service[
 	_, _, _, _, _,
 ]) depositCatchupFetcher(ctx context.Context) {
+	defer s.wg.Done()
 	ticker := time.NewTicker(defaultRetryInterval)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-s.stopCh:
+			return
 		case <-ticker.C:
 			failedBlks := s.getFailedBlocks()
 			if len(failedBlks) == 0 {
@@ -22,7 +22,10 @@ package deposit
 
 import (
 	"context"
+	"math/big"
 
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/bind"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -55,6 +58,18 @@ type BlockEvent[
 	Context() context.Context
 }
 
+// ExecutionClient is the execution client the deposit contract is read
+// through.
+type ExecutionClient interface {
+	bind.ContractCaller
+	bind.ContractFilterer
+	// HeaderByNumber returns the header of the block with the given number.
+	HeaderByNumber(
+		ctx context.Context,
+		number *big.Int,
+	) (*gethprimitives.Header, error)
+}
+
 // ExecutionPayload is an interface for execution payloads.
 type ExecutionPayload interface {
 	GetNumber() math.U64
@@ -67,6 +82,12 @@ type Contract[DepositT any] interface {
 		ctx context.Context,
 		blockNumber math.U64,
 	) ([]DepositT, error)
+	// ReadDepositsInRange reads deposits from the deposit contract for the
+	// blocks in [from, to].
+	ReadDepositsInRange(
+		ctx context.Context,
+		from, to math.U64,
+	) ([]DepositT, error)
 }
 
 // Deposit is an interface for deposits.
@@ -89,6 +110,9 @@ type Store[DepositT any] interface {
 	Prune(index uint64, numPrune uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// GetDepositsByIndex returns the first N deposits starting from the given
+	// index.
+	GetDepositsByIndex(startIndex uint64, numView uint64) ([]DepositT, error)
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
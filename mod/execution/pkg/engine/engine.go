@@ -23,6 +23,7 @@ package engine
 import (
 	"bytes"
 	"context"
+	"sync/atomic"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
@@ -51,6 +52,9 @@ type Engine[
 	logger log.Logger
 	// metrics is the metrics for the engine.
 	metrics *engineMetrics
+	// optimistic is set when the execution client last answered with an
+	// ACCEPTED or SYNCING payload status, and cleared on a VALID one.
+	optimistic atomic.Bool
 }
 
 // New creates a new Engine.
@@ -93,6 +97,18 @@ func (ee *Engine[_, _, _, _]) Start(
 	return nil
 }
 
+// IsConnected returns true if the execution client is reachable.
+func (ee *Engine[_, _, _, _]) IsConnected() bool {
+	return ee.ec.IsConnected()
+}
+
+// IsOptimistic returns true if the execution client has not yet fully
+// validated the latest payload, i.e. its last payload status was ACCEPTED or
+// SYNCING.
+func (ee *Engine[_, _, _, _]) IsOptimistic() bool {
+	return ee.optimistic.Load()
+}
+
 // GetPayload returns the payload and blobs bundle for the given slot.
 func (ee *Engine[
 	ExecutionPayloadT, _, _, _,
@@ -134,6 +150,7 @@ func (ee *Engine[
 		engineerrors.ErrSyncingPayloadStatus,
 	):
 		ee.metrics.markForkchoiceUpdateAcceptedSyncing(req.State, err)
+		ee.optimistic.Store(true)
 		return payloadID, nil, nil
 
 	// If we get invalid payload status, we will need to find a valid
@@ -162,6 +179,7 @@ func (ee *Engine[
 		ee.metrics.markForkchoiceUpdateValid(
 			req.State, hasPayloadAttributes, payloadID,
 		)
+		ee.optimistic.Store(false)
 	}
 
 	// If we reached here, and we have a nil payload ID, we should log a
@@ -204,12 +222,19 @@ func (ee *Engine[
 		return err
 	}
 
+	executionRequests, err := req.ExecutionRequests.Encode()
+	if err != nil {
+		return err
+	}
+
 	// Otherwise we will send the payload to the execution client.
 	lastValidHash, err := ee.ec.NewPayload(
 		ctx,
 		req.ExecutionPayload,
 		req.VersionedHashes,
 		req.ParentBeaconBlockRoot,
+		executionRequests,
+		req.ForkVersion,
 	)
 
 	// We abstract away some of the complexity and categorize status codes
@@ -229,6 +254,7 @@ func (ee *Engine[
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.optimistic.Store(true)
 
 	// These two cases are semantically the same:
 	// https://github.com/ethereum/execution-apis/issues/270
@@ -274,6 +300,7 @@ func (ee *Engine[
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.optimistic.Store(false)
 	}
 
 	// Under the optimistic condition, we are fine ignoring the error. This
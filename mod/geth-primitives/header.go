@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gethprimitives
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// HeaderHashWithRequests returns the hash of the given header extended with
+// the EIP-7685 requests hash, which follows the parent beacon block root in
+// the header from Prague on.
+func HeaderHashWithRequests(
+	header *Header,
+	requestsHash common.Hash,
+) (common.Hash, error) {
+	enc, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	fields, _, err := rlp.SplitList(enc)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	w := rlp.NewEncoderBuffer(nil)
+	list := w.List()
+	if _, err = w.Write(fields); err != nil {
+		return common.Hash{}, err
+	}
+	w.WriteBytes(requestsHash[:])
+	w.ListEnd(list)
+	return crypto.Keccak256Hash(w.ToBytes()), nil
+}
@@ -23,7 +23,9 @@ package bind
 import "github.com/ethereum/go-ethereum/accounts/abi/bind"
 
 type (
+	CallOpts         = bind.CallOpts
 	ContractBackend  = bind.ContractBackend
+	ContractCaller   = bind.ContractCaller
 	ContractFilterer = bind.ContractFilterer
 	FilterOpts       = bind.FilterOpts
 	TransactOpts     = bind.TransactOpts
@@ -62,7 +62,9 @@ type Backend[
 ] struct {
 	sb   StorageBackendT
 	cs   common.ChainSpec
+	ee   ExecutionEngine
 	node NodeT
+	sr   ServiceRegistry
 
 	sp StateProcessor[BeaconStateT]
 }
@@ -101,6 +103,7 @@ func New[
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
 	sp StateProcessor[BeaconStateT],
+	ee ExecutionEngine,
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
@@ -118,6 +121,7 @@ func New[
 		sb: storageBackend,
 		cs: cs,
 		sp: sp,
+		ee: ee,
 	}
 }
 
@@ -129,6 +133,14 @@ func (b *Backend[
 	b.node = node
 }
 
+// AttachServiceRegistry sets the registry of the services of the node on the
+// backend for reporting their health.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) AttachServiceRegistry(sr ServiceRegistry) {
+	b.sr = sr
+}
+
 // ChainSpec returns the chain spec from the backend.
 func (b *Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _,
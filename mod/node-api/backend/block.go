@@ -21,11 +21,39 @@
 package backend
 
 import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	types "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 )
 
+// BlockAtSlot returns the beacon block at the given slot from the block
+// store, resolving an input slot of 0 to the latest slot. The returned error
+// wraps ErrNotFound if no block is stored at the slot.
+func (b Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlockAtSlot(slot math.Slot) (BeaconBlockT, error) {
+	if slot == 0 {
+		_, latestSlot, err := b.stateFromSlotRaw(slot)
+		if err != nil {
+			var blk BeaconBlockT
+			return blk, err
+		}
+		slot = latestSlot
+	}
+
+	blk, err := b.sb.BlockStore().GetBlockBySlot(slot)
+	if errors.Is(err, block.ErrBlockNotFound) {
+		return blk, fmt.Errorf("%w: %w", apitypes.ErrNotFound, err)
+	}
+	return blk, err
+}
+
 // BlockHeader returns the block header at the given slot.
 func (b Backend[
 	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
@@ -42,6 +70,14 @@ func (b Backend[
 	return blockHeader, err
 }
 
+// CommitAtSlot returns the CometBFT commit of the block at the given slot.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) CommitAtSlot(slot math.Slot) (*ctypes.LightClientCommit, error) {
+	//#nosec:G701 // slots fit in an int64.
+	return b.node.BlockCommit(int64(slot.Unwrap()))
+}
+
 // GetBlockRoot returns the root of the block at the given stateID.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	types "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Node is an autogenerated mock type for the Node type
 type Node[ContextT any] struct {
@@ -17,6 +20,64 @@ func (_m *Node[ContextT]) EXPECT() *Node_Expecter[ContextT] {
 	return &Node_Expecter[ContextT]{mock: &_m.Mock}
 }
 
+// BlockCommit provides a mock function with given fields: height
+func (_m *Node[ContextT]) BlockCommit(height int64) (*types.LightClientCommit, error) {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockCommit")
+	}
+
+	var r0 *types.LightClientCommit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (*types.LightClientCommit, error)); ok {
+		return rf(height)
+	}
+	if rf, ok := ret.Get(0).(func(int64) *types.LightClientCommit); ok {
+		r0 = rf(height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.LightClientCommit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Node_BlockCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockCommit'
+type Node_BlockCommit_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// BlockCommit is a helper method to define mock.On call
+//   - height int64
+func (_e *Node_Expecter[ContextT]) BlockCommit(height interface{}) *Node_BlockCommit_Call[ContextT] {
+	return &Node_BlockCommit_Call[ContextT]{Call: _e.mock.On("BlockCommit", height)}
+}
+
+func (_c *Node_BlockCommit_Call[ContextT]) Run(run func(height int64)) *Node_BlockCommit_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *Node_BlockCommit_Call[ContextT]) Return(_a0 *types.LightClientCommit, _a1 error) *Node_BlockCommit_Call[ContextT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Node_BlockCommit_Call[ContextT]) RunAndReturn(run func(int64) (*types.LightClientCommit, error)) *Node_BlockCommit_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateQueryContext provides a mock function with given fields: height, prove
 func (_m *Node[ContextT]) CreateQueryContext(height int64, prove bool) (ContextT, error) {
 	ret := _m.Called(height, prove)
@@ -74,6 +135,141 @@ func (_c *Node_CreateQueryContext_Call[ContextT]) RunAndReturn(run func(int64, b
 	return _c
 }
 
+// IsCatchingUp provides a mock function with given fields:
+func (_m *Node[ContextT]) IsCatchingUp() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCatchingUp")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Node_IsCatchingUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCatchingUp'
+type Node_IsCatchingUp_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// IsCatchingUp is a helper method to define mock.On call
+func (_e *Node_Expecter[ContextT]) IsCatchingUp() *Node_IsCatchingUp_Call[ContextT] {
+	return &Node_IsCatchingUp_Call[ContextT]{Call: _e.mock.On("IsCatchingUp")}
+}
+
+func (_c *Node_IsCatchingUp_Call[ContextT]) Run(run func()) *Node_IsCatchingUp_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Node_IsCatchingUp_Call[ContextT]) Return(_a0 bool) *Node_IsCatchingUp_Call[ContextT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Node_IsCatchingUp_Call[ContextT]) RunAndReturn(run func() bool) *Node_IsCatchingUp_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LastBlockHeight provides a mock function with given fields:
+func (_m *Node[ContextT]) LastBlockHeight() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastBlockHeight")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// Node_LastBlockHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastBlockHeight'
+type Node_LastBlockHeight_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// LastBlockHeight is a helper method to define mock.On call
+func (_e *Node_Expecter[ContextT]) LastBlockHeight() *Node_LastBlockHeight_Call[ContextT] {
+	return &Node_LastBlockHeight_Call[ContextT]{Call: _e.mock.On("LastBlockHeight")}
+}
+
+func (_c *Node_LastBlockHeight_Call[ContextT]) Run(run func()) *Node_LastBlockHeight_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Node_LastBlockHeight_Call[ContextT]) Return(_a0 int64) *Node_LastBlockHeight_Call[ContextT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Node_LastBlockHeight_Call[ContextT]) RunAndReturn(run func() int64) *Node_LastBlockHeight_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PeersLastBlockHeight provides a mock function with given fields:
+func (_m *Node[ContextT]) PeersLastBlockHeight() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PeersLastBlockHeight")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// Node_PeersLastBlockHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PeersLastBlockHeight'
+type Node_PeersLastBlockHeight_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// PeersLastBlockHeight is a helper method to define mock.On call
+func (_e *Node_Expecter[ContextT]) PeersLastBlockHeight() *Node_PeersLastBlockHeight_Call[ContextT] {
+	return &Node_PeersLastBlockHeight_Call[ContextT]{Call: _e.mock.On("PeersLastBlockHeight")}
+}
+
+func (_c *Node_PeersLastBlockHeight_Call[ContextT]) Run(run func()) *Node_PeersLastBlockHeight_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Node_PeersLastBlockHeight_Call[ContextT]) Return(_a0 int64) *Node_PeersLastBlockHeight_Call[ContextT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Node_PeersLastBlockHeight_Call[ContextT]) RunAndReturn(run func() int64) *Node_PeersLastBlockHeight_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNode creates a new instance of Node. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNode[ContextT any](t interface {
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// HeadSlot returns the slot of the latest beacon state.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) HeadSlot() (math.Slot, error) {
+	_, slot, err := b.stateFromSlotRaw(0)
+	return slot, err
+}
+
+// SyncDistance returns the number of blocks the node is behind the most
+// advanced of its peers.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SyncDistance() math.Slot {
+	latest, committed := b.node.PeersLastBlockHeight(), b.node.LastBlockHeight()
+	if latest <= committed {
+		return 0
+	}
+	//#nosec:G701 // checked above.
+	return math.Slot(latest - committed)
+}
+
+// IsSyncing returns true if the node is catching up with the chain. Peers
+// may be a block ahead of a node that is in sync while a block is being
+// committed, so only the catching up status of the consensus engine is used.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) IsSyncing() bool {
+	return b.node.IsCatchingUp()
+}
+
+// IsOptimistic returns true if the execution client has not yet fully
+// validated the latest payload.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) IsOptimistic() bool {
+	return b.ee.IsOptimistic()
+}
+
+// IsELOffline returns true if the execution client cannot be reached.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) IsELOffline() bool {
+	return !b.ee.IsConnected()
+}
+
+// ServicesHealth returns the health of every service of the node by name,
+// nil for the healthy services.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ServicesHealth() map[string]error {
+	if b.sr == nil {
+		return nil
+	}
+	return b.sr.Health()
+}
@@ -34,6 +34,15 @@ func (b *Backend[
 	return b.stateFromSlotRaw(slot)
 }
 
+// StateAtSlot returns the beacon state committed at the given slot, resolving
+// slot 0 to the latest slot. The state is returned as stored, without
+// processing the next slot.
+func (b *Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) StateAtSlot(slot math.Slot) (BeaconStateT, math.Slot, error) {
+	return b.stateFromSlotRaw(slot)
+}
+
 // GetStateRoot returns the root of the state at the given slot.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
@@ -23,6 +23,7 @@ package backend
 import (
 	"context"
 
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -42,6 +43,9 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
+	// GetBlobSidecars returns all the blob sidecars stored for the given
+	// slot.
+	GetBlobSidecars(math.Slot) (BlobSidecarsT, error)
 }
 
 // BeaconBlockHeader is the interface for a beacon block header.
@@ -78,6 +82,8 @@ type BeaconState[
 
 // BlockStore is the interface for block storage.
 type BlockStore[BeaconBlockT any] interface {
+	// GetBlockBySlot retrieves the block at a given slot.
+	GetBlockBySlot(slot math.Slot) (BeaconBlockT, error)
 	// GetSlotByBlockRoot retrieves the slot by a given block root.
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given state root.
@@ -96,11 +102,38 @@ type DepositStore[DepositT any] interface {
 	EnqueueDeposits(deposits []DepositT) error
 }
 
+// ExecutionEngine is the interface for the execution engine.
+type ExecutionEngine interface {
+	// IsConnected returns true if the execution client is reachable.
+	IsConnected() bool
+	// IsOptimistic returns true if the execution client has not yet fully
+	// validated the latest payload.
+	IsOptimistic() bool
+}
+
 // Node is the interface for a node.
 type Node[ContextT any] interface {
+	// BlockCommit returns the commit of the block at the given height.
+	BlockCommit(height int64) (*ctypes.LightClientCommit, error)
 	// CreateQueryContext creates a query context for a given height and proof
 	// flag.
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
+	// IsCatchingUp returns true if the node is still syncing blocks from its
+	// peers.
+	IsCatchingUp() bool
+	// LastBlockHeight returns the height of the last committed block.
+	LastBlockHeight() int64
+	// PeersLastBlockHeight returns the greatest last committed block height
+	// among the peers of the node.
+	PeersLastBlockHeight() int64
+}
+
+// ServiceRegistry is the interface for the registry of the services of the
+// node.
+type ServiceRegistry interface {
+	// Health returns the health of every service by name, nil for the
+	// healthy services.
+	Health() map[string]error
 }
 
 type StateProcessor[BeaconStateT any] interface {
@@ -96,7 +96,10 @@ func (s *Service[_, _]) eventLoop(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-s.subFinalizedBlkEvents:
+		case event, ok := <-s.subFinalizedBlkEvents:
+			if !ok {
+				return
+			}
 			s.onFinalizeBlock(event)
 		}
 	}
@@ -21,6 +21,8 @@
 package echo
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/labstack/echo/v4"
@@ -31,12 +33,18 @@ import (
 type Engine struct {
 	*echo.Echo
 	logger log.Logger
+	// shutdownCtx is cancelled when the server starts shutting down, ending
+	// the event streams which would otherwise hold the shutdown up.
+	shutdownCtx context.Context
 }
 
 // New initializes a new API engine with the given Echo instance.
 func New(e *echo.Echo) *Engine {
+	shutdownCtx, cancel := context.WithCancel(context.Background())
+	e.Server.RegisterOnShutdown(cancel)
 	return &Engine{
-		Echo: e,
+		Echo:        e,
+		shutdownCtx: shutdownCtx,
 	}
 }
 
@@ -70,7 +78,7 @@ func (e *Engine) RegisterRoutes(
 		group.Add(
 			route.Method,
 			route.Path,
-			responseMiddleware(route),
+			responseMiddleware(e.shutdownCtx, route),
 		)
 	}
 }
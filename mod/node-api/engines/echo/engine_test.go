@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingStream is an event stream which never ends on its own.
+type blockingStream struct{}
+
+func (blockingStream) Stream(ctx context.Context, _ io.Writer, _ func()) error {
+	<-ctx.Done()
+	return nil
+}
+
+func TestEngine_ShutdownEndsEventStreams(t *testing.T) {
+	engine := echo.NewDefaultEngine()
+	engine.RegisterRoutes(handlers.NewRouteSet("", &handlers.Route[echo.Context]{
+		Method: http.MethodGet,
+		Path:   "/events",
+		Handler: func(echo.Context) (any, error) {
+			return blockingStream{}, nil
+		},
+	}), noop.NewLogger[any]())
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- engine.Run("127.0.0.1:0") }()
+	require.Eventually(t, func() bool {
+		return engine.ListenerAddr() != nil
+	}, time.Second, 10*time.Millisecond)
+	addr := engine.ListenerAddr()
+
+	resp, err := http.Get("http://" + addr.String() + "/events")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The shutdown does not wait for the client to disconnect.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, engine.Shutdown(ctx))
+	require.ErrorIs(t, <-errCh, http.ErrServerClosed)
+	_, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+}
@@ -21,7 +21,11 @@
 package echo
 
 import (
+	"context"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
@@ -29,6 +33,10 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// consensusVersionHeader is the header used to report the fork of a
+// versioned response.
+const consensusVersionHeader = "Eth-Consensus-Version"
+
 // ErrorResponse is a response that is returned when an error occurs.
 type ErrorResponse struct {
 	Code    int    `json:"code"`
@@ -36,17 +44,99 @@ type ErrorResponse struct {
 }
 
 // responseMiddleware is a middleware that converts errors to an HTTP status
-// code and response.
+// code and response. Event streams are served until the shutdown context is
+// cancelled.
 func responseMiddleware(
+	shutdownCtx context.Context,
 	handler *handlers.Route[Context],
 ) echo.HandlerFunc {
 	return func(c Context) error {
 		data, err := handler.Handler(c)
+		if stream, ok := data.(types.EventStream); ok && err == nil {
+			return eventStreamResponse(shutdownCtx, c, stream)
+		}
+		if status, ok := data.(types.StatusResponse); ok && err == nil {
+			return c.NoContent(status.StatusCode())
+		}
 		code, response := responseFromError(data, err)
+		if code == http.StatusOK && acceptsSSZ(c.Request()) {
+			return sszResponse(c, response)
+		}
 		return c.JSON(code, response)
 	}
 }
 
+// acceptsSSZ returns true if the client prefers an SSZ encoded response, i.e.
+// if application/octet-stream has the highest quality value of the media
+// ranges in the Accept header. Ties go to the media range listed first.
+func acceptsSSZ(req *http.Request) bool {
+	var (
+		preferred string
+		bestQ     float64
+	)
+	for _, mediaRange := range strings.Split(
+		req.Header.Get(echo.HeaderAccept), ",",
+	) {
+		mediaType, params, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if raw, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(raw, 64); err != nil {
+				continue
+			}
+		}
+		if q > bestQ {
+			preferred, bestQ = mediaType, q
+		}
+	}
+	return preferred == echo.MIMEOctetStream
+}
+
+// sszResponse writes the SSZ encoding of the response. Responses without an
+// SSZ encoding are written as JSON, while failing to encode the response is
+// reported as a JSON error.
+func sszResponse(c Context, response any) error {
+	marshaler, ok := response.(types.SSZMarshaler)
+	if !ok {
+		return c.JSON(http.StatusOK, response)
+	}
+	bz, err := marshaler.MarshalSSZ()
+	if err != nil {
+		code, errResponse := responseFromError(nil, err)
+		return c.JSON(code, errResponse)
+	}
+	if versioned, isVersioned := response.(types.VersionedResponse); isVersioned {
+		c.Response().Header().Set(
+			consensusVersionHeader, versioned.ConsensusVersion(),
+		)
+	}
+	return c.Blob(http.StatusOK, echo.MIMEOctetStream, bz)
+}
+
+// eventStreamResponse serves the stream as server-sent events until the client
+// disconnects, the stream ends or the server shuts down.
+func eventStreamResponse(
+	shutdownCtx context.Context, c Context, stream types.EventStream,
+) error {
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	header.Set(echo.HeaderConnection, "keep-alive")
+	c.Response().WriteHeader(http.StatusOK)
+	c.Response().Flush()
+
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+	defer context.AfterFunc(shutdownCtx, cancel)()
+
+	// The response is already committed, so errors ending the stream (e.g.
+	// the client being dropped for falling behind) cannot be reported.
+	_ = stream.Stream(ctx, c.Response(), c.Response().Flush)
+	return nil
+}
+
 // responseFromErr converts an error to an HTTP status code and response. If
 // the error is nil, the response is returned as is.
 func responseFromError(data any, err error) (int, any) {
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -68,6 +69,9 @@ func ConstructValidator() *validator.Validate {
 		"epoch":            ValidateUint64,
 		"slot":             ValidateUint64,
 		"validator_status": ValidateValidatorStatus,
+		"uint64":           ValidateUint64,
+		"event_topic":      ValidateEventTopic,
+		"root":             ValidateRootField,
 	}
 	validate := validator.New()
 	for tag, fn := range validators {
@@ -150,6 +154,11 @@ func ValidateRoot(value string) bool {
 	return err == nil
 }
 
+// ValidateRootField checks if the provided field is a valid root.
+func ValidateRootField(fl validator.FieldLevel) bool {
+	return ValidateRoot(fl.Field().String())
+}
+
 func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 	// Eth Beacon Node API specs: https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
 	allowedStatuses := map[string]bool{
@@ -166,6 +175,24 @@ func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 	return validateAllowedStrings(fl.Field().String(), allowedStatuses)
 }
 
+// ValidateEventTopic checks if the provided field is a supported event topic
+// or a comma separated list of supported event topics.
+func ValidateEventTopic(fl validator.FieldLevel) bool {
+	allowedTopics := map[string]bool{
+		"head":                 true,
+		"block":                true,
+		"finalized_checkpoint": true,
+		"blob_sidecar":         true,
+		"payload_attributes":   true,
+	}
+	for _, topic := range strings.Split(fl.Field().String(), ",") {
+		if !allowedTopics[topic] {
+			return false
+		}
+	}
+	return true
+}
+
 func validateAllowedStrings(
 	value string,
 	allowedValues map[string]bool,
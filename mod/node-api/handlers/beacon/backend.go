@@ -21,15 +21,19 @@
 package beacon
 
 import (
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // Backend is the interface for backend of the beacon API.
-type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
+type Backend[
+	BeaconBlockT, BlockHeaderT, BlobSidecarsT, ForkT, ValidatorT any,
+] interface {
 	GenesisBackend
-	BlockBackend[BlockHeaderT]
+	BlockBackend[BeaconBlockT, BlockHeaderT]
+	BlobBackend[BlobSidecarsT]
 	RandaoBackend
 	StateBackend[ForkT]
 	ValidatorBackend[ValidatorT]
@@ -38,6 +42,36 @@ type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given root from the store.
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
+	// ChainSpec returns the chain spec of the node.
+	ChainSpec() common.ChainSpec
+}
+
+// LightClientBackend is the interface for the light client subsystem backing
+// the light client endpoints of the beacon API.
+type LightClientBackend interface {
+	// Bootstrap returns the light client bootstrap of the block with the
+	// given root.
+	Bootstrap(blockRoot common.Root) (*ctypes.LightClientBootstrap, error)
+	// Updates returns the light client updates of up to count sync periods
+	// starting at the given period.
+	Updates(
+		startPeriod math.U64, count uint64,
+	) ([]*ctypes.LightClientUpdate, error)
+	// FinalityUpdate returns the light client finality update of the
+	// latest finalized block.
+	FinalityUpdate() (*ctypes.LightClientFinalityUpdate, error)
+	// OptimisticUpdate returns the light client optimistic update of the
+	// latest finalized block.
+	OptimisticUpdate() (*ctypes.LightClientOptimisticUpdate, error)
+}
+
+// VoluntaryExitPool is the interface for the pool of pending voluntary exits
+// backing the voluntary exit pool endpoints of the beacon API.
+type VoluntaryExitPool interface {
+	// Add verifies the voluntary exit and inserts it into the pool.
+	Add(exit *ctypes.SignedVoluntaryExit) error
+	// Get returns the pending voluntary exits.
+	Get() []*ctypes.SignedVoluntaryExit
 }
 
 type GenesisBackend interface {
@@ -53,12 +87,17 @@ type RandaoBackend interface {
 	RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 }
 
-type BlockBackend[BeaconBlockHeaderT any] interface {
+type BlockBackend[BeaconBlockT, BeaconBlockHeaderT any] interface {
+	BlockAtSlot(slot math.Slot) (BeaconBlockT, error)
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
 }
 
+type BlobBackend[BlobSidecarsT any] interface {
+	BlobSidecarsAtSlot(slot math.Slot) (BlobSidecarsT, error)
+}
+
 type StateBackend[ForkT any] interface {
 	StateRootAtSlot(slot math.Slot) (common.Root, error)
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
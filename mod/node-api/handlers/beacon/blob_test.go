@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon_test
+
+import (
+	"fmt"
+	"testing"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func (backend) BlobSidecarsAtSlot(slot math.Slot) (sidecars, error) {
+	if slot != storedSlot {
+		return nil, fmt.Errorf("%w: slot %d", types.ErrNotFound, slot)
+	}
+	return sidecars{{index: 0}, {index: 1}, {index: 2}}, nil
+}
+
+// blobSidecarsContext is a request context which binds the given block ID
+// and indices.
+type blobSidecarsContext struct {
+	blockID string
+	indices []string
+}
+
+func (c blobSidecarsContext) Bind(v any) error {
+	//nolint:errcheck // the handler binds a blob sidecars request.
+	req := v.(*beacontypes.BlobSidecarRequest)
+	req.BlockID = c.blockID
+	req.Indices = c.indices
+	return nil
+}
+
+func (blobSidecarsContext) Validate(any) error { return nil }
+
+func TestGetBlobSidecars(t *testing.T) {
+	h := newHandler()
+
+	res, err := h.GetBlobSidecars(blobSidecarsContext{
+		blockID: "3", indices: []string{"0", "2"},
+	})
+	require.NoError(t, err)
+	data := res.(beacontypes.BlobSidecarsResponse[header]).Data
+	require.Len(t, data, 2)
+	require.Equal(t, uint64(0), data[0].Index)
+	require.Equal(t, uint64(2), data[1].Index)
+}
+
+func TestGetBlobSidecars_Errors(t *testing.T) {
+	h := newHandler()
+
+	tests := []struct {
+		name    string
+		ctx     blobSidecarsContext
+		wantErr error
+	}{
+		{
+			name:    "malformed index",
+			ctx:     blobSidecarsContext{blockID: "3", indices: []string{""}},
+			wantErr: types.ErrInvalidRequest,
+		},
+		{
+			name: "index out of range",
+			ctx: blobSidecarsContext{
+				blockID: "3", indices: []string{"18446744073709551616"},
+			},
+			wantErr: types.ErrInvalidRequest,
+		},
+		{
+			name:    "missing block",
+			ctx:     blobSidecarsContext{blockID: "4"},
+			wantErr: types.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.GetBlobSidecars(tt.ctx)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -23,9 +23,108 @@ package beacon
 import (
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
+// GetBlock returns the beacon block identified by the given block ID.
+func (h *Handler[
+	BeaconBlockT, _, _, _, _, ContextT, _, _,
+]) GetBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.backend.BlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.BlockResponse{
+		Version: version.Name(blk.Version()),
+		ValidatorResponse: beacontypes.ValidatorResponse{
+			ExecutionOptimistic: false, // stubbed
+			Finalized:           false, // stubbed
+			Data: &beacontypes.SignedBeaconBlock[BeaconBlockT]{
+				Message:   blk,
+				Signature: crypto.BLSSignature{}, // blocks are unsigned
+			},
+		},
+	}, nil
+}
+
+// GetBlockRoot returns the hash tree root of the beacon block identified by
+// the given block ID.
+func (h *Handler[
+	_, _, _, _, _, ContextT, _, _,
+]) GetBlockRoot(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlockRootRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.backend.BlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data: beacontypes.RootData{
+			Root: blk.HashTreeRoot(),
+		},
+	}, nil
+}
+
+// GetBlindedBlock returns the beacon block identified by the given block ID,
+// with its execution payload replaced by the execution payload header.
+func (h *Handler[
+	_, _, BlindedBeaconBlockT, _, _, ContextT, _, _,
+]) GetBlindedBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlindedBlockRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.backend.BlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	blinded, err := blk.Blind(h.backend.ChainSpec().DepositEth1ChainID())
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.BlockResponse{
+		Version: version.Name(blk.Version()),
+		ValidatorResponse: beacontypes.ValidatorResponse{
+			ExecutionOptimistic: false, // stubbed
+			Finalized:           false, // stubbed
+			Data: &beacontypes.SignedBeaconBlock[BlindedBeaconBlockT]{
+				Message:   blinded,
+				Signature: crypto.BLSSignature{}, // blocks are unsigned
+			},
+		},
+	}, nil
+}
+
+func (h *Handler[
+	_, _, _, _, _, ContextT, _, _,
+]) GetBlockRewards(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockRewardsRequest](
 		c, h.Logger(),
 	)
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// storedSlot is the only slot the test backend holds a block for.
+const storedSlot math.Slot = 3
+
+type blindedBlock struct{ slot math.Slot }
+
+func (b blindedBlock) MarshalSSZ() ([]byte, error) {
+	return []byte{byte(b.slot)}, nil
+}
+
+type block struct{ slot math.Slot }
+
+func (b block) MarshalSSZ() ([]byte, error) { return []byte{byte(b.slot)}, nil }
+
+func (b block) HashTreeRoot() common.Root { return common.Root{byte(b.slot)} }
+
+func (block) Version() uint32 { return 0 }
+
+func (b block) Blind(uint64) (blindedBlock, error) {
+	return blindedBlock(b), nil
+}
+
+type header struct{}
+
+func (header) GetBodyRoot() common.Root { return common.Root{} }
+
+type sidecar struct{ index uint64 }
+
+func (s *sidecar) MarshalSSZ() ([]byte, error) {
+	return []byte{byte(s.index)}, nil
+}
+
+func (s *sidecar) GetIndex() uint64 { return s.index }
+
+func (*sidecar) GetBlob() eip4844.Blob { return eip4844.Blob{} }
+
+func (*sidecar) GetKzgCommitment() eip4844.KZGCommitment {
+	return eip4844.KZGCommitment{}
+}
+
+func (*sidecar) GetKzgProof() eip4844.KZGProof { return eip4844.KZGProof{} }
+
+func (*sidecar) GetBeaconBlockHeader() header { return header{} }
+
+func (*sidecar) GetInclusionProof() []common.Root { return nil }
+
+type sidecars []*sidecar
+
+func (s sidecars) GetSidecars() []*sidecar { return s }
+
+// backend serves a single block, at storedSlot. The methods the tests do not
+// call are left to the embedded interface.
+type backend struct {
+	beacon.Backend[block, header, sidecars, any, any]
+}
+
+func (backend) BlockAtSlot(slot math.Slot) (block, error) {
+	if slot != storedSlot {
+		return block{}, fmt.Errorf("%w: slot %d", types.ErrNotFound, slot)
+	}
+	return block{slot: slot}, nil
+}
+
+func newHandler() *beacon.Handler[
+	block, header, blindedBlock, *sidecar, sidecars, context.Context, any, any,
+] {
+	h := beacon.NewHandler[
+		block, header, blindedBlock, *sidecar, sidecars, context.Context,
+		any, any,
+	](backend{}, nil, nil)
+	h.RegisterRoutes(noop.NewLogger[any]())
+	return h
+}
+
+// blockIDContext is a request context which binds the given block ID.
+type blockIDContext string
+
+func (c blockIDContext) Bind(v any) error {
+	switch req := v.(type) {
+	case *beacontypes.GetBlocksRequest:
+		req.BlockID = string(c)
+	case *beacontypes.GetBlockRootRequest:
+		req.BlockID = string(c)
+	case *beacontypes.GetBlindedBlockRequest:
+		req.BlockID = string(c)
+	}
+	return nil
+}
+
+func (blockIDContext) Validate(any) error { return nil }
+
+func TestGetBlock(t *testing.T) {
+	h := newHandler()
+
+	res, err := h.GetBlock(blockIDContext("3"))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&beacontypes.SignedBeaconBlock[block]{Message: block{slot: 3}},
+		res.(beacontypes.BlockResponse).Data,
+	)
+
+	root, err := h.GetBlockRoot(blockIDContext("3"))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		beacontypes.RootData{Root: common.Root{3}},
+		root.(beacontypes.ValidatorResponse).Data,
+	)
+}
+
+func TestGetBlock_NotFound(t *testing.T) {
+	h := newHandler()
+
+	_, err := h.GetBlock(blockIDContext("4"))
+	require.ErrorIs(t, err, types.ErrNotFound)
+	_, err = h.GetBlockRoot(blockIDContext("4"))
+	require.ErrorIs(t, err, types.ErrNotFound)
+	_, err = h.GetBlindedBlock(blockIDContext("4"))
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
@@ -28,29 +28,53 @@ import (
 
 // Handler is the handler for the beacon API.
 type Handler[
+	BeaconBlockT types.BeaconBlock[BlindedBeaconBlockT],
 	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BlindedBeaconBlockT types.BlindedBeaconBlock,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
 	ContextT context.Context,
 	ForkT any,
 	ValidatorT any,
 ] struct {
 	*handlers.BaseHandler[ContextT]
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT]
+	backend Backend[
+		BeaconBlockT, BeaconBlockHeaderT, BlobSidecarsT, ForkT, ValidatorT,
+	]
+	lightClient LightClientBackend
+	exitPool    VoluntaryExitPool
 }
 
 // NewHandler creates a new handler for the beacon API.
 func NewHandler[
+	BeaconBlockT types.BeaconBlock[BlindedBeaconBlockT],
 	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BlindedBeaconBlockT types.BlindedBeaconBlock,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
 	ContextT context.Context,
 	ForkT any,
 	ValidatorT any,
 ](
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT],
-) *Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT] {
-	h := &Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT]{
+	backend Backend[
+		BeaconBlockT, BeaconBlockHeaderT, BlobSidecarsT, ForkT, ValidatorT,
+	],
+	lightClient LightClientBackend,
+	exitPool VoluntaryExitPool,
+) *Handler[
+	BeaconBlockT, BeaconBlockHeaderT, BlindedBeaconBlockT, BlobSidecarT,
+	BlobSidecarsT, ContextT, ForkT, ValidatorT,
+] {
+	h := &Handler[
+		BeaconBlockT, BeaconBlockHeaderT, BlindedBeaconBlockT, BlobSidecarT,
+		BlobSidecarsT, ContextT, ForkT, ValidatorT,
+	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
-		backend: backend,
+		backend:     backend,
+		lightClient: lightClient,
+		exitPool:    exitPool,
 	}
 	return h
 }
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"fmt"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// GetLightClientBootstrap returns the light client bootstrap of the block
+// with the given root, as JSON or as SSZ if requested with an
+// application/octet-stream Accept header.
+func (h *Handler[
+	_, _, _, _, _, ContextT, _, _,
+]) GetLightClientBootstrap(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.LightClientBootstrapRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	blockRoot, err := common.NewRootFromHex(req.BlockRoot)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", types.ErrInvalidRequest, err)
+	}
+	bootstrap, err := h.lightClient.Bootstrap(blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewLightClientResponse(
+		h.backend.ChainSpec().ActiveForkVersionForSlot(bootstrap.GetSlot()),
+		bootstrap,
+	), nil
+}
+
+// GetLightClientUpdates returns the light client updates of up to count sync
+// periods starting at the given period.
+func (h *Handler[
+	_, _, _, _, _, ContextT, _, _,
+]) GetLightClientUpdates(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.LightClientUpdatesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	startPeriod, err := utils.U64FromString(req.StartPeriod)
+	if err != nil {
+		return nil, err
+	}
+	count, err := utils.U64FromString(req.Count)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := h.lightClient.Updates(startPeriod, count.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+	chainSpec := h.backend.ChainSpec()
+	resp := make(beacontypes.LightClientUpdatesResponse, len(updates))
+	for i, update := range updates {
+		resp[i] = beacontypes.NewLightClientResponse(
+			chainSpec.ActiveForkVersionForSlot(update.GetSlot()), update,
+		)
+	}
+	return resp, nil
+}
+
+// GetLightClientFinalityUpdate returns the light client finality update of
+// the latest finalized block.
+func (h *Handler[
+	_, _, _, _, _, ContextT, _, _,
+]) GetLightClientFinalityUpdate(ContextT) (any, error) {
+	update, err := h.lightClient.FinalityUpdate()
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewLightClientResponse(
+		h.backend.ChainSpec().ActiveForkVersionForSlot(update.GetSlot()),
+		update,
+	), nil
+}
+
+// GetLightClientOptimisticUpdate returns the light client optimistic update
+// of the latest finalized block.
+func (h *Handler[
+	_, _, _, _, _, ContextT, _, _,
+]) GetLightClientOptimisticUpdate(ContextT) (any, error) {
+	update, err := h.lightClient.OptimisticUpdate()
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewLightClientResponse(
+		h.backend.ChainSpec().ActiveForkVersionForSlot(update.GetSlot()),
+		update,
+	), nil
+}
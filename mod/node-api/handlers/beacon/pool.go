@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetPoolVoluntaryExits returns the voluntary exits pending in the pool.
+func (h *Handler[
+	_, _, _, _, _, ContextT, _, _,
+]) GetPoolVoluntaryExits(ContextT) (any, error) {
+	return types.Wrap(h.exitPool.Get()), nil
+}
+
+// PostPoolVoluntaryExits verifies the submitted voluntary exit against the
+// head state and inserts it into the pool.
+func (h *Handler[
+	_, _, _, _, _, ContextT, _, _,
+]) PostPoolVoluntaryExits(c ContextT) (any, error) {
+	exit, err := utils.BindAndValidate[ctypes.SignedVoluntaryExit](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if exit.Message == nil {
+		return nil, types.ErrInvalidRequest
+	}
+	if err = h.exitPool.Add(&exit); err != nil {
+		return nil, fmt.Errorf("%w: %w", types.ErrInvalidRequest, err)
+	}
+	return nil, nil //nolint:nilnil // the endpoint has no response body.
+}
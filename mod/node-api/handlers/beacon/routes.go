@@ -28,7 +28,9 @@ import (
 )
 
 //nolint:funlen // routes are long
-func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
+func (h *Handler[
+	_, _, _, _, _, ContextT, _, _,
+]) RegisterRoutes(
 	logger log.Logger,
 ) {
 	h.SetLogger(logger)
@@ -125,13 +127,13 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		},
 		{
 			Method:  http.MethodGet,
-			Path:    "eth/v2/beacon/blocks/:block_id",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v2/beacon/blocks/:block_id",
+			Handler: h.GetBlock,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blocks/:block_id/root",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlockRoot,
 		},
 		{
 			Method:  http.MethodGet,
@@ -141,7 +143,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blob_sidecars/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlobSidecars,
 		},
 		{
 			Method:  http.MethodPost,
@@ -161,27 +163,27 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blinded_blocks/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlindedBlock,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/bootstrap/:block_root",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientBootstrap,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/updates",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientUpdates,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/finality_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientFinalityUpdate,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/optimistic_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientOptimisticUpdate,
 		},
 		{
 			Method:  http.MethodGet,
@@ -221,12 +223,12 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.GetPoolVoluntaryExits,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.PostPoolVoluntaryExits,
 		},
 		{
 			Method:  http.MethodGet,
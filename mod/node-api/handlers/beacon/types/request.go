@@ -176,3 +176,12 @@ type BlobSidecarRequest struct {
 	types.BlockIDRequest
 	Indices []string `query:"indices" validate:"dive,uint64"`
 }
+
+type LightClientBootstrapRequest struct {
+	BlockRoot string `param:"block_root" validate:"required,root"`
+}
+
+type LightClientUpdatesRequest struct {
+	StartPeriod string `query:"start_period" validate:"required,uint64"`
+	Count       string `query:"count"        validate:"required,uint64"`
+}
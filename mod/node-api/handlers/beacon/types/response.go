@@ -21,10 +21,21 @@
 package types
 
 import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
+// ErrSSZNotSupported is returned when a response is requested as SSZ but its
+// payload cannot be SSZ encoded.
+var ErrSSZNotSupported = errors.New("response does not support SSZ encoding")
+
 type ValidatorResponse struct {
 	ExecutionOptimistic bool `json:"execution_optimistic"`
 	Finalized           bool `json:"finalized"`
@@ -36,6 +47,43 @@ type BlockResponse struct {
 	ValidatorResponse
 }
 
+// ConsensusVersion returns the name of the fork the block belongs to.
+func (r BlockResponse) ConsensusVersion() string {
+	return r.Version
+}
+
+// MarshalSSZ returns the SSZ encoding of the response data.
+func (r BlockResponse) MarshalSSZ() ([]byte, error) {
+	data, ok := r.Data.(types.SSZMarshaler)
+	if !ok {
+		return nil, ErrSSZNotSupported
+	}
+	return data.MarshalSSZ()
+}
+
+// SignedBeaconBlock wraps a (possibly blinded) beacon block in the signed
+// envelope expected by Beacon API clients. BeaconKit blocks are not signed
+// by their proposer, so the signature is always empty.
+type SignedBeaconBlock[BeaconBlockT types.SSZMarshaler] struct {
+	Message   BeaconBlockT        `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// MarshalSSZ returns the SSZ encoding of the SignedBeaconBlock container,
+// i.e. the offset of the message, the signature and then the message itself.
+func (b *SignedBeaconBlock[_]) MarshalSSZ() ([]byte, error) {
+	const fixedSize = 4 + len(crypto.BLSSignature{})
+	message, err := b.Message.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, fixedSize, fixedSize+len(message))
+	binary.LittleEndian.PutUint32(buf[:4], uint32(fixedSize))
+	copy(buf[4:], b.Signature[:])
+	return append(buf, message...), nil
+}
+
 type BlockHeaderResponse[BlockHeaderT any] struct {
 	Root      common.Root                `json:"root"`
 	Canonical bool                       `json:"canonical"`
@@ -47,6 +95,58 @@ type BlockHeader[BlockHeaderT any] struct {
 	Signature bytes.B48    `json:"signature"`
 }
 
+type BlobSidecarsResponse[BlockHeaderT any] struct {
+	ExecutionOptimistic bool                     `json:"execution_optimistic"`
+	Finalized           bool                     `json:"finalized"`
+	Data                []*Sidecar[BlockHeaderT] `json:"data"`
+}
+
+// MarshalSSZ returns the SSZ encoding of the list of sidecars, which is the
+// concatenation of the encodings of each sidecar.
+func (r BlobSidecarsResponse[_]) MarshalSSZ() ([]byte, error) {
+	var buf []byte
+	for _, sidecar := range r.Data {
+		bz, err := sidecar.sidecar.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, bz...)
+	}
+	return buf, nil
+}
+
+//nolint:lll // tags get long
+type Sidecar[BlockHeaderT any] struct {
+	Index                       uint64                     `json:"index,string"`
+	Blob                        eip4844.Blob               `json:"blob"`
+	KzgCommitment               eip4844.KZGCommitment      `json:"kzg_commitment"`
+	KzgProof                    eip4844.KZGProof           `json:"kzg_proof"`
+	SignedBlockHeader           *BlockHeader[BlockHeaderT] `json:"signed_block_header"`
+	KzgCommitmentInclusionProof []common.Root              `json:"kzg_commitment_inclusion_proof"`
+
+	// sidecar is the sidecar the response was built from, kept around to
+	// serve SSZ encoded responses.
+	sidecar types.SSZMarshaler
+}
+
+// NewSidecar builds the API representation of the given blob sidecar.
+func NewSidecar[BlockHeaderT any](
+	sidecar BlobSidecar[BlockHeaderT],
+) *Sidecar[BlockHeaderT] {
+	return &Sidecar[BlockHeaderT]{
+		Index:         sidecar.GetIndex(),
+		Blob:          sidecar.GetBlob(),
+		KzgCommitment: sidecar.GetKzgCommitment(),
+		KzgProof:      sidecar.GetKzgProof(),
+		SignedBlockHeader: &BlockHeader[BlockHeaderT]{
+			Message:   sidecar.GetBeaconBlockHeader(),
+			Signature: bytes.B48{}, // TODO: implement
+		},
+		KzgCommitmentInclusionProof: sidecar.GetInclusionProof(),
+		sidecar:                     sidecar,
+	}
+}
+
 type GenesisData struct {
 	GenesisTime           string      `json:"genesis_time"`
 	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
@@ -83,3 +183,64 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+// LightClientResponse is the response of the light client endpoints, holding
+// a light client object along with the name of the fork it belongs to.
+type LightClientResponse struct {
+	Version string             `json:"version"`
+	Data    types.SSZMarshaler `json:"data"`
+
+	// forkVersion is the version of the fork the object belongs to, kept
+	// around to serve SSZ encoded update lists.
+	forkVersion uint32
+}
+
+// NewLightClientResponse builds the response for the given light client
+// object of the given fork version.
+func NewLightClientResponse(
+	forkVersion uint32, data types.SSZMarshaler,
+) LightClientResponse {
+	return LightClientResponse{
+		Version:     version.Name(forkVersion),
+		Data:        data,
+		forkVersion: forkVersion,
+	}
+}
+
+// ConsensusVersion returns the name of the fork the object belongs to.
+func (r LightClientResponse) ConsensusVersion() string {
+	return r.Version
+}
+
+// MarshalSSZ returns the SSZ encoding of the light client object.
+func (r LightClientResponse) MarshalSSZ() ([]byte, error) {
+	return r.Data.MarshalSSZ()
+}
+
+// LightClientUpdatesResponse is the response of the light client updates
+// endpoint.
+type LightClientUpdatesResponse []LightClientResponse
+
+// MarshalSSZ returns the SSZ encoding of the updates as a sequence of
+// response chunks. Each chunk is made of the little-endian uint64 length of
+// the rest of the chunk, the 4 byte fork version of the update as context,
+// and the SSZ encoding of the update.
+func (r LightClientUpdatesResponse) MarshalSSZ() ([]byte, error) {
+	const contextSize = 4
+	var buf []byte
+	for _, update := range r {
+		bz, err := update.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		buf = binary.LittleEndian.AppendUint64(
+			buf, uint64(contextSize+len(bz)),
+		)
+		forkVersion := version.FromUint32[[contextSize]byte](
+			update.forkVersion,
+		)
+		buf = append(buf, forkVersion[:]...)
+		buf = append(buf, bz...)
+	}
+	return buf, nil
+}
@@ -20,9 +20,42 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+)
+
+// BeaconBlock is the interface for the beacon block.
+type BeaconBlock[BlindedBeaconBlockT any] interface {
+	types.SSZMarshaler
+	HashTreeRoot() common.Root
+	Version() uint32
+	Blind(eth1ChainID uint64) (BlindedBeaconBlockT, error)
+}
+
+// BlindedBeaconBlock is the interface for the blinded beacon block.
+type BlindedBeaconBlock interface {
+	types.SSZMarshaler
+}
 
 // BeaconBlockHeader is the interface for the beacon block header.
 type BeaconBlockHeader interface {
 	GetBodyRoot() common.Root
 }
+
+// BlobSidecars is the interface for the blob sidecars of a block.
+type BlobSidecars[BlobSidecarT any] interface {
+	GetSidecars() []BlobSidecarT
+}
+
+// BlobSidecar is the interface for a blob sidecar.
+type BlobSidecar[BeaconBlockHeaderT any] interface {
+	types.SSZMarshaler
+	GetIndex() uint64
+	GetBlob() eip4844.Blob
+	GetKzgCommitment() eip4844.KZGCommitment
+	GetKzgProof() eip4844.KZGProof
+	GetBeaconBlockHeader() BeaconBlockHeaderT
+	GetInclusionProof() []common.Root
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"strconv"
+	"strings"
+
+	configtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GetSpec returns the chain spec as the key/value pairs expected by Beacon
+// API clients, with all values rendered as strings.
+func (h *Handler[ContextT]) GetSpec(ContextT) (any, error) {
+	cs := h.chainSpec
+	spec := map[string]string{
+		// Gwei values.
+		"MIN_DEPOSIT_AMOUNT":          formatUint(cs.MinDepositAmount()),
+		"MAX_EFFECTIVE_BALANCE":       formatUint(cs.MaxEffectiveBalance()),
+		"EJECTION_BALANCE":            formatUint(cs.EjectionBalance()),
+		"EFFECTIVE_BALANCE_INCREMENT": formatUint(cs.EffectiveBalanceIncrement()),
+		// Time parameters.
+		"SLOTS_PER_EPOCH":           formatUint(cs.SlotsPerEpoch()),
+		"SLOTS_PER_HISTORICAL_ROOT": formatUint(cs.SlotsPerHistoricalRoot()),
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY": formatUint(
+			cs.MinEpochsToInactivityPenalty(),
+		),
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY": formatUint(
+			cs.MinValidatorWithdrawabilityDelay(),
+		),
+		// Validator cycle.
+		"MIN_PER_EPOCH_CHURN_LIMIT": formatUint(cs.MinPerEpochChurnLimit()),
+		"CHURN_LIMIT_QUOTIENT":      formatUint(cs.ChurnLimitQuotient()),
+		// Signature domains.
+		"DOMAIN_BEACON_PROPOSER":     cs.DomainTypeProposer().String(),
+		"DOMAIN_BEACON_ATTESTER":     cs.DomainTypeAttester().String(),
+		"DOMAIN_RANDAO":              cs.DomainTypeRandao().String(),
+		"DOMAIN_DEPOSIT":             cs.DomainTypeDeposit().String(),
+		"DOMAIN_VOLUNTARY_EXIT":      cs.DomainTypeVoluntaryExit().String(),
+		"DOMAIN_SELECTION_PROOF":     cs.DomainTypeSelectionProof().String(),
+		"DOMAIN_AGGREGATE_AND_PROOF": cs.DomainTypeAggregateAndProof().String(),
+		"DOMAIN_APPLICATION_MASK":    cs.DomainTypeApplicationMask().String(),
+		// Eth1 values.
+		"DEPOSIT_CONTRACT_ADDRESS": cs.DepositContractAddress().Hex(),
+		"DEPOSIT_CHAIN_ID":         formatUint(cs.DepositEth1ChainID()),
+		"DEPOSIT_NETWORK_ID":       formatUint(cs.DepositEth1ChainID()),
+		"MAX_DEPOSITS":             formatUint(cs.MaxDepositsPerBlock()),
+		"MAX_VOLUNTARY_EXITS":      formatUint(cs.MaxVoluntaryExitsPerBlock()),
+		"ETH1_FOLLOW_DISTANCE":     formatUint(cs.Eth1FollowDistance()),
+		"SECONDS_PER_ETH1_BLOCK":   formatUint(cs.TargetSecondsPerEth1Block()),
+		// State list lengths.
+		"EPOCHS_PER_HISTORICAL_VECTOR": formatUint(
+			cs.EpochsPerHistoricalVector(),
+		),
+		"EPOCHS_PER_SLASHINGS_VECTOR": formatUint(
+			cs.EpochsPerSlashingsVector(),
+		),
+		"HISTORICAL_ROOTS_LIMIT":   formatUint(cs.HistoricalRootsLimit()),
+		"VALIDATOR_REGISTRY_LIMIT": formatUint(cs.ValidatorRegistryLimit()),
+		// Rewards and penalties.
+		"INACTIVITY_PENALTY_QUOTIENT": formatUint(
+			cs.InactivityPenaltyQuotient(),
+		),
+		"PROPORTIONAL_SLASHING_MULTIPLIER": formatUint(
+			cs.ProportionalSlashingMultiplier(),
+		),
+		// Capella values.
+		"MAX_WITHDRAWALS_PER_PAYLOAD": formatUint(
+			cs.MaxWithdrawalsPerPayload(),
+		),
+		"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP": formatUint(
+			cs.MaxValidatorsPerWithdrawalsSweep(),
+		),
+		// Deneb values.
+		"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS": formatUint(
+			cs.MinEpochsForBlobsSidecarsRequest(),
+		),
+		"MAX_BLOB_COMMITMENTS_PER_BLOCK": formatUint(
+			cs.MaxBlobCommitmentsPerBlock(),
+		),
+		"MAX_BLOBS_PER_BLOCK":     formatUint(cs.MaxBlobsPerBlock()),
+		"FIELD_ELEMENTS_PER_BLOB": formatUint(cs.FieldElementsPerBlob()),
+		"BYTES_PER_BLOB":          formatUint(cs.BytesPerBlob()),
+	}
+
+	// Fork versions and epochs, keyed by the upper case fork name.
+	forks := h.forkSchedule()
+	spec["GENESIS_FORK_VERSION"] = forks[0].CurrentVersion.String()
+	for _, fork := range forks {
+		name := strings.ToUpper(
+			version.Name(version.ToUint32(fork.CurrentVersion)),
+		)
+		spec[name+"_FORK_VERSION"] = fork.CurrentVersion.String()
+		spec[name+"_FORK_EPOCH"] = fork.Epoch
+	}
+	return types.Wrap(spec), nil
+}
+
+// GetForkSchedule returns the forks of the chain, from the genesis fork
+// onwards.
+func (h *Handler[ContextT]) GetForkSchedule(ContextT) (any, error) {
+	return types.Wrap(h.forkSchedule()), nil
+}
+
+// GetDepositContract returns the deposit contract address and the chain ID
+// of the execution layer it is deployed on.
+func (h *Handler[ContextT]) GetDepositContract(ContextT) (any, error) {
+	return types.Wrap(configtypes.DepositContract{
+		ChainID: formatUint(h.chainSpec.DepositEth1ChainID()),
+		Address: h.chainSpec.DepositContractAddress(),
+	}), nil
+}
+
+// forkSchedule returns the forks of the chain. The chain starts at Deneb, so
+// the genesis fork is Deneb at epoch 0.
+func (h *Handler[ContextT]) forkSchedule() []configtypes.Fork {
+	forks := []struct {
+		version uint32
+		epoch   math.Epoch
+	}{
+		{version.Deneb, 0},
+		{version.DenebPlus, h.chainSpec.DenebPlusForkEpoch()},
+		{version.Electra, h.chainSpec.ElectraForkEpoch()},
+	}
+
+	schedule := make([]configtypes.Fork, 0, len(forks))
+	previous := version.FromUint32[common.Version](forks[0].version)
+	for _, fork := range forks {
+		current := version.FromUint32[common.Version](fork.version)
+		schedule = append(schedule, configtypes.Fork{
+			PreviousVersion: previous,
+			CurrentVersion:  current,
+			Epoch:           formatUint(fork.epoch.Unwrap()),
+		})
+		previous = current
+	}
+	return schedule
+}
+
+// formatUint formats the value in base 10, as the Beacon API expects.
+func formatUint(value uint64) string {
+	return strconv.FormatUint(value, 10) //nolint:mnd // base 10.
+}
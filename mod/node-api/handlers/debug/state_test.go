@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/debug"
+	debugtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+const headSlot math.Slot = 12
+
+var stateRoot = common.Root{0x01}
+
+type marshallable []byte
+
+func (m marshallable) MarshalSSZ() ([]byte, error) { return m, nil }
+
+type state struct{ slot math.Slot }
+
+func (s state) GetMarshallable() (marshallable, error) {
+	return marshallable{byte(s.slot)}, nil
+}
+
+type chainSpec struct{ common.ChainSpec }
+
+func (chainSpec) ActiveForkVersionForSlot(math.Slot) uint32 {
+	return version.Deneb
+}
+
+type backend struct{}
+
+func (backend) StateAtSlot(slot math.Slot) (state, math.Slot, error) {
+	if slot == 0 {
+		slot = headSlot
+	}
+	return state{slot: slot}, slot, nil
+}
+
+func (backend) GetSlotByStateRoot(root common.Root) (math.Slot, error) {
+	if root != stateRoot {
+		return 0, types.ErrNotFound
+	}
+	return 3, nil
+}
+
+func (backend) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
+	return common.Root{byte(slot)}, nil
+}
+
+func (backend) ChainSpec() common.ChainSpec { return chainSpec{} }
+
+// stateIDContext is a request context which binds the given state ID.
+type stateIDContext string
+
+func (c stateIDContext) Bind(v any) error {
+	//nolint:errcheck // the handler binds a state request.
+	v.(*debugtypes.GetStateRequest).StateID = string(c)
+	return nil
+}
+
+func (stateIDContext) Validate(any) error { return nil }
+
+func TestGetState(t *testing.T) {
+	h := debug.NewHandler[state, marshallable, context.Context](backend{})
+	h.RegisterRoutes(noop.NewLogger[any]())
+
+	tests := []struct {
+		stateID string
+		slot    math.Slot
+	}{
+		{stateID: "head", slot: headSlot},
+		{stateID: "5", slot: 5},
+		{stateID: stateRoot.String(), slot: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.stateID, func(t *testing.T) {
+			res, err := h.GetState(stateIDContext(tt.stateID))
+			require.NoError(t, err)
+			require.Equal(t, debugtypes.StateResponse[marshallable]{
+				Version:   "deneb",
+				Finalized: true,
+				Data:      marshallable{byte(tt.slot)},
+			}, res)
+
+			bz, err := res.(types.SSZMarshaler).MarshalSSZ()
+			require.NoError(t, err)
+			require.Equal(t, []byte{byte(tt.slot)}, bz)
+		})
+	}
+
+	_, err := h.GetState(stateIDContext(common.Root{0x02}.String()))
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestGetHeads(t *testing.T) {
+	h := debug.NewHandler[state, marshallable, context.Context](backend{})
+	res, err := h.GetHeads(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap([]debugtypes.HeadData{
+		{Root: common.Root{byte(headSlot)}, Slot: headSlot},
+	}), res)
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// StateResponse is the response for the
+// `/eth/v2/debug/beacon/states/{state_id}` endpoint.
+type StateResponse[BeaconStateMarshallableT BeaconStateMarshallable] struct {
+	Version             string                   `json:"version"`
+	ExecutionOptimistic bool                     `json:"execution_optimistic"`
+	Finalized           bool                     `json:"finalized"`
+	Data                BeaconStateMarshallableT `json:"data"`
+}
+
+// ConsensusVersion returns the name of the fork the state belongs to.
+func (r StateResponse[_]) ConsensusVersion() string {
+	return r.Version
+}
+
+// MarshalSSZ returns the SSZ encoding of the state.
+func (r StateResponse[_]) MarshalSSZ() ([]byte, error) {
+	return r.Data.MarshalSSZ()
+}
+
+// HeadData is a head of the chain, as returned by the
+// `/eth/v2/debug/beacon/states/heads` endpoint.
+type HeadData struct {
+	Root                common.Root `json:"root"`
+	Slot                math.Slot   `json:"slot"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// defaultClientBufferSize is the number of events buffered for a client
+// before it is considered too slow and disconnected.
+const defaultClientBufferSize = 64
+
+// defaultKeepaliveInterval is the interval at which a comment is written to
+// idle streams, so that proxies and clients do not time them out.
+const defaultKeepaliveInterval = 15 * time.Second
+
+// ErrSlowClient is returned when a client is disconnected because it could not
+// keep up with the events published to it.
+var ErrSlowClient = errors.New("client disconnected: too slow to keep up")
+
+// event is a server-sent event with its JSON encoded data.
+type event struct {
+	topic string
+	data  []byte
+}
+
+// Broadcaster fans events out to the clients subscribed to their topics.
+// Publishing never blocks: a client whose buffer is full is disconnected
+// instead of stalling the publisher and, transitively, the dispatcher.
+type Broadcaster struct {
+	mu                sync.Mutex
+	clients           map[*Subscription]struct{}
+	bufferSize        int
+	keepaliveInterval time.Duration
+}
+
+// NewBroadcaster creates a new Broadcaster buffering up to bufferSize events
+// for each client and keeping their streams alive every keepaliveInterval.
+func NewBroadcaster(
+	bufferSize int,
+	keepaliveInterval time.Duration,
+) *Broadcaster {
+	return &Broadcaster{
+		clients:           make(map[*Subscription]struct{}),
+		bufferSize:        bufferSize,
+		keepaliveInterval: keepaliveInterval,
+	}
+}
+
+// Subscribe registers a new client for the given topics.
+func (b *Broadcaster) Subscribe(topics ...string) *Subscription {
+	sub := &Subscription{
+		topics:      make(map[string]struct{}, len(topics)),
+		events:      make(chan event, b.bufferSize),
+		broadcaster: b,
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = struct{}{}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clients[sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe removes the client from the broadcaster, closing its stream.
+func (b *Broadcaster) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remove(sub)
+}
+
+// HasSubscribers returns true if at least one client is subscribed to the
+// topic.
+func (b *Broadcaster) HasSubscribers(topic string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.clients {
+		if _, ok := sub.topics[topic]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish sends the event data to every client subscribed to the topic. It
+// returns the number of clients disconnected for being too slow.
+func (b *Broadcaster) Publish(topic string, data any) (int, error) {
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return 0, err
+	}
+
+	var dropped int
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.clients {
+		if _, ok := sub.topics[topic]; !ok {
+			continue
+		}
+		select {
+		case sub.events <- event{topic: topic, data: bz}:
+		default:
+			b.remove(sub)
+			dropped++
+		}
+	}
+	return dropped, nil
+}
+
+// remove deletes the client and closes its events channel. It must be called
+// with the lock held.
+func (b *Broadcaster) remove(sub *Subscription) {
+	if _, ok := b.clients[sub]; !ok {
+		return
+	}
+	delete(b.clients, sub)
+	close(sub.events)
+}
+
+// Subscription is a client's stream of events.
+type Subscription struct {
+	topics      map[string]struct{}
+	events      chan event
+	broadcaster *Broadcaster
+}
+
+// Stream writes the events of the subscription to w in the server-sent events
+// format until the context is cancelled or the client is disconnected. A
+// keepalive comment is written whenever the stream has been idle for the
+// keepalive interval.
+func (s *Subscription) Stream(
+	ctx context.Context,
+	w io.Writer,
+	flush func(),
+) error {
+	defer s.broadcaster.Unsubscribe(s)
+	keepalive := time.NewTicker(s.broadcaster.keepaliveInterval)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-keepalive.C:
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return err
+			}
+			flush()
+		case ev, ok := <-s.events:
+			if !ok {
+				return ErrSlowClient
+			}
+			if _, err := fmt.Fprintf(
+				w, "event: %s\ndata: %s\n\n", ev.topic, ev.data,
+			); err != nil {
+				return err
+			}
+			flush()
+			keepalive.Reset(s.broadcaster.keepaliveInterval)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcaster_Publish(t *testing.T) {
+	b := events.NewBroadcaster(4, time.Minute)
+	heads := b.Subscribe("head")
+	all := b.Subscribe("head", "block")
+
+	require.True(t, b.HasSubscribers("head"))
+	require.True(t, b.HasSubscribers("block"))
+	require.False(t, b.HasSubscribers("blob_sidecar"))
+
+	dropped, err := b.Publish("head", map[string]string{"slot": "1"})
+	require.NoError(t, err)
+	require.Zero(t, dropped)
+	dropped, err = b.Publish("block", map[string]string{"slot": "1"})
+	require.NoError(t, err)
+	require.Zero(t, dropped)
+
+	require.Equal(t,
+		"event: head\ndata: {\"slot\":\"1\"}\n\n",
+		streamN(t, heads, 1),
+	)
+	require.Equal(t,
+		"event: head\ndata: {\"slot\":\"1\"}\n\n"+
+			"event: block\ndata: {\"slot\":\"1\"}\n\n",
+		streamN(t, all, 2),
+	)
+
+	// Streaming unsubscribes the client once it returns.
+	require.False(t, b.HasSubscribers("head"))
+}
+
+func TestBroadcaster_DropsSlowClients(t *testing.T) {
+	b := events.NewBroadcaster(1, time.Minute)
+	sub := b.Subscribe("head")
+
+	dropped, err := b.Publish("head", 1)
+	require.NoError(t, err)
+	require.Zero(t, dropped)
+	dropped, err = b.Publish("head", 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, dropped)
+	require.False(t, b.HasSubscribers("head"))
+
+	// The buffered event is still delivered before the stream ends.
+	var buf bytes.Buffer
+	err = sub.Stream(context.Background(), &buf, func() {})
+	require.ErrorIs(t, err, events.ErrSlowClient)
+	require.Equal(t, "event: head\ndata: 1\n\n", buf.String())
+}
+
+func TestBroadcaster_PublishInvalidData(t *testing.T) {
+	b := events.NewBroadcaster(1, time.Minute)
+	b.Subscribe("head")
+
+	_, err := b.Publish("head", make(chan int))
+	require.Error(t, err)
+}
+
+func TestSubscription_StreamKeepalive(t *testing.T) {
+	b := events.NewBroadcaster(1, time.Millisecond)
+	sub := b.Subscribe("head")
+
+	require.Equal(t, ": keepalive\n\n", streamN(t, sub, 1))
+}
+
+// streamN streams the subscription until n events have been written.
+func streamN(t *testing.T, sub *events.Subscription, n int) string {
+	t.Helper()
+	var (
+		buf         bytes.Buffer
+		ctx, cancel = context.WithCancel(context.Background())
+	)
+	defer cancel()
+	err := sub.Stream(ctx, &buf, func() {
+		if n--; n == 0 {
+			cancel()
+		}
+	})
+	require.NoError(t, err)
+	return buf.String()
+}
@@ -21,19 +21,111 @@
 package events
 
 import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
-	"github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
+	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
-type Handler[ContextT context.Context] struct {
+// Handler is the handler for the events API.
+type Handler[
+	BeaconBlockT types.BeaconBlock,
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
+	ContextT apicontext.Context,
+	PayloadAttributesT types.PayloadAttributer[WithdrawalT],
+	WithdrawalT types.Withdrawal,
+] struct {
 	*handlers.BaseHandler[ContextT]
+	broadcaster *Broadcaster
+	chainSpec   common.ChainSpec
+	// payloadBuilds receives the payload attributes sent by this node to the
+	// execution client.
+	payloadBuilds <-chan async.Event[*engineprimitives.PayloadBuildData[PayloadAttributesT]] //nolint:lll // generic type.
+	// finalizedBlocks receives the blocks finalized by the chain.
+	finalizedBlocks <-chan async.Event[BeaconBlockT]
+	// finalSidecars receives the sidecars of the blocks being finalized.
+	finalSidecars <-chan async.Event[BlobSidecarsT]
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
-	h := &Handler[ContextT]{
+// NewHandler creates a new handler for the events API, publishing the events
+// received on the given channels to the subscribed clients.
+func NewHandler[
+	BeaconBlockT types.BeaconBlock,
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
+	ContextT apicontext.Context,
+	PayloadAttributesT types.PayloadAttributer[WithdrawalT],
+	WithdrawalT types.Withdrawal,
+](
+	chainSpec common.ChainSpec,
+	payloadBuilds <-chan async.Event[*engineprimitives.PayloadBuildData[PayloadAttributesT]], //nolint:lll // generic type.
+	finalizedBlocks <-chan async.Event[BeaconBlockT],
+	finalSidecars <-chan async.Event[BlobSidecarsT],
+) *Handler[
+	BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT, ContextT,
+	PayloadAttributesT, WithdrawalT,
+] {
+	h := &Handler[
+		BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT,
+		ContextT, PayloadAttributesT, WithdrawalT,
+	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		broadcaster: NewBroadcaster(
+			defaultClientBufferSize, defaultKeepaliveInterval,
+		),
+		chainSpec:       chainSpec,
+		payloadBuilds:   payloadBuilds,
+		finalizedBlocks: finalizedBlocks,
+		finalSidecars:   finalSidecars,
 	}
 	return h
 }
+
+// Start consumes the node events in a goroutine and publishes them to the
+// subscribed clients until the context is cancelled.
+func (h *Handler[_, _, _, _, _, _, _]) Start(ctx context.Context) {
+	go h.start(ctx)
+}
+
+// start is a helper function to consume and publish the node events.
+func (h *Handler[_, _, _, _, _, _, _]) start(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-h.payloadBuilds:
+			if !ok {
+				h.payloadBuilds = nil
+				continue
+			}
+			if ev.Error() == nil {
+				h.publishPayloadAttributes(ev.Data())
+			}
+		case ev, ok := <-h.finalizedBlocks:
+			if !ok {
+				h.finalizedBlocks = nil
+				continue
+			}
+			if ev.Error() == nil {
+				h.publishFinalizedBlock(ev.Data())
+			}
+		case ev, ok := <-h.finalSidecars:
+			if !ok {
+				h.finalSidecars = nil
+				continue
+			}
+			if ev.Error() == nil {
+				h.publishBlobSidecars(ev.Data())
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// publishFinalizedBlock publishes the head, block and finalized checkpoint
+// events for a finalized block. BeaconKit finalizes every block as soon as it
+// is committed, so the finalized block is also the new head.
+func (h *Handler[
+	BeaconBlockT, _, _, _, _, _, _,
+]) publishFinalizedBlock(blk BeaconBlockT) {
+	var (
+		slot      = blk.GetSlot()
+		blockRoot = blk.HashTreeRoot()
+		stateRoot = blk.GetStateRoot()
+	)
+	h.publish(types.TopicHead, &types.HeadEvent{
+		Slot:                      slot.Unwrap(),
+		Block:                     blockRoot,
+		State:                     stateRoot,
+		EpochTransition:           slot.Unwrap()%h.chainSpec.SlotsPerEpoch() == 0,
+		PreviousDutyDependentRoot: common.Root{}, // stubbed
+		CurrentDutyDependentRoot:  common.Root{}, // stubbed
+		ExecutionOptimistic:       false,
+	})
+	h.publish(types.TopicBlock, &types.BlockEvent{
+		Slot:                slot.Unwrap(),
+		Block:               blockRoot,
+		ExecutionOptimistic: false,
+	})
+	h.publish(types.TopicFinalizedCheckpoint, &types.FinalizedCheckpointEvent{
+		Block:               blockRoot,
+		State:               stateRoot,
+		Epoch:               h.chainSpec.SlotToEpoch(slot).Unwrap(),
+		ExecutionOptimistic: false,
+	})
+}
+
+// publishBlobSidecars publishes a blob sidecar event for each sidecar.
+func (h *Handler[
+	_, _, _, BlobSidecarsT, _, _, _,
+]) publishBlobSidecars(sidecars BlobSidecarsT) {
+	if !h.broadcaster.HasSubscribers(types.TopicBlobSidecar) {
+		return
+	}
+	for _, sidecar := range sidecars.GetSidecars() {
+		header := sidecar.GetBeaconBlockHeader()
+		commitment := sidecar.GetKzgCommitment()
+		h.publish(types.TopicBlobSidecar, &types.BlobSidecarEvent{
+			BlockRoot:     header.HashTreeRoot(),
+			Index:         sidecar.GetIndex(),
+			Slot:          header.GetSlot().Unwrap(),
+			KzgCommitment: commitment,
+			VersionedHash: commitment.ToVersionedHash(),
+		})
+	}
+}
+
+// publishPayloadAttributes publishes the payload attributes sent by this node
+// to the execution client to build a payload.
+func (h *Handler[
+	_, _, _, _, _, PayloadAttributesT, _,
+]) publishPayloadAttributes(
+	data *engineprimitives.PayloadBuildData[PayloadAttributesT],
+) {
+	if !h.broadcaster.HasSubscribers(types.TopicPayloadAttributes) {
+		return
+	}
+	attrs := data.PayloadAttributes
+	withdrawals := make([]*types.WithdrawalData, 0)
+	for _, w := range attrs.GetWithdrawals() {
+		withdrawals = append(withdrawals, &types.WithdrawalData{
+			Index:          w.GetIndex().Unwrap(),
+			ValidatorIndex: w.GetValidatorIndex().Unwrap(),
+			Address:        w.GetAddress(),
+			Amount:         w.GetAmount().Unwrap(),
+		})
+	}
+
+	h.publish(types.TopicPayloadAttributes, &types.PayloadAttributesEvent{
+		Version: version.Name(data.ForkVersion),
+		Data: types.PayloadAttributesEventData{
+			ProposerIndex:     data.ProposerIndex.Unwrap(),
+			ProposalSlot:      data.ProposalSlot.Unwrap(),
+			ParentBlockNumber: data.ParentBlockNumber.Unwrap(),
+			ParentBlockRoot:   data.ParentBlockRoot,
+			ParentBlockHash:   data.ParentBlockHash,
+			PayloadAttributes: types.PayloadAttributes{
+				Timestamp:             attrs.GetTimestamp().Unwrap(),
+				PrevRandao:            attrs.GetPrevRandao(),
+				SuggestedFeeRecipient: attrs.GetSuggestedFeeRecipient(),
+				Withdrawals:           withdrawals,
+				ParentBeaconBlockRoot: attrs.GetParentBeaconBlockRoot(),
+			},
+		},
+	})
+}
+
+// publish publishes the event to the subscribed clients, logging any clients
+// disconnected for being too slow.
+func (h *Handler[_, _, _, _, _, _, _]) publish(topic string, data any) {
+	dropped, err := h.broadcaster.Publish(topic, data)
+	if err != nil {
+		h.Logger().Error("Failed to publish event", "topic", topic, "err", err)
+		return
+	}
+	if dropped > 0 {
+		h.Logger().Warn("Disconnected slow event stream clients",
+			"topic", topic, "num_clients", dropped,
+		)
+	}
+}
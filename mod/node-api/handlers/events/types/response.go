@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+)
+
+// Event topics supported by the events API.
+const (
+	TopicHead                = "head"
+	TopicBlock               = "block"
+	TopicFinalizedCheckpoint = "finalized_checkpoint"
+	TopicBlobSidecar         = "blob_sidecar"
+	TopicPayloadAttributes   = "payload_attributes"
+)
+
+type HeadEvent struct {
+	Slot                      uint64      `json:"slot,string"`
+	Block                     common.Root `json:"block"`
+	State                     common.Root `json:"state"`
+	EpochTransition           bool        `json:"epoch_transition"`
+	PreviousDutyDependentRoot common.Root `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  common.Root `json:"current_duty_dependent_root"`
+	ExecutionOptimistic       bool        `json:"execution_optimistic"`
+}
+
+type BlockEvent struct {
+	Slot                uint64      `json:"slot,string"`
+	Block               common.Root `json:"block"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+type FinalizedCheckpointEvent struct {
+	Block               common.Root `json:"block"`
+	State               common.Root `json:"state"`
+	Epoch               uint64      `json:"epoch,string"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+type BlobSidecarEvent struct {
+	BlockRoot     common.Root           `json:"block_root"`
+	Index         uint64                `json:"index,string"`
+	Slot          uint64                `json:"slot,string"`
+	KzgCommitment eip4844.KZGCommitment `json:"kzg_commitment"`
+	VersionedHash common.Bytes32        `json:"versioned_hash"`
+}
+
+type PayloadAttributesEvent struct {
+	Version string                     `json:"version"`
+	Data    PayloadAttributesEventData `json:"data"`
+}
+
+type PayloadAttributesEventData struct {
+	ProposerIndex     uint64               `json:"proposer_index,string"`
+	ProposalSlot      uint64               `json:"proposal_slot,string"`
+	ParentBlockNumber uint64               `json:"parent_block_number,string"`
+	ParentBlockRoot   common.Root          `json:"parent_block_root"`
+	ParentBlockHash   common.ExecutionHash `json:"parent_block_hash"`
+	PayloadAttributes PayloadAttributes    `json:"payload_attributes"`
+}
+
+type PayloadAttributes struct {
+	Timestamp             uint64                  `json:"timestamp,string"`
+	PrevRandao            common.Bytes32          `json:"prev_randao"`
+	SuggestedFeeRecipient common.ExecutionAddress `json:"suggested_fee_recipient"`
+	Withdrawals           []*WithdrawalData       `json:"withdrawals"`
+	ParentBeaconBlockRoot common.Root             `json:"parent_beacon_block_root"`
+}
+
+type WithdrawalData struct {
+	Index          uint64                  `json:"index,string"`
+	ValidatorIndex uint64                  `json:"validator_index,string"`
+	Address        common.ExecutionAddress `json:"address"`
+	Amount         uint64                  `json:"amount,string"`
+}
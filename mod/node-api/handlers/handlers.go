@@ -21,6 +21,8 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 )
@@ -35,6 +37,12 @@ type Handlers[ContextT any] interface {
 	RouteSet() *RouteSet[ContextT]
 }
 
+// Service is implemented by handlers that need to run background work, such as
+// consuming node events, for as long as the API server is running.
+type Service interface {
+	Start(ctx context.Context)
+}
+
 // BaseHandler is a base handler for all handlers. It abstracts the route set
 // and logger from the handler.
 type BaseHandler[ContextT any] struct {
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// Backend is the interface for backend of the node API.
+type Backend interface {
+	// HeadSlot returns the slot of the latest beacon state.
+	HeadSlot() (math.Slot, error)
+	// SyncDistance returns the number of blocks the node is behind the
+	// chain.
+	SyncDistance() math.Slot
+	// IsSyncing returns true if the node is catching up with the chain.
+	IsSyncing() bool
+	// IsOptimistic returns true if the execution client has not yet fully
+	// validated the latest payload.
+	IsOptimistic() bool
+	// IsELOffline returns true if the execution client cannot be reached.
+	IsELOffline() bool
+	// ServicesHealth returns the health of every service of the node by
+	// name, nil for the healthy services.
+	ServicesHealth() map[string]error
+}
@@ -65,7 +65,12 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/health",
-			Handler: h.NotImplemented,
+			Handler: h.Health,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/node/services",
+			Handler: h.Services,
 		},
 	})
 }
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"net/http"
+
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// Syncing returns the sync status of the node.
+func (h *Handler[ContextT]) Syncing(ContextT) (any, error) {
+	headSlot, err := h.backend.HeadSlot()
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(nodetypes.SyncingData{
+		HeadSlot:     headSlot.Unwrap(),
+		SyncDistance: h.backend.SyncDistance().Unwrap(),
+		IsSyncing:    h.backend.IsSyncing(),
+		IsOptimistic: h.backend.IsOptimistic(),
+		ELOffline:    h.backend.IsELOffline(),
+	}), nil
+}
+
+// Health returns the health of the node through the status code: 200 if the
+// node is ready, 206 (or the requested syncing status) if it is syncing and
+// 503 if it is not initialized or cannot reach its execution client.
+func (h *Handler[ContextT]) Health(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.HealthRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = h.backend.HeadSlot(); err != nil || h.backend.IsELOffline() {
+		return nodetypes.HealthResponse{
+			Code: http.StatusServiceUnavailable,
+		}, nil
+	}
+	if h.backend.IsSyncing() {
+		code := http.StatusPartialContent
+		if req.SyncingStatus != 0 {
+			code = req.SyncingStatus
+		}
+		return nodetypes.HealthResponse{Code: code}, nil
+	}
+	return nodetypes.HealthResponse{Code: http.StatusOK}, nil
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/node"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errNotInitialized = errors.New("not initialized")
+
+type backend struct {
+	headSlot     math.Slot
+	headErr      error
+	syncDistance math.Slot
+	catchingUp   bool
+	optimistic   bool
+	elOffline    bool
+	services     map[string]error
+}
+
+func (b backend) HeadSlot() (math.Slot, error) { return b.headSlot, b.headErr }
+func (b backend) SyncDistance() math.Slot      { return b.syncDistance }
+func (b backend) IsSyncing() bool {
+	return b.catchingUp || b.syncDistance > 0
+}
+func (b backend) IsOptimistic() bool { return b.optimistic }
+func (b backend) IsELOffline() bool  { return b.elOffline }
+func (b backend) ServicesHealth() map[string]error {
+	return b.services
+}
+
+// bindContext is a request context which binds no query parameters.
+type bindContext struct{}
+
+func (bindContext) Bind(any) error     { return nil }
+func (bindContext) Validate(any) error { return nil }
+
+func TestSyncing(t *testing.T) {
+	h := node.NewHandler[context.Context](backend{
+		headSlot:     10,
+		syncDistance: 2,
+		optimistic:   true,
+	})
+	res, err := h.Syncing(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(nodetypes.SyncingData{
+		HeadSlot:     10,
+		SyncDistance: 2,
+		IsSyncing:    true,
+		IsOptimistic: true,
+	}), res)
+
+	h = node.NewHandler[context.Context](backend{headErr: errNotInitialized})
+	_, err = h.Syncing(nil)
+	require.ErrorIs(t, err, errNotInitialized)
+}
+
+func TestHealth(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend backend
+		code    int
+	}{
+		{name: "ready", code: http.StatusOK},
+		{
+			name:    "syncing",
+			backend: backend{syncDistance: 3},
+			code:    http.StatusPartialContent,
+		},
+		{
+			name:    "catching up",
+			backend: backend{catchingUp: true},
+			code:    http.StatusPartialContent,
+		},
+		{
+			name:    "not initialized",
+			backend: backend{headErr: errNotInitialized},
+			code:    http.StatusServiceUnavailable,
+		},
+		{
+			name:    "el offline",
+			backend: backend{elOffline: true},
+			code:    http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := node.NewHandler[context.Context](tt.backend)
+			h.RegisterRoutes(noop.NewLogger[any]())
+			res, err := h.Health(bindContext{})
+			require.NoError(t, err)
+			require.Equal(t, nodetypes.HealthResponse{Code: tt.code}, res)
+		})
+	}
+}
+
+func TestServices(t *testing.T) {
+	h := node.NewHandler[context.Context](backend{
+		services: map[string]error{
+			"engine-client": errors.New("no healthy endpoint"),
+			"blockchain":    nil,
+		},
+	})
+	res, err := h.Services(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(nodetypes.ServicesData{
+		Healthy: false,
+		Services: []nodetypes.ServiceData{
+			{Name: "blockchain", Healthy: true},
+			{
+				Name:  "engine-client",
+				Error: "no healthy endpoint",
+			},
+		},
+	}), res)
+
+	h = node.NewHandler[context.Context](backend{})
+	res, err = h.Services(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(nodetypes.ServicesData{
+		Healthy:  true,
+		Services: []nodetypes.ServiceData{},
+	}), res)
+}
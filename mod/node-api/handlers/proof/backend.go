@@ -21,6 +21,7 @@
 package proof
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -33,8 +34,10 @@ type Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT any] interface {
 
 type BlockBackend[BeaconBlockHeaderT any] interface {
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 }
 
 type StateBackend[BeaconStateT any] interface {
 	StateFromSlotForProof(slot math.Slot) (BeaconStateT, math.Slot, error)
+	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 }
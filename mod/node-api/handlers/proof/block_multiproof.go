@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetBlockMultiproof returns the block proposer pubkey and the block number
+// and fee recipient of the latest execution payload header for the given
+// timestamp id, along with a single merkle multiproof of them and of the
+// proposer index that can be verified against the beacon block root.
+func (h *Handler[
+	BeaconBlockHeaderT, _, _, ContextT, _, _,
+]) GetBlockMultiproof(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.BlockMultiproofRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, beaconState, blockHeader, err := h.resolveTimestampID(
+		params.TimestampID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info("Generating block multiproof", "slot", slot)
+	proof, err := merkle.ProveProposerAndExecutionInBlock(
+		blockHeader, beaconState,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the pubkey of the proposer validator.
+	proposerValidator, err := beaconState.ValidatorByIndex(
+		blockHeader.GetProposerIndex(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the latest execution payload header.
+	leph, err := beaconState.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	gIndices := make([]math.U64, len(proof.GeneralizedIndices))
+	for i, gIndex := range proof.GeneralizedIndices {
+		gIndices[i] = math.U64(gIndex)
+	}
+	return types.BlockMultiproofResponse[BeaconBlockHeaderT]{
+		BeaconBlockHeader:     blockHeader,
+		BeaconBlockRoot:       proof.Root,
+		ValidatorPubkey:       proposerValidator.GetPubkey(),
+		ExecutionNumber:       leph.GetNumber(),
+		ExecutionFeeRecipient: leph.GetFeeRecipient(),
+		GeneralizedIndices:    gIndices,
+		Leaves:                proof.Leaves,
+		Multiproof:            proof.Proof,
+	}, nil
+}
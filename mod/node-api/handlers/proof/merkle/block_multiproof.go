@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+)
+
+// ProveProposerAndExecutionInBlock generates a single multiproof of, in
+// order, the proposer index, the proposer pubkey, and the block number and
+// fee recipient of the latest execution payload header in the beacon block.
+// The multiproof is then verified against the beacon block root as a sanity
+// check. It uses the fastssz library to generate the proof.
+func ProveProposerAndExecutionInBlock[
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
+	ValidatorT any,
+](
+	bbh BeaconBlockHeaderT,
+	bs types.BeaconState[
+		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
+	],
+) (*ObjectProof, error) {
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+
+	return ProveObjectPathsInBlock(bbh, bsm, []string{
+		"proposer_index",
+		"state_root/validators/" +
+			strconv.FormatUint(bbh.GetProposerIndex().Unwrap(), 10) +
+			"/pubkey",
+		"state_root/latest_execution_payload_header/block_number",
+		"state_root/latest_execution_payload_header/fee_recipient",
+	})
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	mlib "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProveProposerAndExecutionInBlock tests that the multiproof of the
+// proposer and execution fields verifies against the beacon block root and
+// proves the same leaves as the single proofs.
+func TestProveProposerAndExecutionInBlock(t *testing.T) {
+	bbh, bs := newObjectProofTestBlock(t, 100)
+	bbh.ProposerIndex = 95
+
+	proof, err := merkle.ProveProposerAndExecutionInBlock(bbh, bs)
+	require.NoError(t, err)
+	require.Equal(t, bbh.HashTreeRoot(), proof.Root)
+	require.Equal(t, mlib.GeneralizedIndices{
+		merkle.ProposerIndexGIndexDenebBlock,
+		merkle.ZeroValidatorPubkeyGIndexDenebBlock +
+			merkle.ValidatorPubkeyGIndexOffset*95,
+		merkle.ExecutionNumberGIndexDenebBlock,
+		merkle.ExecutionFeeRecipientGIndexDenebBlock,
+	}, proof.GeneralizedIndices)
+	require.True(t, mlib.VerifyMultiproof(
+		proof.GeneralizedIndices, proof.Leaves, proof.Proof, proof.Root,
+	))
+
+	// The leaves are those of the single proofs.
+	pubkeyRoot := common.Root(bs.Validators[95].Pubkey.HashTreeRoot())
+	var feeRecipientLeaf common.Root
+	copy(feeRecipientLeaf[:], bs.LatestExecutionPayloadHeader.FeeRecipient[:])
+	require.Equal(t, []common.Root{
+		uint64Leaf(95), pubkeyRoot, uint64Leaf(42), feeRecipientLeaf,
+	}, proof.Leaves)
+
+	// The multiproof is smaller than the four single proofs combined.
+	pubkeyProof, _, err := merkle.ProveProposerPubkeyInBlock(bbh, bs)
+	require.NoError(t, err)
+	require.Less(t, len(proof.Proof), 4*len(pubkeyProof))
+}
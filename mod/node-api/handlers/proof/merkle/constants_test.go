@@ -26,96 +26,16 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
 	mlib "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
 	"github.com/stretchr/testify/require"
 )
 
-var (
-	// beaconStateSchema is the schema for the BeaconState struct defined in
-	// beacon-kit/mod/consensus-types/pkg/types/state.go.
-	beaconStateSchema = schema.DefineContainer(
-		schema.NewField("GenesisValidatorsRoot", schema.B32()),
-		schema.NewField("Slot", schema.U64()),
-		schema.NewField("Fork", schema.DefineContainer(
-			schema.NewField("PreviousVersion", schema.B4()),
-			schema.NewField("CurrentVersion", schema.B4()),
-			schema.NewField("Epoch", schema.U64()),
-		)),
-		schema.NewField("LatestBlockHeader", schema.DefineContainer(
-			schema.NewField("Slot", schema.U64()),
-			schema.NewField("ProposerIndex", schema.U64()),
-			schema.NewField("ParentBlockRoot", schema.B32()),
-			schema.NewField("StateRoot", schema.B32()),
-			schema.NewField("BodyRoot", schema.B32()),
-		)),
-		schema.NewField("BlockRoots", schema.DefineList(schema.B32(), 8192)),
-		schema.NewField("StateRoots", schema.DefineList(schema.B32(), 8192)),
-		schema.NewField("Eth1Data", schema.DefineContainer(
-			schema.NewField("DepositRoot", schema.B32()),
-			schema.NewField("DepositCount", schema.U64()),
-			schema.NewField("BlockHash", schema.B32()),
-		)),
-		schema.NewField("Eth1DepositIndex", schema.U64()),
-		schema.NewField("LatestExecutionPayloadHeader", schema.DefineContainer(
-			schema.NewField("ParentHash", schema.B32()),
-			schema.NewField("FeeRecipient", schema.B20()),
-			schema.NewField("StateRoot", schema.B32()),
-			schema.NewField("ReceiptsRoot", schema.B32()),
-			schema.NewField("LogsBloom", schema.B256()),
-			schema.NewField("Random", schema.U64()),
-			schema.NewField("Number", schema.U64()),
-			schema.NewField("GasLimit", schema.U64()),
-			schema.NewField("GasUsed", schema.U64()),
-			schema.NewField("Timestamp", schema.U64()),
-			schema.NewField("ExtraData", schema.DefineByteList(32)),
-			schema.NewField("BaseFeePerGas", schema.B32()),
-			schema.NewField("BlockHash", schema.B32()),
-			schema.NewField("TransactionsRoot", schema.B32()),
-			schema.NewField("WithdrawalsRoot", schema.B32()),
-			schema.NewField("BlobGasUsed", schema.U64()),
-			schema.NewField("ExcessBlobGas", schema.U64()),
-		)),
-		schema.NewField("Validators", schema.DefineList(schema.DefineContainer(
-			schema.NewField("Pubkey", schema.B48()),
-			schema.NewField("WithdrawalCredentials", schema.B32()),
-			schema.NewField("EffectiveBalance", schema.U64()),
-			schema.NewField("Slashed", schema.Bool()),
-			schema.NewField("ActivationEligibilityEpoch", schema.U64()),
-			schema.NewField("ActivationEpoch", schema.U64()),
-			schema.NewField("ExitEpoch", schema.U64()),
-			schema.NewField("WithdrawableEpoch", schema.U64()),
-		), types.MaxValidators)),
-		schema.NewField(
-			"Balances", schema.DefineList(schema.U64(), types.MaxValidators),
-		),
-		schema.NewField("RandaoMixes", schema.DefineList(schema.B32(), 65536)),
-		schema.NewField("NextWithdrawalIndex", schema.U64()),
-		schema.NewField("NextWithdrawalValidatorIndex", schema.U64()),
-		schema.NewField(
-			"Slashings", schema.DefineList(schema.U64(), types.MaxValidators),
-		),
-		schema.NewField("TotalSlashing", schema.U64()),
-	)
-
-	// beaconHeaderSchema is the schema for the BeaconBlockHeader struct defined
-	// in beacon-kit/mod/consensus-types/pkg/types/header.go, with the SSZ
-	// expansion of StateRoot to use the BeaconState.
-	beaconHeaderSchema = schema.DefineContainer(
-		schema.NewField("Slot", schema.U64()),
-		schema.NewField("ProposerIndex", schema.U64()),
-		schema.NewField("ParentRoot", schema.B32()),
-		schema.NewField("State", beaconStateSchema),
-		schema.NewField("BodyRoot", schema.B32()),
-	)
-)
-
 // TestGIndexProposerIndexDeneb tests the generalized index of the proposer
 // index in the beacon block on the Deneb fork.
 func TestGIndexProposerIndexDeneb(t *testing.T) {
 	// GIndex of the proposer index in the beacon block.
 	_, proposerIndexGIndexDenebBlock, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("ProposerIndex").GetGeneralizedIndex(beaconHeaderSchema)
+	]("proposer_index").GetGeneralizedIndex(merkle.BeaconBlockHeaderSchemaDeneb)
 	require.NoError(t, err)
 	require.Equal(
 		t,
@@ -130,14 +50,14 @@ func TestGIndicesValidatorPubkeyDeneb(t *testing.T) {
 	// GIndex of state in the block.
 	_, stateGIndexDenebBlock, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("State").GetGeneralizedIndex(beaconHeaderSchema)
+	]("state_root").GetGeneralizedIndex(merkle.BeaconBlockHeaderSchemaDeneb)
 	require.NoError(t, err)
 	require.Equal(t, merkle.StateGIndexDenebBlock, int(stateGIndexDenebBlock))
 
 	// GIndex of the 0 validator's pubkey in the state.
 	_, zeroValidatorPubkeyGIndexDenebState, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("Validators/0/Pubkey").GetGeneralizedIndex(beaconStateSchema)
+	]("validators/0/pubkey").GetGeneralizedIndex(types.BeaconStateSchemaDeneb)
 	require.NoError(t, err)
 	require.Equal(t,
 		merkle.ZeroValidatorPubkeyGIndexDenebState,
@@ -147,7 +67,7 @@ func TestGIndicesValidatorPubkeyDeneb(t *testing.T) {
 	// GIndex of the 0 validator's pubkey in the block.
 	_, zeroValidatorPubkeyGIndexDenebBlock, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("State/Validators/0/Pubkey").GetGeneralizedIndex(beaconHeaderSchema)
+	]("state_root/validators/0/pubkey").GetGeneralizedIndex(merkle.BeaconBlockHeaderSchemaDeneb)
 	require.NoError(t, err)
 	require.Equal(t,
 		merkle.ZeroValidatorPubkeyGIndexDenebBlock,
@@ -167,7 +87,7 @@ func TestGIndicesValidatorPubkeyDeneb(t *testing.T) {
 	// GIndex offset of the next validator's pubkey.
 	_, oneValidatorPubkeyGIndexDenebState, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("Validators/1/Pubkey").GetGeneralizedIndex(beaconStateSchema)
+	]("validators/1/pubkey").GetGeneralizedIndex(types.BeaconStateSchemaDeneb)
 	require.NoError(t, err)
 	require.Equal(t,
 		mlib.GeneralizedIndex(merkle.ValidatorPubkeyGIndexOffset),
@@ -181,8 +101,8 @@ func TestGInidicesExecutionDeneb(t *testing.T) {
 	// GIndex of the execution number in the state.
 	_, executionNumberGIndexDenebState, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("LatestExecutionPayloadHeader/Number").GetGeneralizedIndex(
-		beaconStateSchema,
+	]("latest_execution_payload_header/block_number").GetGeneralizedIndex(
+		types.BeaconStateSchemaDeneb,
 	)
 	require.NoError(t, err)
 	require.Equal(t,
@@ -193,8 +113,8 @@ func TestGInidicesExecutionDeneb(t *testing.T) {
 	// GIndex of the execution number in the block.
 	_, executionNumberGIndexDenebBlock, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("State/LatestExecutionPayloadHeader/Number").GetGeneralizedIndex(
-		beaconHeaderSchema,
+	]("state_root/latest_execution_payload_header/block_number").GetGeneralizedIndex(
+		merkle.BeaconBlockHeaderSchemaDeneb,
 	)
 	require.NoError(t, err)
 	require.Equal(t,
@@ -215,8 +135,8 @@ func TestGInidicesExecutionDeneb(t *testing.T) {
 	// GIndex of the execution fee recipient in the state.
 	_, executionFeeRecipientGIndexDenebState, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("LatestExecutionPayloadHeader/FeeRecipient").GetGeneralizedIndex(
-		beaconStateSchema,
+	]("latest_execution_payload_header/fee_recipient").GetGeneralizedIndex(
+		types.BeaconStateSchemaDeneb,
 	)
 	require.NoError(t, err)
 	require.Equal(t,
@@ -227,8 +147,8 @@ func TestGInidicesExecutionDeneb(t *testing.T) {
 	// GIndex of the execution fee recipient in the block.
 	_, executionFeeRecipientGIndexDenebBlock, _, err := mlib.ObjectPath[
 		mlib.GeneralizedIndex, [32]byte,
-	]("State/LatestExecutionPayloadHeader/FeeRecipient").GetGeneralizedIndex(
-		beaconHeaderSchema,
+	]("state_root/latest_execution_payload_header/fee_recipient").GetGeneralizedIndex(
+		merkle.BeaconBlockHeaderSchemaDeneb,
 	)
 	require.NoError(t, err)
 	require.Equal(t,
@@ -99,6 +99,7 @@ func NewBeaconState(
 		0,
 		[]math.Gwei{},
 		0,
+		0,
 	)
 	return &BeaconState{BeaconStateMarshallable: bsm}, err
 }
@@ -128,3 +129,8 @@ func (m *BeaconState) ValidatorByIndex(
 
 	return vals[index], nil
 }
+
+// GetValidators returns the validator set of the mock beacon state.
+func (m *BeaconState) GetValidators() (types.Validators, error) {
+	return m.BeaconStateMarshallable.Validators, nil
+}
@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+// blockHeaderDepth is the depth of the leaves of the beacon block header tree.
+const blockHeaderDepth = 3
+
+var (
+	// ErrInvalidObjectPath is returned when an object path does not resolve
+	// to a node of the object.
+	ErrInvalidObjectPath = errors.New("invalid object path")
+
+	// errObjectProofFailed is returned when the generated proof fails to
+	// verify against the root of the object.
+	errObjectProofFailed = errors.New("object proof failed to verify")
+)
+
+// ObjectProof is a proof of the leaves at one or more object paths.
+type ObjectProof struct {
+	// Root is the hash tree root of the object the proof verifies against.
+	Root common.Root
+	// GeneralizedIndices are the generalized indices of the object paths.
+	GeneralizedIndices merkle.GeneralizedIndices
+	// Leaves are the nodes of the object at the generalized indices.
+	Leaves []common.Root
+	// Proof is a Merkle proof of the leaf if a single object path was given,
+	// and a Merkle multiproof of the leaves otherwise.
+	Proof []common.Root
+}
+
+// nodeGetter returns the root of the node at the given generalized index.
+type nodeGetter func(merkle.GeneralizedIndex) (common.Root, error)
+
+// ProveObjectPathsInState generates a proof of the given object paths, e.g.
+// `validators/12/effective_balance`, in the beacon state. The proof is then
+// verified against the beacon state root as a sanity check. It uses the
+// fastssz library to generate the proof.
+func ProveObjectPathsInState[
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+](bsm BeaconStateMarshallableT, paths []string) (*ObjectProof, error) {
+	stateTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	return proveObjectPaths(
+		ctypes.BeaconStateSchema(bsm.Version()),
+		common.NewRootFromBytes(stateTree.Hash()),
+		treeNodeGetter(stateTree),
+		paths,
+	)
+}
+
+// ProveObjectPathsInBlockHeader generates a proof of the given object paths,
+// e.g. `parent_root`, in the beacon block header. Unlike
+// ProveObjectPathsInBlock, it does not merkleize the beacon state, so the
+// paths may not go below the state root.
+func ProveObjectPathsInBlockHeader[
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+](bbh BeaconBlockHeaderT, paths []string) (*ObjectProof, error) {
+	blockTree, err := bbh.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	return proveObjectPaths(
+		BeaconBlockHeaderSchemaDeneb,
+		bbh.HashTreeRoot(),
+		treeNodeGetter(blockTree),
+		paths,
+	)
+}
+
+// ProveObjectPathsInBlock generates a proof of the given object paths, e.g.
+// `state_root/validators/12/effective_balance`, in the beacon block. The
+// proof is then verified against the beacon block root as a sanity check. It
+// uses the fastssz library to generate the proof.
+func ProveObjectPathsInBlock[
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+](
+	bbh BeaconBlockHeaderT,
+	bsm BeaconStateMarshallableT,
+	paths []string,
+) (*ObjectProof, error) {
+	blockTree, err := bbh.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	stateTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, err
+	}
+
+	// Nodes below the state root are served from the beacon state tree.
+	blockNodes, stateNodes := treeNodeGetter(blockTree), treeNodeGetter(
+		stateTree,
+	)
+	return proveObjectPaths(
+		BeaconBlockHeaderSchema(bsm.Version()),
+		bbh.HashTreeRoot(),
+		func(gIndex merkle.GeneralizedIndex) (common.Root, error) {
+			depth := gIndex.Length()
+			if depth <= blockHeaderDepth ||
+				gIndex>>(depth-blockHeaderDepth) != StateGIndexDenebBlock {
+				return blockNodes(gIndex)
+			}
+			subtreeDepth := depth - blockHeaderDepth
+			return stateNodes(
+				merkle.GeneralizedIndex(1)<<subtreeDepth |
+					gIndex&(1<<subtreeDepth-1),
+			)
+		},
+		paths,
+	)
+}
+
+// proveObjectPaths generates a proof of the given object paths in the object
+// of the given schema and root, whose nodes are returned by the given getter.
+func proveObjectPaths(
+	typ schema.SSZType,
+	root common.Root,
+	nodes nodeGetter,
+	paths []string,
+) (*ObjectProof, error) {
+	gIndices := make(merkle.GeneralizedIndices, len(paths))
+	for i, path := range paths {
+		_, gIndex, _, err := merkle.ObjectPath[
+			merkle.GeneralizedIndex, common.Root,
+		](path).GetGeneralizedIndex(typ)
+		if err != nil {
+			return nil, errors.Wrapf(
+				ErrInvalidObjectPath, "%s: %s", path, err.Error(),
+			)
+		}
+		gIndices[i] = gIndex
+	}
+
+	leaves := make([]common.Root, len(gIndices))
+	for i, gIndex := range gIndices {
+		leaf, err := nodes(gIndex)
+		if err != nil {
+			return nil, errors.Wrapf(
+				ErrInvalidObjectPath, "%s: %s", paths[i], err.Error(),
+			)
+		}
+		leaves[i] = leaf
+	}
+
+	proof, err := merkle.BuildMultiproof(gIndices, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = verifyObjectProof(root, gIndices, leaves, proof); err != nil {
+		return nil, err
+	}
+
+	return &ObjectProof{
+		Root:               root,
+		GeneralizedIndices: gIndices,
+		Leaves:             leaves,
+		Proof:              proof,
+	}, nil
+}
+
+// verifyObjectProof verifies the object proof against the given root.
+//
+// TODO: verifying the proof is not absolutely necessary.
+func verifyObjectProof(
+	root common.Root,
+	gIndices merkle.GeneralizedIndices,
+	leaves []common.Root,
+	proof []common.Root,
+) error {
+	if len(gIndices) == 1 {
+		verified, err := merkle.VerifyProof(
+			gIndices[0], leaves[0], proof, root,
+		)
+		if err != nil {
+			return err
+		} else if !verified {
+			return errObjectProofFailed
+		}
+		return nil
+	}
+
+	if !merkle.VerifyMultiproof(gIndices, leaves, proof, root) {
+		return errObjectProofFailed
+	}
+	return nil
+}
+
+// treeNodeGetter returns a getter of the nodes of the given fastssz tree.
+func treeNodeGetter(tree *fastssz.Node) nodeGetter {
+	return func(gIndex merkle.GeneralizedIndex) (common.Root, error) {
+		//#nosec:G701 // generalized indices of the schemas fit in an int.
+		node, err := tree.Get(int(gIndex))
+		if err != nil {
+			return common.Root{}, err
+		}
+		return common.NewRootFromBytes(node.Hash()), nil
+	}
+}
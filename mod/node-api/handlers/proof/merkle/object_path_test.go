@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle/mock"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	mlib "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// newObjectProofTestBlock returns a beacon state with the given number of
+// validators and the beacon block header committing to it.
+func newObjectProofTestBlock(
+	t *testing.T,
+	numValidators int,
+) (*types.BeaconBlockHeader, *mock.BeaconState) {
+	t.Helper()
+	vals := make(types.Validators, numValidators)
+	for i := range vals {
+		vals[i] = &types.Validator{
+			Pubkey:           [48]byte{byte(i), 1, 2, 3},
+			EffectiveBalance: math.Gwei(i) * 1e9,
+		}
+	}
+	bs, err := mock.NewBeaconState(
+		7, vals, 42, common.ExecutionAddress{1, 2, 3},
+	)
+	require.NoError(t, err)
+
+	bbh := (&types.BeaconBlockHeader{}).New(
+		7, 3, common.Root{1, 2, 3}, bs.HashTreeRoot(), common.Root{3, 2, 1},
+	)
+	return bbh, bs
+}
+
+// uint64Leaf returns the leaf of the given uint64 in a Merkle tree.
+func uint64Leaf(v uint64) common.Root {
+	var leaf common.Root
+	binary.LittleEndian.PutUint64(leaf[:], v)
+	return leaf
+}
+
+// TestProveObjectPathsInState tests that the proofs of object paths in the
+// beacon state verify against the state root.
+func TestProveObjectPathsInState(t *testing.T) {
+	_, bs := newObjectProofTestBlock(t, 20)
+
+	// A single path yields a regular Merkle proof.
+	proof, err := merkle.ProveObjectPathsInState(
+		bs.BeaconStateMarshallable,
+		[]string{"validators/12/effective_balance"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, bs.HashTreeRoot(), proof.Root)
+	require.Len(t, proof.Leaves, 1)
+	require.Equal(
+		t, uint64Leaf(12e9), proof.Leaves[0],
+	)
+	verified, err := mlib.VerifyProof(
+		proof.GeneralizedIndices[0], proof.Leaves[0], proof.Proof, proof.Root,
+	)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	// The generalized index matches the hand-written constants.
+	proof, err = merkle.ProveObjectPathsInState(
+		bs.BeaconStateMarshallable,
+		[]string{"latest_execution_payload_header/block_number"},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		mlib.GeneralizedIndex(merkle.ExecutionNumberGIndexDenebState),
+		proof.GeneralizedIndices[0],
+	)
+	require.Equal(t, uint64Leaf(42), proof.Leaves[0])
+
+	// Several paths yield a Merkle multiproof.
+	proof, err = merkle.ProveObjectPathsInState(
+		bs.BeaconStateMarshallable,
+		[]string{
+			"slot",
+			"validators/3/pubkey",
+			"validators/19/effective_balance",
+			"latest_execution_payload_header/fee_recipient",
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, proof.Leaves, 4)
+	require.True(t, mlib.VerifyMultiproof(
+		proof.GeneralizedIndices, proof.Leaves, proof.Proof, proof.Root,
+	))
+}
+
+// TestProveObjectPathsInBlock tests that the proofs of object paths in the
+// beacon block verify against the beacon block root.
+func TestProveObjectPathsInBlock(t *testing.T) {
+	bbh, bs := newObjectProofTestBlock(t, 20)
+
+	// A proof into the state matches the hand-written proposer proof.
+	bbh.ProposerIndex = 12
+	expectedProof, beaconRoot, err := merkle.ProveProposerPubkeyInBlock(
+		bbh, bs,
+	)
+	require.NoError(t, err)
+	proof, err := merkle.ProveObjectPathsInBlock(
+		bbh,
+		bs.BeaconStateMarshallable,
+		[]string{"state_root/validators/12/pubkey"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, beaconRoot, proof.Root)
+	require.Equal(t, expectedProof, proof.Proof)
+
+	// A multiproof may span the header and the state.
+	proof, err = merkle.ProveObjectPathsInBlock(
+		bbh,
+		bs.BeaconStateMarshallable,
+		[]string{
+			"proposer_index",
+			"state_root/slot",
+			"state_root/validators/7/effective_balance",
+			"body_root",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, bbh.HashTreeRoot(), proof.Root)
+	require.Equal(t, uint64Leaf(12), proof.Leaves[0])
+	require.Equal(t, uint64Leaf(7), proof.Leaves[1])
+	require.True(t, mlib.VerifyMultiproof(
+		proof.GeneralizedIndices, proof.Leaves, proof.Proof, proof.Root,
+	))
+}
+
+// TestProveObjectPathsInElectraBlock tests that the object paths resolve in
+// the layout of the Electra state, which holds the deposit requests start
+// index.
+func TestProveObjectPathsInElectraBlock(t *testing.T) {
+	_, bs := newObjectProofTestBlock(t, 2)
+	deneb := bs.BeaconStateMarshallable
+	electra, err := deneb.New(
+		version.Electra,
+		deneb.GenesisValidatorsRoot,
+		deneb.Slot,
+		deneb.Fork,
+		deneb.LatestBlockHeader,
+		deneb.BlockRoots,
+		deneb.StateRoots,
+		deneb.Eth1Data,
+		deneb.Eth1DepositIndex.Unwrap(),
+		deneb.LatestExecutionPayloadHeader,
+		deneb.Validators,
+		[]uint64{},
+		deneb.RandaoMixes,
+		deneb.NextWithdrawalIndex.Unwrap(),
+		deneb.NextWithdrawalValidatorIndex,
+		deneb.Slashings,
+		deneb.TotalSlashing,
+		9,
+	)
+	require.NoError(t, err)
+	bbh := (&types.BeaconBlockHeader{}).New(
+		7, 1, common.Root{1}, electra.HashTreeRoot(), common.Root{2},
+	)
+
+	proof, err := merkle.ProveObjectPathsInBlock(
+		bbh,
+		electra,
+		[]string{
+			"state_root/deposit_requests_start_index",
+			"state_root/validators/1/effective_balance",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, bbh.HashTreeRoot(), proof.Root)
+	require.Equal(t, uint64Leaf(9), proof.Leaves[0])
+	require.Equal(t, uint64Leaf(1e9), proof.Leaves[1])
+
+	// The deposit requests start index is not part of the Deneb state.
+	_, err = merkle.ProveObjectPathsInState(
+		deneb, []string{"deposit_requests_start_index"},
+	)
+	require.ErrorIs(t, err, merkle.ErrInvalidObjectPath)
+}
+
+// TestProveObjectPathsInBlockHeader tests that the proofs of object paths in
+// the beacon block header verify against the beacon block root, without the
+// beacon state.
+func TestProveObjectPathsInBlockHeader(t *testing.T) {
+	bbh, _ := newObjectProofTestBlock(t, 2)
+
+	proof, err := merkle.ProveObjectPathsInBlockHeader(
+		bbh, []string{"parent_root"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, bbh.HashTreeRoot(), proof.Root)
+	require.Equal(t, bbh.GetParentBlockRoot(), proof.Leaves[0])
+	verified, err := mlib.VerifyProof(
+		proof.GeneralizedIndices[0], proof.Leaves[0], proof.Proof, proof.Root,
+	)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	// Paths below the state root are not served without the state.
+	_, err = merkle.ProveObjectPathsInBlockHeader(
+		bbh, []string{"state_root/slot"},
+	)
+	require.ErrorIs(t, err, merkle.ErrInvalidObjectPath)
+}
+
+// TestProveObjectPathsErrors tests that invalid object paths are rejected.
+func TestProveObjectPathsErrors(t *testing.T) {
+	_, bs := newObjectProofTestBlock(t, 2)
+
+	for _, path := range []string{
+		"",
+		"unknown",
+		"slot/0",
+		"validators/x",
+		"validators/1099511627776",
+		// Beyond the validators in the state.
+		"validators/12/effective_balance",
+	} {
+		_, err := merkle.ProveObjectPathsInState(
+			bs.BeaconStateMarshallable, []string{path},
+		)
+		require.ErrorIs(t, err, merkle.ErrInvalidObjectPath, path)
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+//nolint:gochecknoglobals // schema definitions.
+var (
+	// BeaconBlockHeaderSchemaDeneb is the schema of the BeaconBlockHeader
+	// defined in beacon-kit/mod/consensus-types/pkg/types/header.go in the
+	// Deneb fork, with the SSZ expansion of the state root to the
+	// BeaconState. Paths may hence descend from `state_root` into the state.
+	BeaconBlockHeaderSchemaDeneb = defineBeaconBlockHeaderSchema(
+		types.BeaconStateSchemaDeneb,
+	)
+
+	// BeaconBlockHeaderSchemaElectra is the schema of the BeaconBlockHeader
+	// in the Electra fork, with the SSZ expansion of the state root to the
+	// BeaconState of the Electra fork.
+	BeaconBlockHeaderSchemaElectra = defineBeaconBlockHeaderSchema(
+		types.BeaconStateSchemaElectra,
+	)
+)
+
+// BeaconBlockHeaderSchema returns the schema of the BeaconBlockHeader, with
+// the SSZ expansion of the state root to the BeaconState, in the fork of the
+// given version.
+func BeaconBlockHeaderSchema(forkVersion uint32) schema.SSZType {
+	if forkVersion >= version.Electra {
+		return BeaconBlockHeaderSchemaElectra
+	}
+	return BeaconBlockHeaderSchemaDeneb
+}
+
+// defineBeaconBlockHeaderSchema defines the schema of the BeaconBlockHeader
+// whose state root expands to the BeaconState of the given schema.
+func defineBeaconBlockHeaderSchema(stateSchema schema.SSZType) schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("proposer_index", schema.U64()),
+		schema.NewField("parent_root", schema.B32()),
+		schema.NewField("state_root", stateSchema),
+		schema.NewField("body_root", schema.B32()),
+	)
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetStateProof returns the leaves at the requested object paths in the beacon
+// state for the given state id, along with a merkle proof (or multiproof, if
+// several paths are requested) that can be verified against the state root.
+func (h *Handler[
+	_, _, _, ContextT, _, _,
+]) GetStateProof(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.StateProofRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(params.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	beaconState, slot, err := h.backend.StateFromSlotForProof(slot)
+	if err != nil {
+		return nil, err
+	}
+	bsm, err := beaconState.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info(
+		"Generating beacon state proof", "slot", slot, "paths", params.Paths,
+	)
+	proof, err := merkle.ProveObjectPathsInState(bsm, params.Paths)
+	if err != nil {
+		return nil, objectProofError(err)
+	}
+	return objectProofResponse(proof), nil
+}
+
+// GetBlockProof returns the leaves at the requested object paths in the beacon
+// block for the given block id, along with a merkle proof (or multiproof, if
+// several paths are requested) that can be verified against the beacon block
+// root. Paths may descend into the beacon state through `state_root`.
+func (h *Handler[
+	_, _, _, ContextT, _, _,
+]) GetBlockProof(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.BlockProofRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(params.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	beaconState, slot, err := h.backend.StateFromSlotForProof(slot)
+	if err != nil {
+		return nil, err
+	}
+	bsm, err := beaconState.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	blockHeader, err := h.backend.BlockHeaderAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info(
+		"Generating beacon block proof", "slot", slot, "paths", params.Paths,
+	)
+	proof, err := merkle.ProveObjectPathsInBlock(
+		blockHeader, bsm, params.Paths,
+	)
+	if err != nil {
+		return nil, objectProofError(err)
+	}
+	return objectProofResponse(proof), nil
+}
+
+// objectProofError reports invalid object paths as invalid requests.
+func objectProofError(err error) error {
+	if errors.Is(err, merkle.ErrInvalidObjectPath) {
+		return fmt.Errorf("%w: %w", apitypes.ErrInvalidRequest, err)
+	}
+	return err
+}
+
+// objectProofResponse returns the response for the given object proof.
+func objectProofResponse(proof *merkle.ObjectProof) types.ObjectProofResponse {
+	gIndices := make([]math.U64, len(proof.GeneralizedIndices))
+	for i, gIndex := range proof.GeneralizedIndices {
+		gIndices[i] = math.U64(gIndex)
+	}
+	return types.ObjectProofResponse{
+		Root:               proof.Root,
+		GeneralizedIndices: gIndices,
+		Leaves:             proof.Leaves,
+		Proof:              proof.Proof,
+	}
+}
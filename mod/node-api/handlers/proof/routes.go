@@ -47,5 +47,20 @@ func (
 			Path:    "bkit/v1/proof/execution_fee_recipient/:timestamp_id",
 			Handler: h.GetExecutionFeeRecipient,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/block_multiproof/:timestamp_id",
+			Handler: h.GetBlockMultiproof,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/state/:state_id",
+			Handler: h.GetStateProof,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/block/:block_id",
+			Handler: h.GetBlockProof,
+		},
 	})
 }
@@ -39,3 +39,26 @@ type ExecutionNumberRequest struct {
 type ExecutionFeeRecipientRequest struct {
 	types.TimestampIDRequest
 }
+
+// BlockMultiproofRequest is the request for the
+// `/proof/block_multiproof/{timestamp_id}` endpoint.
+type BlockMultiproofRequest struct {
+	types.TimestampIDRequest
+}
+
+// StateProofRequest is the request for the `/proof/state/{state_id}`
+// endpoint. Each path is an object path in the beacon state, e.g.
+// `validators/12/effective_balance`.
+type StateProofRequest struct {
+	types.StateIDRequest
+	Paths []string `query:"path" validate:"required,dive,required"`
+}
+
+// BlockProofRequest is the request for the `/proof/block/{block_id}`
+// endpoint. Each path is an object path in the beacon block header, which
+// may descend into the beacon state through `state_root`, e.g.
+// `state_root/validators/12/effective_balance`.
+type BlockProofRequest struct {
+	types.BlockIDRequest
+	Paths []string `query:"path" validate:"required,dive,required"`
+}
@@ -87,3 +87,55 @@ type ExecutionFeeRecipientResponse[BeaconBlockHeaderT any] struct {
 	// using a Generalized Index of 5894 in the Deneb fork.
 	ExecutionFeeRecipientProof []common.Root `json:"execution_fee_recipient_proof"`
 }
+
+// BlockMultiproofResponse is the response for the
+// `/proof/block_multiproof/{timestamp_id}` endpoint.
+type BlockMultiproofResponse[BeaconBlockHeaderT any] struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader BeaconBlockHeaderT `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// ValidatorPubkey is the pubkey of the block proposer.
+	ValidatorPubkey crypto.BLSPubkey `json:"validator_pubkey"`
+
+	// ExecutionNumber is the block number from the execution payload.
+	ExecutionNumber math.U64 `json:"execution_number"`
+
+	// ExecutionFeeRecipient is the fee recipient from the execution payload.
+	ExecutionFeeRecipient common.ExecutionAddress `json:"execution_fee_recipient"`
+
+	// GeneralizedIndices are the generalized indices in the beacon block of,
+	// in order, the proposer index, the proposer pubkey, the execution number
+	// and the execution fee recipient.
+	GeneralizedIndices []math.U64 `json:"generalized_indices"`
+
+	// Leaves are the hash tree roots of, in order, the proposer index, the
+	// proposer pubkey, the execution number and the execution fee recipient.
+	Leaves []common.Root `json:"leaves"`
+
+	// Multiproof can be verified against the beacon block root with
+	// `merkle.VerifyMultiproof`, using the generalized indices and leaves.
+	Multiproof []common.Root `json:"multiproof"`
+}
+
+// ObjectProofResponse is the response for the `/proof/state/{state_id}` and
+// `/proof/block/{block_id}` endpoints.
+type ObjectProofResponse struct {
+	// Root is the beacon state root or beacon block root to verify against.
+	Root common.Root `json:"root"`
+
+	// GeneralizedIndices are the generalized indices of the requested paths,
+	// in the order of the requested paths.
+	GeneralizedIndices []math.U64 `json:"generalized_indices"`
+
+	// Leaves are the 32 byte nodes at the generalized indices.
+	Leaves []common.Root `json:"leaves"`
+
+	// Proof can be verified against the root with `merkle.VerifyProof` if a
+	// single path was requested, and with `merkle.VerifyMultiproof`
+	// otherwise.
+	Proof []common.Root `json:"proof"`
+}
@@ -20,6 +20,11 @@
 
 package types
 
+import (
+	"context"
+	"io"
+)
+
 type DataResponse struct {
 	Data any `json:"data"`
 }
@@ -29,3 +34,30 @@ func Wrap(data any) DataResponse {
 		Data: data,
 	}
 }
+
+// SSZMarshaler is implemented by responses that can be served as SSZ-encoded
+// bytes when the client requests them with an application/octet-stream
+// Accept header.
+type SSZMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// VersionedResponse is implemented by responses whose payload depends on the
+// consensus fork, so that the fork name can be reported to the client.
+type VersionedResponse interface {
+	ConsensusVersion() string
+}
+
+// StatusResponse is implemented by responses which carry no body and are
+// reported to the client through the HTTP status code alone.
+type StatusResponse interface {
+	StatusCode() int
+}
+
+// EventStream is implemented by responses which are streamed to the client as
+// server-sent events rather than written once.
+type EventStream interface {
+	// Stream writes events to w, calling flush after each of them, until the
+	// context is cancelled or the stream ends.
+	Stream(ctx context.Context, w io.Writer, flush func()) error
+}
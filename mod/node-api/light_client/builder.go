@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import (
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// validatorsPath is the object path of the validators in the state.
+	validatorsPath = "validators"
+	// parentRootPath is the object path of the parent block root in the
+	// block header.
+	parentRootPath = "parent_root"
+	// validatorSetDelay is the number of slots after which the validator
+	// updates of a block take effect in CometBFT. The commit of a block is
+	// signed by the validators of the state this many slots before it.
+	validatorSetDelay = 2
+)
+
+var (
+	// errStateRootMismatch is returned when the state a light client object
+	// is built from does not match the state root of its header.
+	errStateRootMismatch = errors.New("state root mismatch")
+
+	// errFinalizedRootMismatch is returned when the parent block root of the
+	// attested header does not match the finalized header.
+	errFinalizedRootMismatch = errors.New("finalized block root mismatch")
+)
+
+// SyncPeriod returns the sync period of the given slot. Since CometBFT
+// provides single-slot finality there are no sync committees, so a period
+// spans the slots of one cycle of the block roots in the beacon state.
+func SyncPeriod(slotsPerHistoricalRoot uint64, slot math.Slot) math.U64 {
+	return math.U64(slot.Unwrap() / slotsPerHistoricalRoot)
+}
+
+// isBetterUpdate returns true if the update of the given finality update is
+// a better update of its period than the best one stored, that is if its
+// commit is signed by more validators. With as many signatures the stored
+// update, which is older, is kept.
+func isBetterUpdate(
+	finality *ctypes.LightClientFinalityUpdate,
+	best *ctypes.LightClientUpdate,
+) bool {
+	return best == nil ||
+		finality.Commit.NumCommitted() > best.Commit.NumCommitted()
+}
+
+// buildBootstrap builds the light client bootstrap of the block at the given
+// slot, with the validator set of its state and the branch of the validators
+// in its state.
+func (s *Service[
+	_, BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT, _,
+]) buildBootstrap(slot math.Slot) (*ctypes.LightClientBootstrap, error) {
+	header, err := s.backend.BlockHeaderAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	validators, branch, err := s.proveValidators(
+		slot, header.GetStateRoot(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.LightClientBootstrap{
+		Header:           lightClientHeader(header),
+		Validators:       validators,
+		ValidatorsBranch: branch,
+	}, nil
+}
+
+// buildFinalityUpdate builds the light client finality update attested by
+// the block at the given slot. As every committed block is final, the
+// finalized header is the one of the parent of the attested block, proven by
+// the parent block root of the attested header, which does not require
+// merkleizing any state. The signature slot is the slot of the block
+// carrying the commit of the attested block.
+func (s *Service[
+	_, BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT, _,
+]) buildFinalityUpdate(
+	attestedSlot, signatureSlot math.Slot,
+) (*ctypes.LightClientFinalityUpdate, error) {
+	finalizedSlot := attestedSlot - 1
+	attestedHeader, err := s.backend.BlockHeaderAtSlot(attestedSlot)
+	if err != nil {
+		return nil, err
+	}
+	finalizedHeader, err := s.backend.BlockHeaderAtSlot(finalizedSlot)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := merkle.ProveObjectPathsInBlockHeader(
+		attestedHeader, []string{parentRootPath},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if proof.Leaves[0] != finalizedHeader.HashTreeRoot() {
+		return nil, errors.Wrapf(
+			errFinalizedRootMismatch, "slot %d", finalizedSlot,
+		)
+	}
+	commit, err := s.backend.CommitAtSlot(attestedSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.LightClientFinalityUpdate{
+		AttestedHeader:  lightClientHeader(attestedHeader),
+		FinalizedHeader: lightClientHeader(finalizedHeader),
+		FinalityBranch:  proof.Proof,
+		Commit:          commit,
+		SignatureSlot:   signatureSlot,
+	}, nil
+}
+
+// buildUpdate builds the light client update of the given finality update,
+// with the validator set that signed its commit and the validator set of its
+// attested state, proven against the state root of its attested header.
+func (s *Service[
+	_, BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT, _,
+]) buildUpdate(
+	finality *ctypes.LightClientFinalityUpdate,
+) (*ctypes.LightClientUpdate, error) {
+	attested := finality.AttestedHeader.Beacon
+	nextValidators, nextBranch, err := s.proveValidators(
+		attested.GetSlot(), attested.GetStateRoot(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	st, _, err := s.backend.StateFromSlotForProof(
+		attested.GetSlot() - validatorSetDelay,
+	)
+	if err != nil {
+		return nil, err
+	}
+	signingValidators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.LightClientUpdate{
+		AttestedHeader:       finality.AttestedHeader,
+		NextValidators:       nextValidators,
+		NextValidatorsBranch: nextBranch,
+		FinalizedHeader:      finality.FinalizedHeader,
+		FinalityBranch:       finality.FinalityBranch,
+		Commit:               finality.Commit,
+		SigningValidators:    signingValidators,
+		SignatureSlot:        finality.SignatureSlot,
+	}, nil
+}
+
+// proveValidators returns the validator set of the state at the given slot
+// and the branch of the validators in the state, whose root must be the
+// given state root.
+func (s *Service[
+	_, BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT, _,
+]) proveValidators(
+	slot math.Slot, stateRoot common.Root,
+) ([]*ctypes.Validator, []common.Root, error) {
+	st, _, err := s.backend.StateFromSlotForProof(slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	bsm, err := st.GetMarshallable()
+	if err != nil {
+		return nil, nil, err
+	}
+	proof, err := merkle.ProveObjectPathsInState(
+		bsm, []string{validatorsPath},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if proof.Root != stateRoot {
+		return nil, nil, errors.Wrapf(errStateRootMismatch, "slot %d", slot)
+	}
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, nil, err
+	}
+	return validators, proof.Proof, nil
+}
+
+// lightClientHeader returns the light client header of the given header.
+func lightClientHeader[BeaconBlockHeaderT BeaconBlockHeader](
+	header BeaconBlockHeaderT,
+) *ctypes.LightClientHeader {
+	return ctypes.NewLightClientHeader(ctypes.NewBeaconBlockHeader(
+		header.GetSlot(),
+		header.GetProposerIndex(),
+		header.GetParentBlockRoot(),
+		header.GetStateRoot(),
+		header.GetBodyRoot(),
+	))
+}
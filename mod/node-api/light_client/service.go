@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	prooftypes "github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// MaxRequestLightClientUpdates is the maximum number of light client updates
+// returned by a single request.
+const MaxRequestLightClientUpdates = 128
+
+// errDisabled is returned when the light client service is disabled.
+var errDisabled = errors.New("light client service is disabled")
+
+// Service is a Service that builds light client updates as blocks finalize
+// and stores them by sync period. It also serves light client bootstraps,
+// which are built on demand.
+type Service[
+	BeaconBlockT BeaconBlock,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT prooftypes.BeaconStateMarshallable,
+	StoreT Store,
+] struct {
+	// config is the configuration for the light client service.
+	config Config
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// backend is the node API backend the light client objects are built
+	// from.
+	backend Backend[BeaconBlockHeaderT, BeaconStateT]
+	// store is the store of light client updates for the service.
+	store StoreT
+	// latest is the finality update built from the latest finalized block.
+	latest atomic.Pointer[ctypes.LightClientFinalityUpdate]
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+}
+
+// NewService creates a new light client service.
+func NewService[
+	BeaconBlockT BeaconBlock,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT prooftypes.BeaconStateMarshallable,
+	StoreT Store,
+](
+	config Config,
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+	backend Backend[BeaconBlockHeaderT, BeaconStateT],
+	store StoreT,
+) *Service[
+	BeaconBlockT, BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
+	StoreT,
+] {
+	return &Service[
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT,
+		BeaconStateMarshallableT, StoreT,
+	]{
+		config:                config,
+		logger:                logger,
+		dispatcher:            dispatcher,
+		backend:               backend,
+		store:                 store,
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_, _, _, _, _]) Name() string {
+	return "light-client-service"
+}
+
+// Start subscribes the light client service to BeaconBlockFinalized events
+// and starts the main event loop to handle them accordingly.
+func (s *Service[_, _, _, _, _]) Start(ctx context.Context) error {
+	if !s.config.Enabled {
+		s.logger.Warn(
+			"light client service is disabled, skipping building updates",
+		)
+		return nil
+	}
+
+	// subscribe a channel to the finalized block events.
+	if err := s.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, s.subFinalizedBlkEvents,
+	); err != nil {
+		s.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+
+	// start the event loop to listen and handle events.
+	go s.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop for the light client service.
+func (s *Service[_, _, _, _, _]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-s.subFinalizedBlkEvents:
+			if !ok {
+				return
+			}
+			s.onFinalizeBlock(event)
+		}
+	}
+}
+
+// onFinalizeBlock is triggered when a finalized block event is received.
+// The state of the finalized block is not committed yet, so it builds the
+// finality update attested by the parent block, whose commit is carried by the
+// finalized block. Only the best update of each period is stored, so the
+// validator sets of the update, whose branch requires merkleizing the attested
+// state, are only built if it improves on the stored one.
+func (s *Service[BeaconBlockT, _, _, _, _]) onFinalizeBlock(
+	event async.Event[BeaconBlockT],
+) {
+	slot := event.Data().GetSlot()
+
+	// The signing validators of the update are the ones of a state after
+	// genesis.
+	if slot <= validatorSetDelay+1 {
+		return
+	}
+
+	finality, err := s.buildFinalityUpdate(slot-1, slot)
+	if err != nil {
+		s.logger.Error(
+			"failed to build light client finality update",
+			"slot", slot, "error", err,
+		)
+		return
+	}
+	s.latest.Store(finality)
+
+	period := SyncPeriod(
+		s.backend.ChainSpec().SlotsPerHistoricalRoot(), finality.GetSlot(),
+	)
+	best, err := s.store.Get(period)
+	if err != nil {
+		s.logger.Error(
+			"failed to get light client update", "period", period,
+			"error", err,
+		)
+		return
+	}
+	if !isBetterUpdate(finality, best) {
+		return
+	}
+
+	update, err := s.buildUpdate(finality)
+	if err != nil {
+		s.logger.Error(
+			"failed to build light client update", "slot", slot, "error", err,
+		)
+		return
+	}
+	if err = s.store.Set(period, update); err != nil {
+		s.logger.Error(
+			"failed to store light client update", "slot", slot, "error", err,
+		)
+	}
+}
+
+// Bootstrap returns the light client bootstrap of the block with the given
+// root.
+func (s *Service[_, _, _, _, _]) Bootstrap(
+	blockRoot common.Root,
+) (*ctypes.LightClientBootstrap, error) {
+	if !s.config.Enabled {
+		return nil, errDisabled
+	}
+	slot, err := s.backend.GetSlotByBlockRoot(blockRoot)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", apitypes.ErrNotFound, err)
+	}
+	return s.buildBootstrap(slot)
+}
+
+// Updates returns the stored light client updates of up to count periods
+// starting at the given period.
+func (s *Service[_, _, _, _, _]) Updates(
+	startPeriod math.U64, count uint64,
+) ([]*ctypes.LightClientUpdate, error) {
+	if !s.config.Enabled {
+		return nil, errDisabled
+	}
+	count = min(count, MaxRequestLightClientUpdates)
+	return s.store.GetRange(startPeriod, startPeriod+math.U64(count))
+}
+
+// FinalityUpdate returns the light client finality update of the latest
+// finalized block.
+func (s *Service[_, _, _, _, _]) FinalityUpdate() (
+	*ctypes.LightClientFinalityUpdate, error,
+) {
+	return s.latestUpdate()
+}
+
+// OptimisticUpdate returns the light client optimistic update of the latest
+// finalized block.
+func (s *Service[_, _, _, _, _]) OptimisticUpdate() (
+	*ctypes.LightClientOptimisticUpdate, error,
+) {
+	update, err := s.latestUpdate()
+	if err != nil {
+		return nil, err
+	}
+	return update.ToOptimisticUpdate(), nil
+}
+
+// latestUpdate returns the finality update built from the latest finalized
+// block.
+func (s *Service[_, _, _, _, _]) latestUpdate() (
+	*ctypes.LightClientFinalityUpdate, error,
+) {
+	if !s.config.Enabled {
+		return nil, errDisabled
+	}
+	update := s.latest.Load()
+	if update == nil {
+		return nil, fmt.Errorf(
+			"%w: no light client update available", apitypes.ErrNotFound,
+		)
+	}
+	return update, nil
+}
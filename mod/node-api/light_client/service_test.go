@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	proofmerkle "github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle/mock"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	lightclient "github.com/berachain/beacon-kit/mod/node-api/light_client"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const slotsPerHistoricalRoot = 8
+
+type chainSpec struct{ common.ChainSpec }
+
+func (chainSpec) SlotsPerHistoricalRoot() uint64 {
+	return slotsPerHistoricalRoot
+}
+
+type block struct{ slot math.Slot }
+
+func (b block) GetSlot() math.Slot { return b.slot }
+
+// backend serves a chain of blocks whose states hold the roots of the
+// previous blocks, and whose commits are signed by one of two validators,
+// or by both for the slots in signedByAll.
+type backend struct {
+	headers     map[math.Slot]*ctypes.BeaconBlockHeader
+	states      map[math.Slot]*mock.BeaconState
+	signedByAll map[math.Slot]bool
+}
+
+func newBackend(t *testing.T, numSlots math.Slot) *backend {
+	t.Helper()
+	b := &backend{
+		headers:     make(map[math.Slot]*ctypes.BeaconBlockHeader),
+		states:      make(map[math.Slot]*mock.BeaconState),
+		signedByAll: map[math.Slot]bool{5: true, 6: true},
+	}
+	blockRoots := make([]common.Root, slotsPerHistoricalRoot)
+	var parentRoot common.Root
+	for slot := range numSlots + 1 {
+		// The balance of a validator tells the state it is from.
+		vals := ctypes.Validators{
+			{
+				Pubkey:           crypto.BLSPubkey{1},
+				EffectiveBalance: math.Gwei(32e9 + slot),
+			},
+			{Pubkey: crypto.BLSPubkey{2}, EffectiveBalance: 32e9},
+		}
+		st, err := mock.NewBeaconState(slot, vals, 0, common.ExecutionAddress{})
+		require.NoError(t, err)
+		st.BlockRoots = append([]common.Root(nil), blockRoots...)
+
+		header := ctypes.NewBeaconBlockHeader(
+			slot, 0, parentRoot, st.HashTreeRoot(), common.Root{byte(slot)},
+		)
+		parentRoot = header.HashTreeRoot()
+		blockRoots[slot%slotsPerHistoricalRoot] = parentRoot
+
+		b.headers[slot], b.states[slot] = header, st
+	}
+	return b
+}
+
+func (b *backend) BlockHeaderAtSlot(
+	slot math.Slot,
+) (*ctypes.BeaconBlockHeader, error) {
+	return b.headers[slot], nil
+}
+
+func (b *backend) StateFromSlotForProof(
+	slot math.Slot,
+) (*mock.BeaconState, math.Slot, error) {
+	return b.states[slot], slot, nil
+}
+
+func (b *backend) CommitAtSlot(
+	slot math.Slot,
+) (*ctypes.LightClientCommit, error) {
+	flag := ctypes.BlockIDFlagAbsent
+	if b.signedByAll[slot] {
+		flag = ctypes.BlockIDFlagCommit
+	}
+	return &ctypes.LightClientCommit{
+		Height: slot,
+		Signatures: []*ctypes.CommitSignature{
+			{BlockIDFlag: math.U64(ctypes.BlockIDFlagCommit)},
+			{BlockIDFlag: math.U64(flag)},
+		},
+	}, nil
+}
+
+func (b *backend) GetSlotByBlockRoot(root common.Root) (math.Slot, error) {
+	for slot, header := range b.headers {
+		if header.HashTreeRoot() == root {
+			return slot, nil
+		}
+	}
+	return 0, errors.New("not found")
+}
+
+func (*backend) ChainSpec() common.ChainSpec { return chainSpec{} }
+
+// store keeps the updates by period in memory.
+type store map[math.U64]*ctypes.LightClientUpdate
+
+func (s store) Get(period math.U64) (*ctypes.LightClientUpdate, error) {
+	return s[period], nil
+}
+
+func (s store) Set(period math.U64, update *ctypes.LightClientUpdate) error {
+	s[period] = update
+	return nil
+}
+
+func (s store) GetRange(
+	start, end math.U64,
+) ([]*ctypes.LightClientUpdate, error) {
+	var updates []*ctypes.LightClientUpdate
+	for period := start; period < end; period++ {
+		if update, ok := s[period]; ok {
+			updates = append(updates, update)
+		}
+	}
+	return updates, nil
+}
+
+// dispatcher hands out the finalized block events to the subscribed channel.
+type dispatcher struct {
+	ch chan async.Event[block]
+}
+
+func (*dispatcher) Publish(async.BaseEvent) error { return nil }
+
+func (d *dispatcher) Subscribe(_ async.EventID, ch any) error {
+	d.ch = ch.(chan async.Event[block])
+	return nil
+}
+
+func (*dispatcher) Unsubscribe(async.EventID, any) error { return nil }
+
+func newService(
+	t *testing.T, enabled bool,
+) (*lightclient.Service[
+	block, *ctypes.BeaconBlockHeader, *mock.BeaconState,
+	*mock.BeaconStateMarshallable, store,
+], *backend, *dispatcher) {
+	t.Helper()
+	b, d := newBackend(t, 12), &dispatcher{}
+	s := lightclient.NewService[
+		block, *ctypes.BeaconBlockHeader, *mock.BeaconState,
+		*mock.BeaconStateMarshallable, store,
+	](
+		lightclient.Config{Enabled: enabled},
+		noop.NewLogger[any](),
+		d,
+		b,
+		store{},
+	)
+	require.NoError(t, s.Start(context.Background()))
+	return s, b, d
+}
+
+// gIndex returns the generalized index of the given object path in an
+// object of the given schema.
+func gIndex(
+	t *testing.T, typ schema.SSZType, path string,
+) merkle.GeneralizedIndex {
+	t.Helper()
+	_, gIndex, _, err := merkle.ObjectPath[
+		merkle.GeneralizedIndex, common.Root,
+	](path).GetGeneralizedIndex(typ)
+	require.NoError(t, err)
+	return gIndex
+}
+
+func TestBootstrap(t *testing.T) {
+	s, b, _ := newService(t, true)
+	header := b.headers[5]
+
+	bootstrap, err := s.Bootstrap(header.HashTreeRoot())
+	require.NoError(t, err)
+	require.Equal(t, header, bootstrap.Header.Beacon)
+	require.Equal(t, []*ctypes.Validator(b.states[5].Validators),
+		bootstrap.Validators)
+
+	// The validators branch proves the validators against the state root.
+	verified, err := merkle.VerifyProof(
+		gIndex(t, ctypes.BeaconStateSchemaDeneb, "validators"),
+		ctypes.Validators(bootstrap.Validators).HashTreeRoot(),
+		bootstrap.ValidatorsBranch,
+		header.GetStateRoot(),
+	)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	_, err = s.Bootstrap(common.Root{0xff})
+	require.ErrorIs(t, err, apitypes.ErrNotFound)
+}
+
+func TestUpdates(t *testing.T) {
+	s, b, d := newService(t, true)
+
+	_, err := s.FinalityUpdate()
+	require.ErrorIs(t, err, apitypes.ErrNotFound)
+
+	for slot := range math.Slot(13) {
+		d.ch <- async.NewEvent(
+			context.Background(), async.BeaconBlockFinalized, block{slot},
+		)
+	}
+	require.Eventually(t, func() bool {
+		update, _ := s.FinalityUpdate()
+		return update != nil && update.SignatureSlot == 12
+	}, time.Second, 10*time.Millisecond)
+
+	// The latest update is attested by the parent of the latest block, and
+	// finalizes its parent.
+	finality, err := s.FinalityUpdate()
+	require.NoError(t, err)
+	require.Equal(t, b.headers[11], finality.AttestedHeader.Beacon)
+	require.Equal(t, b.headers[10], finality.FinalizedHeader.Beacon)
+	require.Equal(t, math.U64(11), finality.Commit.Height)
+	verified, err := merkle.VerifyProof(
+		gIndex(t, proofmerkle.BeaconBlockHeaderSchemaDeneb, "parent_root"),
+		b.headers[10].HashTreeRoot(),
+		finality.FinalityBranch,
+		b.headers[11].HashTreeRoot(),
+	)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	optimistic, err := s.OptimisticUpdate()
+	require.NoError(t, err)
+	require.Equal(t, finality.AttestedHeader, optimistic.AttestedHeader)
+	require.Equal(t, finality.Commit, optimistic.Commit)
+	require.Equal(t, finality.SignatureSlot, optimistic.SignatureSlot)
+
+	// Slots 3 to 7 are in period 0 and slots 8 to 11 in period 1. The best
+	// update of each period is kept: the first one signed by both
+	// validators in period 0, the first one in period 1.
+	updates, err := s.Updates(0, 4)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	require.Equal(t, b.headers[5], updates[0].AttestedHeader.Beacon)
+	require.Equal(t, b.headers[8], updates[1].AttestedHeader.Beacon)
+
+	// The update carries the validators that signed its commit, the ones of
+	// the state two slots before, and the validators of its attested state,
+	// proven against its state root.
+	update := updates[0]
+	require.Equal(t, 2, update.Commit.NumCommitted())
+	require.Equal(t, []*ctypes.Validator(b.states[3].Validators),
+		update.SigningValidators)
+	require.Equal(t, []*ctypes.Validator(b.states[5].Validators),
+		update.NextValidators)
+	verified, err = merkle.VerifyProof(
+		gIndex(t, ctypes.BeaconStateSchemaDeneb, "validators"),
+		ctypes.Validators(update.NextValidators).HashTreeRoot(),
+		update.NextValidatorsBranch,
+		b.headers[5].GetStateRoot(),
+	)
+	require.NoError(t, err)
+	require.True(t, verified)
+}
+
+func TestDisabled(t *testing.T) {
+	s, b, d := newService(t, false)
+	require.Nil(t, d.ch)
+
+	_, err := s.Bootstrap(b.headers[1].HashTreeRoot())
+	require.Error(t, err)
+	_, err = s.Updates(0, 1)
+	require.Error(t, err)
+	_, err = s.FinalityUpdate()
+	require.Error(t, err)
+	_, err = s.OptimisticUpdate()
+	require.Error(t, err)
+}
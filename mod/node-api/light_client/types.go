@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import (
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	prooftypes "github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the interface for a finalized beacon block.
+type BeaconBlock interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+}
+
+// BeaconBlockHeader is the interface for a beacon block header.
+type BeaconBlockHeader interface {
+	prooftypes.BeaconBlockHeader
+	// GetSlot returns the slot of the header.
+	GetSlot() math.Slot
+	// GetParentBlockRoot returns the parent block root of the header.
+	GetParentBlockRoot() common.Root
+	// GetStateRoot returns the state root of the header.
+	GetStateRoot() common.Root
+	// GetBodyRoot returns the body root of the header.
+	GetBodyRoot() common.Root
+}
+
+// BeaconState is the interface for a beacon state.
+type BeaconState[BeaconStateMarshallableT any] interface {
+	// GetMarshallable returns the marshallable version of the beacon state.
+	GetMarshallable() (BeaconStateMarshallableT, error)
+	// GetValidators returns the validator set of the beacon state.
+	GetValidators() (ctypes.Validators, error)
+}
+
+// Backend is the interface of the node API backend the light client objects
+// are built from.
+type Backend[BeaconBlockHeaderT, BeaconStateT any] interface {
+	// BlockHeaderAtSlot returns the block header at the given slot.
+	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+	// StateFromSlotForProof returns the beacon state committed at the given
+	// slot, whose root is the state root of the block header at the slot.
+	StateFromSlotForProof(slot math.Slot) (BeaconStateT, math.Slot, error)
+	// CommitAtSlot returns the CometBFT commit of the block at the given
+	// slot.
+	CommitAtSlot(slot math.Slot) (*ctypes.LightClientCommit, error)
+	// GetSlotByBlockRoot retrieves the slot by a given block root.
+	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	// ChainSpec returns the chain spec of the node.
+	ChainSpec() common.ChainSpec
+}
+
+// Store is the interface of the store of light client updates, keyed by sync
+// period.
+type Store interface {
+	// Get returns the update stored for the given period, nil if none is.
+	Get(period math.U64) (*ctypes.LightClientUpdate, error)
+	// Set persists the update as the update of the given period.
+	Set(period math.U64, update *ctypes.LightClientUpdate) error
+	// GetRange returns the updates stored for the periods in the range
+	// [start, end).
+	GetRange(start, end math.U64) ([]*ctypes.LightClientUpdate, error)
+}
@@ -22,7 +22,9 @@ package server
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
@@ -33,9 +35,10 @@ import (
 type Server[
 	ContextT apicontext.Context,
 ] struct {
-	engine Engine[ContextT]
-	config Config
-	logger log.Logger
+	engine   Engine[ContextT]
+	config   Config
+	logger   log.Logger
+	handlers []handlers.Handlers[ContextT]
 }
 
 // New initializes a new API Server with the given config, engine, and logger.
@@ -58,9 +61,10 @@ func New[
 		engine.RegisterRoutes(handler.RouteSet(), apiLogger)
 	}
 	return &Server[ContextT]{
-		engine: engine,
-		config: config,
-		logger: logger,
+		engine:   engine,
+		config:   config,
+		logger:   logger,
+		handlers: handlers,
 	}
 }
 
@@ -69,6 +73,11 @@ func (s *Server[_]) Start(ctx context.Context) error {
 	if !s.config.Enabled {
 		return nil
 	}
+	for _, handler := range s.handlers {
+		if service, ok := handler.(handlers.Service); ok {
+			service.Start(ctx)
+		}
+	}
 	go s.start(ctx)
 	return nil
 }
@@ -81,13 +90,24 @@ func (s *Server[_]) start(ctx context.Context) {
 	for {
 		select {
 		case err := <-errCh:
-			s.logger.Error(err.Error())
+			if !errors.Is(err, http.ErrServerClosed) {
+				s.logger.Error(err.Error())
+			}
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// Stop gracefully shuts the API Server down, waiting for the requests being
+// served to complete.
+func (s *Server[_]) Stop(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	return s.engine.Shutdown(ctx)
+}
+
 // Name returns the name of the API server service.
 func (s *Server[_]) Name() string {
 	return "node-api-server"
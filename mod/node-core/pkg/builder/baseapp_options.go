@@ -27,11 +27,14 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	server "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
 	"github.com/berachain/beacon-kit/mod/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
@@ -41,6 +44,10 @@ import (
 // called by cosmos when building the app.
 // TODO: refactor into consensus_options for serverv2 migration.
 
+// flagStateSyncEnable is the key of the CometBFT configuration enabling state
+// sync, which is read into the application options along with config.toml.
+const flagStateSyncEnable = "statesync.enable"
+
 // DefaultServiceOptions returns the default Service options provided by the
 // Cosmos SDK.
 func DefaultServiceOptions[
@@ -68,6 +75,16 @@ func DefaultServiceOptions[
 		}
 	}
 
+	snapshotOpts := snapshottypes.NewSnapshotOptions(
+		cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
+		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
+	)
+
+	snapshotStore, err := loadSnapshotStore(appOpts, snapshotOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	return []func(*cometbft.Service[LoggerT]){
 		cometbft.SetPruning[LoggerT](pruningOpts),
 		cometbft.SetMinRetainBlocks[LoggerT](
@@ -82,7 +99,37 @@ func DefaultServiceOptions[
 			true,
 		),
 		cometbft.SetChainID[LoggerT](chainID),
+		cometbft.SetSnapshot[LoggerT](snapshotStore, snapshotOpts),
+	}
+}
+
+// loadSnapshotStore opens the state sync snapshot store under the data
+// directory of the node home. No store is opened, and snapshots are disabled,
+// if the node neither takes snapshots nor state syncs from the snapshots of
+// its peers.
+func loadSnapshotStore(
+	appOpts config.AppOptions,
+	opts snapshottypes.SnapshotOptions,
+) (*snapshots.Store, error) {
+	if opts.Interval == 0 && !cast.ToBool(appOpts.Get(flagStateSyncEnable)) {
+		return nil, nil //nolint:nilnil // snapshots are disabled.
+	}
+
+	var (
+		homeDir     = cast.ToString(appOpts.Get(flags.FlagHome))
+		snapshotDir = filepath.Join(homeDir, "data", "snapshots")
+	)
+
+	if err := os.MkdirAll(snapshotDir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create snapshots directory: %w", err)
 	}
+
+	snapshotDB, err := dbm.NewDB("metadata", dbm.PebbleDBBackend, snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots.NewStore(snapshotDB, snapshotDir)
 }
 
 func loadChainIDFromGenesis(appOpts config.AppOptions) (string, error) {
@@ -28,6 +28,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
@@ -82,10 +84,12 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 	var (
 		apiBackend interface {
 			AttachQueryBackend(*cometbft.Service[LoggerT])
+			AttachServiceRegistry(backend.ServiceRegistry)
 		}
 		beaconNode NodeT
 		cmtService *cometbft.Service[LoggerT]
 		config     *config.Config
+		registry   *service.Registry
 	)
 
 	// build all node components using depinject
@@ -105,6 +109,7 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 		&beaconNode,
 		&cmtService,
 		&config,
+		&registry,
 	); err != nil {
 		panic(err)
 	}
@@ -118,5 +123,6 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 	// TODO: so hood
 	logger.WithConfig(any(config.GetLogger()).(LoggerConfigT))
 	apiBackend.AttachQueryBackend(cmtService)
+	apiBackend.AttachServiceRegistry(registry)
 	return beaconNode
 }
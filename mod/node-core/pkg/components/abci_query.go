@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"cosmossdk.io/depinject"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/statestore"
+)
+
+const (
+	// QueryPathLatestHeader serves the latest beacon block header.
+	QueryPathLatestHeader = "/beacon/state/latest_header"
+	// QueryPathValidators serves the validator at the given index, or all
+	// validators if no index is given.
+	QueryPathValidators = "/beacon/state/validators"
+	// QueryPathBalances serves the balance of the validator at the given
+	// index, or all balances if no index is given.
+	QueryPathBalances = "/beacon/state/balances"
+	// QueryPathRandao serves the RANDAO mix of the given epoch, defaulting to
+	// the epoch of the queried state.
+	QueryPathRandao = "/beacon/state/randao"
+
+	// QueryParamIndex is the query parameter selecting a validator index.
+	QueryParamIndex = "index"
+	// QueryParamEpoch is the query parameter selecting an epoch.
+	QueryParamEpoch = "epoch"
+)
+
+// errIndexRequired is returned when a query needs an index to be served.
+var errIndexRequired = errors.New("query requires an index")
+
+// ABCIQueryHandlersInput is the input for the ABCI query handlers.
+type ABCIQueryHandlersInput[KVStoreT any] struct {
+	depinject.In
+	ChainSpec common.ChainSpec
+	KVStore   KVStoreT
+}
+
+// ProvideABCIQueryHandlers provides the handlers of the ABCI queries that
+// expose the beacon state over the CometBFT RPC. Proofs are only served when
+// the state is stored field by field, as a Merkle tree store does not keep
+// the queried values under keys of their own.
+func ProvideABCIQueryHandlers[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+](
+	in ABCIQueryHandlersInput[*statestore.KVStore[
+		BeaconBlockHeaderT,
+		*Eth1Data,
+		ExecutionPayloadHeaderT,
+		*Fork,
+		*Validator,
+		Validators,
+	]],
+) map[string]cometbft.QueryHandler {
+	kv, cs := in.KVStore, in.ChainSpec
+	proofKey := func(key []byte, err error) ([]byte, error) {
+		if err != nil || kv.IsMerkleized() {
+			return nil, err
+		}
+		return key, nil
+	}
+	return map[string]cometbft.QueryHandler{
+		QueryPathLatestHeader: func(
+			ctx context.Context, _ url.Values,
+		) (any, []byte, error) {
+			header, err := kv.WithContext(ctx).GetLatestBlockHeader()
+			if err != nil {
+				return nil, nil, err
+			}
+			key, err := proofKey(beacondb.LatestBlockHeaderKey(), nil)
+			return header, key, err
+		},
+		QueryPathValidators: func(
+			ctx context.Context, params url.Values,
+		) (any, []byte, error) {
+			st := kv.WithContext(ctx)
+			index, ok, err := queryIndex(params, QueryParamIndex)
+			if err != nil {
+				return nil, nil, err
+			} else if !ok {
+				vals, valsErr := st.GetValidators()
+				return vals, nil, valsErr
+			}
+			val, err := st.ValidatorByIndex(math.ValidatorIndex(index))
+			if err != nil {
+				return nil, nil, err
+			}
+			key, err := proofKey(beacondb.ValidatorKey(index))
+			return val, key, err
+		},
+		QueryPathBalances: func(
+			ctx context.Context, params url.Values,
+		) (any, []byte, error) {
+			st := kv.WithContext(ctx)
+			index, ok, err := queryIndex(params, QueryParamIndex)
+			if err != nil {
+				return nil, nil, err
+			} else if !ok {
+				balances, balErr := st.GetBalances()
+				return queryUint64s(balances), nil, balErr
+			}
+			balance, err := st.GetBalance(math.ValidatorIndex(index))
+			if err != nil {
+				return nil, nil, err
+			}
+			key, err := proofKey(beacondb.BalanceKey(index))
+			return queryUint64s{balance.Unwrap()}, key, err
+		},
+		QueryPathRandao: func(
+			ctx context.Context, params url.Values,
+		) (any, []byte, error) {
+			st := kv.WithContext(ctx)
+			epoch, ok, err := queryIndex(params, QueryParamEpoch)
+			if err != nil {
+				return nil, nil, err
+			} else if !ok {
+				slot, slotErr := st.GetSlot()
+				if slotErr != nil {
+					return nil, nil, slotErr
+				}
+				epoch = cs.SlotToEpoch(slot).Unwrap()
+			}
+			index := epoch % cs.EpochsPerHistoricalVector()
+			mix, err := st.GetRandaoMixAtIndex(index)
+			if err != nil {
+				return nil, nil, err
+			}
+			key, err := proofKey(beacondb.RandaoMixKey(index))
+			return mix, key, err
+		},
+	}
+}
+
+// queryIndex parses the uint64 query parameter with the given name, returning
+// false if it is not set.
+func queryIndex(params url.Values, name string) (uint64, bool, error) {
+	if !params.Has(name) {
+		return 0, false, nil
+	}
+	index, err := strconv.ParseUint(params.Get(name), 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return index, true, nil
+}
+
+// queryUint64s is a list of uint64 values served by the ABCI queries, which
+// is SSZ encoded as the concatenation of its little-endian values.
+type queryUint64s []uint64
+
+// MarshalSSZ marshals the list into SSZ format.
+func (q queryUint64s) MarshalSSZ() ([]byte, error) {
+	//nolint:mnd // a uint64 is 8 bytes.
+	buf := make([]byte, 0, len(q)*8)
+	for _, v := range q {
+		buf = binary.LittleEndian.AppendUint64(buf, v)
+	}
+	return buf, nil
+}
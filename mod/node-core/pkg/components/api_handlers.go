@@ -22,17 +22,24 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	builderapi "github.com/berachain/beacon-kit/mod/node-api/handlers/builder"
 	configapi "github.com/berachain/beacon-kit/mod/node-api/handlers/config"
 	debugapi "github.com/berachain/beacon-kit/mod/node-api/handlers/debug"
 	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
+	eventstypes "github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
 type NodeAPIHandlersInput[
+	BeaconBlockT NodeAPIBeaconBlock[BlindedBeaconBlockT],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
 		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
@@ -43,6 +50,9 @@ type NodeAPIHandlersInput[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
 		ExecutionPayloadHeaderT, *Fork, *Validator,
 	],
+	BlindedBeaconBlockT beacontypes.BlindedBeaconBlock,
+	BlobSidecarT beacontypes.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT beacontypes.BlobSidecars[BlobSidecarT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
 	NodeAPIContextT NodeAPIContext,
@@ -50,20 +60,28 @@ type NodeAPIHandlersInput[
 ] struct {
 	depinject.In
 	BeaconAPIHandler *beaconapi.Handler[
-		BeaconBlockHeaderT, NodeAPIContextT, *Fork, *Validator,
+		BeaconBlockT, BeaconBlockHeaderT, BlindedBeaconBlockT, BlobSidecarT,
+		BlobSidecarsT, NodeAPIContextT, *Fork, *Validator,
 	]
 	BuilderAPIHandler *builderapi.Handler[NodeAPIContextT]
 	ConfigAPIHandler  *configapi.Handler[NodeAPIContextT]
-	DebugAPIHandler   *debugapi.Handler[NodeAPIContextT]
-	EventsAPIHandler  *eventsapi.Handler[NodeAPIContextT]
-	NodeAPIHandler    *nodeapi.Handler[NodeAPIContextT]
-	ProofAPIHandler   *proofapi.Handler[
+	DebugAPIHandler   *debugapi.Handler[
+		BeaconStateT, BeaconStateMarshallableT, NodeAPIContextT,
+	]
+	EventsAPIHandler *eventsapi.Handler[
+		BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT,
+		NodeAPIContextT, *engineprimitives.PayloadAttributes[WithdrawalT],
+		WithdrawalT,
+	]
+	NodeAPIHandler  *nodeapi.Handler[NodeAPIContextT]
+	ProofAPIHandler *proofapi.Handler[
 		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
 		NodeAPIContextT, ExecutionPayloadHeaderT, *Validator,
 	]
 }
 
 func ProvideNodeAPIHandlers[
+	BeaconBlockT NodeAPIBeaconBlock[BlindedBeaconBlockT],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
 		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
@@ -74,15 +92,19 @@ func ProvideNodeAPIHandlers[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
 		ExecutionPayloadHeaderT, *Fork, *Validator,
 	],
+	BlindedBeaconBlockT beacontypes.BlindedBeaconBlock,
+	BlobSidecarT beacontypes.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT beacontypes.BlobSidecars[BlobSidecarT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
 	NodeAPIContextT NodeAPIContext,
 	WithdrawalT Withdrawal[WithdrawalT],
 ](
 	in NodeAPIHandlersInput[
-		BeaconBlockHeaderT, BeaconStateT,
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, KVStoreT,
-		NodeAPIContextT, WithdrawalT,
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT,
+		BeaconStateMarshallableT, BlindedBeaconBlockT, BlobSidecarT,
+		BlobSidecarsT, ExecutionPayloadHeaderT, KVStoreT, NodeAPIContextT,
+		WithdrawalT,
 	],
 ) []handlers.Handlers[NodeAPIContextT] {
 	return []handlers.Handlers[NodeAPIContextT]{
@@ -97,25 +119,40 @@ func ProvideNodeAPIHandlers[
 }
 
 func ProvideNodeAPIBeaconHandler[
+	BeaconBlockT beacontypes.BeaconBlock[BlindedBeaconBlockT],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT any,
+	BlindedBeaconBlockT beacontypes.BlindedBeaconBlock,
+	BlobSidecarT beacontypes.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT beacontypes.BlobSidecars[BlobSidecarT],
 	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-](b NodeAPIBackend[
-	BeaconBlockHeaderT,
-	BeaconStateT,
-	*Fork,
-	NodeT,
-	*Validator,
-]) *beaconapi.Handler[
-	BeaconBlockHeaderT, NodeAPIContextT, *Fork, *Validator,
+](
+	b NodeAPIBackend[
+		BeaconBlockT,
+		BeaconBlockHeaderT,
+		BeaconStateT,
+		BlobSidecarsT,
+		*Fork,
+		NodeT,
+		*Validator,
+	],
+	lightClient beaconapi.LightClientBackend,
+	exitPool beaconapi.VoluntaryExitPool,
+) *beaconapi.Handler[
+	BeaconBlockT, BeaconBlockHeaderT, BlindedBeaconBlockT, BlobSidecarT,
+	BlobSidecarsT, NodeAPIContextT, *Fork, *Validator,
 ] {
 	return beaconapi.NewHandler[
+		BeaconBlockT,
 		BeaconBlockHeaderT,
+		BlindedBeaconBlockT,
+		BlobSidecarT,
+		BlobSidecarsT,
 		NodeAPIContextT,
 		*Fork,
 		*Validator,
-	](b)
+	](b, lightClient, exitPool)
 }
 
 func ProvideNodeAPIBuilderHandler[
@@ -126,29 +163,129 @@ func ProvideNodeAPIBuilderHandler[
 
 func ProvideNodeAPIConfigHandler[
 	NodeAPIContextT NodeAPIContext,
-]() *configapi.Handler[NodeAPIContextT] {
-	return configapi.NewHandler[NodeAPIContextT]()
+](chainSpec common.ChainSpec) *configapi.Handler[NodeAPIContextT] {
+	return configapi.NewHandler[NodeAPIContextT](chainSpec)
 }
 
 func ProvideNodeAPIDebugHandler[
+	BeaconBlockT any,
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
+		*Validator, Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT BeaconStateMarshallable[
+		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
+		ExecutionPayloadHeaderT, *Fork, *Validator,
+	],
+	BlobSidecarsT any,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	KVStoreT any,
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *debugapi.Handler[NodeAPIContextT] {
-	return debugapi.NewHandler[NodeAPIContextT]()
+	WithdrawalT Withdrawal[WithdrawalT],
+](b NodeAPIBackend[
+	BeaconBlockT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *debugapi.Handler[
+	BeaconStateT, BeaconStateMarshallableT, NodeAPIContextT,
+] {
+	return debugapi.NewHandler[
+		BeaconStateT,
+		BeaconStateMarshallableT,
+		NodeAPIContextT,
+	](b)
+}
+
+// NodeAPIEventsHandlerInput is the input for the events API handler.
+type NodeAPIEventsHandlerInput struct {
+	depinject.In
+
+	ChainSpec  common.ChainSpec
+	Config     *config.Config
+	Dispatcher Dispatcher
 }
 
+// ProvideNodeAPIEventsHandler provides the events API handler, subscribing it
+// to the node events it streams if the node API is enabled.
 func ProvideNodeAPIEventsHandler[
+	BeaconBlockT eventstypes.BeaconBlock,
+	BeaconBlockHeaderT eventstypes.BeaconBlockHeader,
+	BlobSidecarT eventstypes.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT eventstypes.BlobSidecars[BlobSidecarT],
 	NodeAPIContextT NodeAPIContext,
-]() *eventsapi.Handler[NodeAPIContextT] {
-	return eventsapi.NewHandler[NodeAPIContextT]()
+	WithdrawalT eventstypes.Withdrawal,
+](in NodeAPIEventsHandlerInput) (*eventsapi.Handler[
+	BeaconBlockT, BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT,
+	NodeAPIContextT, *engineprimitives.PayloadAttributes[WithdrawalT],
+	WithdrawalT,
+], error) {
+	var (
+		payloadBuilds   chan async.Event[*engineprimitives.PayloadBuildData[*engineprimitives.PayloadAttributes[WithdrawalT]]] //nolint:lll // generic type.
+		finalizedBlocks chan async.Event[BeaconBlockT]
+		finalSidecars   chan async.Event[BlobSidecarsT]
+	)
+	// The events are only consumed while the API server is running, so only
+	// subscribe if it is enabled to avoid stalling the dispatcher.
+	if in.Config.NodeAPI.Enabled {
+		payloadBuilds = make(chan async.Event[*engineprimitives.PayloadBuildData[*engineprimitives.PayloadAttributes[WithdrawalT]]]) //nolint:lll // generic type.
+		finalizedBlocks = make(chan async.Event[BeaconBlockT])
+		finalSidecars = make(chan async.Event[BlobSidecarsT])
+		if err := in.Dispatcher.Subscribe(
+			async.PayloadAttributesSent, payloadBuilds,
+		); err != nil {
+			return nil, err
+		}
+		if err := in.Dispatcher.Subscribe(
+			async.BeaconBlockFinalized, finalizedBlocks,
+		); err != nil {
+			return nil, err
+		}
+		if err := in.Dispatcher.Subscribe(
+			async.FinalSidecarsReceived, finalSidecars,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return eventsapi.NewHandler[
+		BeaconBlockT,
+		BeaconBlockHeaderT,
+		BlobSidecarT,
+		BlobSidecarsT,
+		NodeAPIContextT,
+		*engineprimitives.PayloadAttributes[WithdrawalT],
+		WithdrawalT,
+	](in.ChainSpec, payloadBuilds, finalizedBlocks, finalSidecars), nil
 }
 
 func ProvideNodeAPINodeHandler[
+	BeaconBlockT any,
+	BeaconBlockHeaderT any,
+	BeaconStateT any,
+	BlobSidecarsT any,
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *nodeapi.Handler[NodeAPIContextT] {
-	return nodeapi.NewHandler[NodeAPIContextT]()
+](b NodeAPIBackend[
+	BeaconBlockT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *nodeapi.Handler[NodeAPIContextT] {
+	return nodeapi.NewHandler[NodeAPIContextT](b)
 }
 
 func ProvideNodeAPIProofHandler[
+	BeaconBlockT any,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
 		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
@@ -159,14 +296,17 @@ func ProvideNodeAPIProofHandler[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
 		ExecutionPayloadHeaderT, *Fork, *Validator,
 	],
+	BlobSidecarsT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
 	NodeT any,
 	NodeAPIContextT NodeAPIContext,
 	WithdrawalT Withdrawal[WithdrawalT],
 ](b NodeAPIBackend[
+	BeaconBlockT,
 	BeaconBlockHeaderT,
 	BeaconStateT,
+	BlobSidecarsT,
 	*Fork,
 	NodeT,
 	*Validator,
@@ -22,10 +22,16 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	storev2 "cosmossdk.io/store/v2/db"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 )
 
 // BlockStoreInput is the input for the dep inject framework.
@@ -39,8 +45,8 @@ type BlockStoreInput[
 ] struct {
 	depinject.In
 
-	Config *config.Config
-	Logger LoggerT
+	AppOpts config.AppOptions
+	Logger  LoggerT
 }
 
 // ProvideBlockStore is a function that provides the module to the
@@ -57,8 +63,62 @@ func ProvideBlockStore[
 		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT, LoggerT,
 	],
 ) (*block.KVStore[BeaconBlockT], error) {
+	name := "blocks"
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	return block.NewStore[BeaconBlockT](
+		storage.NewKVStoreProvider(kvp),
 		in.Logger.With("service", manager.BlockStoreName),
-		in.Config.BlockStoreService.AvailabilityWindow,
+	), nil
+}
+
+// BlockPrunerInput is the input for the block pruner.
+type BlockPrunerInput[
+	BlockStoreT any,
+	LoggerT any,
+] struct {
+	depinject.In
+
+	BlockStore BlockStoreT
+	Config     *config.Config
+	Dispatcher Dispatcher
+	Logger     LoggerT
+}
+
+// ProvideBlockPruner provides a block pruner for the depinject framework.
+func ProvideBlockPruner[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT any,
+	BlockStoreT BlockStore[BeaconBlockT],
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in BlockPrunerInput[BlockStoreT, LoggerT],
+) (pruner.Pruner[BlockStoreT], error) {
+	// initialize a subscription for finalized blocks.
+	subFinalizedBlocks := make(chan async.Event[BeaconBlockT])
+	if err := in.Dispatcher.Subscribe(
+		async.BeaconBlockFinalized, subFinalizedBlocks,
+	); err != nil {
+		in.Logger.Error("failed to subscribe to event", "event",
+			async.BeaconBlockFinalized, "err", err)
+		return nil, err
+	}
+
+	return pruner.NewPruner[BeaconBlockT, BlockStoreT](
+		in.Logger.With("service", manager.BlockPrunerName),
+		in.BlockStore,
+		manager.BlockPrunerName,
+		subFinalizedBlocks,
+		block.BuildPruneRangeFn[BeaconBlockT](
+			//#nosec:G701 // the availability window is always positive.
+			uint64(in.Config.BlockStoreService.AvailabilityWindow),
+		),
 	), nil
 }
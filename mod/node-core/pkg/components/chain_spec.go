@@ -23,8 +23,15 @@ package components
 import (
 	"os"
 
+	"cosmossdk.io/depinject"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/cast"
 )
 
 const (
@@ -34,22 +41,45 @@ const (
 	BoonetChainSpecType = "boonet"
 )
 
-// ProvideChainSpec provides the chain spec based on the environment variable.
-func ProvideChainSpec() common.ChainSpec {
-	// TODO: This is hood as fuck needs to be improved
-	// but for now we ball to get CI unblocked.
-	specType := os.Getenv(ChainSpecTypeEnvVar)
-	var chainSpec common.ChainSpec
-	switch specType {
+// ChainSpecInput is the input for the chain spec provider.
+type ChainSpecInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+}
+
+// ProvideChainSpec provides the chain spec from the file passed with the
+// `--chain-spec` flag, falling back to the preset selected by the
+// environment variable.
+func ProvideChainSpec(in ChainSpecInput) (common.ChainSpec, error) {
+	data, err := ChainSpecData(cast.ToString(in.AppOpts.Get(flags.ChainSpec)))
+	if err != nil {
+		return nil, err
+	}
+	return chain.NewChainSpec(data), nil
+}
+
+// ChainSpecData returns the chain spec data loaded from the spec file at the
+// given path. If the path is empty, the preset selected by the environment
+// variable is used, defaulting to the testnet.
+func ChainSpecData(path string) (chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+], error) {
+	if path != "" {
+		return spec.LoadSpecData(path)
+	}
+
+	switch os.Getenv(ChainSpecTypeEnvVar) {
 	case DevnetChainSpecType:
-		chainSpec = spec.DevnetChainSpec()
+		return spec.DevnetChainSpecData(), nil
 	case BetnetChainSpecType:
-		chainSpec = spec.BetnetChainSpec()
+		return spec.BetnetChainSpecData(), nil
 	case BoonetChainSpecType:
-		chainSpec = spec.BoonetChainSpec()
+		return spec.BoonetChainSpecData(), nil
 	default:
-		chainSpec = spec.TestnetChainSpec()
+		return spec.TestnetChainSpecData(), nil
 	}
-
-	return chainSpec
 }
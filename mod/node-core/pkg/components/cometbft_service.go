@@ -21,35 +21,61 @@
 package components
 
 import (
+	"cosmossdk.io/depinject"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/mod/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
+// CometBFTServiceInput is the input for the CometBFT service provider.
+type CometBFTServiceInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+	ABCIMiddleware cometbft.MiddlewareI
+	AppOpts        config.AppOptions
+	ChainSpec      common.ChainSpec
+	CmtCfg         *cmtcfg.Config
+	DB             dbm.DB
+	Logger         LoggerT
+	QueryHandlers  map[string]cometbft.QueryHandler
+	StoreKey       *storetypes.KVStoreKey
+	TelemetrySink  *metrics.TelemetrySink
+	// SnapshotRestoredHandler brings the stores kept outside of the
+	// multistore in line with a restored state sync snapshot.
+	SnapshotRestoredHandler cometbft.SnapshotRestoredHandler
+	// Options are applied after the default service options, e.g. by test
+	// harnesses that drive consensus themselves.
+	Options []func(*cometbft.Service[LoggerT]) `optional:"true"`
+}
+
 // ProvideCometBFTService provides the CometBFT service component.
 func ProvideCometBFTService[
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
-	logger LoggerT,
-	storeKey *storetypes.KVStoreKey,
-	abciMiddleware cometbft.MiddlewareI,
-	db dbm.DB,
-	cmtCfg *cmtcfg.Config,
-	appOpts config.AppOptions,
-	chainSpec common.ChainSpec,
+	in CometBFTServiceInput[LoggerT],
 ) *cometbft.Service[LoggerT] {
+	options := append(
+		builder.DefaultServiceOptions[LoggerT](in.AppOpts),
+		cometbft.SetQueryHandlers[LoggerT](in.QueryHandlers),
+		cometbft.SetSnapshotRestoredHandler[LoggerT](
+			in.SnapshotRestoredHandler,
+		),
+	)
 	return cometbft.NewService(
-		storeKey,
-		logger,
-		db,
-		abciMiddleware,
-		cmtCfg,
-		chainSpec,
-		builder.DefaultServiceOptions[LoggerT](appOpts)...,
+		in.StoreKey,
+		in.Logger,
+		in.DB,
+		in.ABCIMiddleware,
+		in.CmtCfg,
+		in.ChainSpec,
+		in.TelemetrySink,
+		append(options, in.Options...)...,
 	)
 }
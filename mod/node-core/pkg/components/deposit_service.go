@@ -21,7 +21,10 @@
 package components
 
 import (
+	"context"
+
 	"cosmossdk.io/depinject"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
@@ -104,3 +107,41 @@ func ProvideDepositService[
 		in.Dispatcher,
 	), nil
 }
+
+// SnapshotRestoredHandlerInput is the input for the snapshot restored
+// handler.
+type SnapshotRestoredHandlerInput[
+	DepositServiceT any,
+	StorageBackendT any,
+] struct {
+	depinject.In
+	DepositService DepositServiceT
+	StorageBackend StorageBackendT
+}
+
+// ProvideSnapshotRestoredHandler provides the handler that has the deposit
+// service backfill the deposits not yet processed by the state restored from
+// a state sync snapshot.
+func ProvideSnapshotRestoredHandler[
+	BeaconStateT interface {
+		GetEth1DepositIndex() (uint64, error)
+	},
+	DepositServiceT interface {
+		SetRestoredDepositIndex(index math.U64)
+	},
+	StorageBackendT interface {
+		StateFromContext(context.Context) BeaconStateT
+	},
+](
+	in SnapshotRestoredHandlerInput[DepositServiceT, StorageBackendT],
+) cometbft.SnapshotRestoredHandler {
+	return func(ctx context.Context) error {
+		index, err := in.StorageBackend.StateFromContext(ctx).
+			GetEth1DepositIndex()
+		if err != nil {
+			return err
+		}
+		in.DepositService.SetRestoredDepositIndex(math.U64(index))
+		return nil
+	}
+}
@@ -23,7 +23,9 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	dp "github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 )
 
@@ -32,7 +34,8 @@ type DispatcherInput[
 	LoggerT any,
 ] struct {
 	depinject.In
-	Logger LoggerT
+	Logger        LoggerT
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideDispatcher provides a new Dispatcher.
@@ -43,14 +46,17 @@ func ProvideDispatcher[
 	BlobSidecarsT any,
 	GenesisT any,
 	LoggerT log.AdvancedLogger[LoggerT],
+	WithdrawalT any,
 ](
 	in DispatcherInput[LoggerT],
 ) (Dispatcher, error) {
 	return dp.New(
 		in.Logger.With("service", "dispatcher"),
+		in.TelemetrySink,
 		dp.WithEvent[async.Event[GenesisT]](async.GenesisDataReceived),
 		dp.WithEvent[ValidatorUpdateEvent](async.GenesisDataProcessed),
 		dp.WithEvent[SlotEvent](async.NewSlot),
+		dp.WithEvent[async.Event[*engineprimitives.PayloadBuildData[*engineprimitives.PayloadAttributes[WithdrawalT]]]](async.PayloadAttributesSent), //nolint:lll // generic type.
 		dp.WithEvent[async.Event[BeaconBlockT]](async.BuiltBeaconBlock),
 		dp.WithEvent[async.Event[BlobSidecarsT]](async.BuiltSidecars),
 		dp.WithEvent[async.Event[ConsensusBlockT]](async.BeaconBlockReceived),
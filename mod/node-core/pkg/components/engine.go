@@ -45,7 +45,8 @@ type EngineClientInputs[LoggerT any] struct {
 	TelemetrySink *metrics.TelemetrySink
 }
 
-// ProvideEngineClient creates a new EngineClient.
+// ProvideEngineClient creates a new EngineClient, loading the JWT secrets of
+// the fallback execution clients dialed over HTTP.
 func ProvideEngineClient[
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
@@ -56,20 +57,39 @@ func ProvideEngineClient[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ](
 	in EngineClientInputs[LoggerT],
-) *client.EngineClient[
+) (*client.EngineClient[
 	ExecutionPayloadT,
 	*engineprimitives.PayloadAttributes[WithdrawalT],
-] {
+], error) {
+	cfg := in.Config.GetEngine()
+	fallbackJWTSecrets := make([]*jwt.Secret, len(cfg.RPCFallbackDialURLs))
+	for i, dialURL := range cfg.RPCFallbackDialURLs {
+		if dialURL.IsIPC() {
+			continue
+		}
+		path := cfg.JWTSecretPath
+		if i < len(cfg.FallbackJWTSecretPaths) &&
+			cfg.FallbackJWTSecretPaths[i] != "" {
+			path = cfg.FallbackJWTSecretPaths[i]
+		}
+		secret, err := LoadJWTFromFile(path)
+		if err != nil {
+			return nil, err
+		}
+		fallbackJWTSecrets[i] = secret
+	}
+
 	return client.New[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	](
-		in.Config.GetEngine(),
+		cfg,
 		in.Logger.With("service", "engine.client"),
 		in.JWTSecret,
+		fallbackJWTSecrets,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
-	)
+	), nil
 }
 
 // EngineClientInputs is the input for the EngineClient.
@@ -25,10 +25,12 @@ import (
 	"context"
 	"encoding/json"
 
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	eventstypes "github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
@@ -78,6 +80,9 @@ type (
 		// Persist makes sure that the sidecar remains accessible for data
 		// availability checks throughout the beacon node's operation.
 		Persist(math.Slot, BlobSidecarsT) error
+		// GetBlobSidecars returns all the blob sidecars stored for the given
+		// slot.
+		GetBlobSidecars(math.Slot) (BlobSidecarsT, error)
 	}
 
 	ConsensusBlock[BeaconBlockT any] interface {
@@ -150,10 +155,17 @@ type (
 		GetRandaoReveal() crypto.BLSSignature
 		// GetExecutionPayload returns the execution payload.
 		GetExecutionPayload() ExecutionPayloadT
+		// GetEth1Data returns the eth1 data voted for by the block.
+		GetEth1Data() Eth1DataT
 		// GetDeposits returns the list of deposits.
 		GetDeposits() []DepositT
 		// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 		GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+		// GetVoluntaryExits returns the list of voluntary exits.
+		GetVoluntaryExits() []*SignedVoluntaryExit
+		// GetExecutionRequests returns the execution requests of the beacon
+		// block body, which are nil before Electra.
+		GetExecutionRequests() *engineprimitives.ExecutionRequests
 		// SetRandaoReveal sets the Randao reveal of the beacon block body.
 		SetRandaoReveal(crypto.BLSSignature)
 		// SetEth1Data sets the Eth1 data of the beacon block body.
@@ -171,6 +183,11 @@ type (
 		// SetBlobKzgCommitments sets the blob KZG commitments of the beacon
 		// block body.
 		SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+		// SetVoluntaryExits sets the voluntary exits of the beacon block body.
+		SetVoluntaryExits([]*SignedVoluntaryExit)
+		// SetExecutionRequests sets the execution requests of the beacon
+		// block body.
+		SetExecutionRequests(*engineprimitives.ExecutionRequests)
 	}
 
 	// BeaconBlockHeader is the interface for a beacon block header.
@@ -206,6 +223,8 @@ type (
 	] interface {
 		constraints.SSZMarshallableRootable
 		GetTree() (*fastssz.Node, error)
+		// Version returns the fork version the state is encoded for.
+		Version() uint32
 		// New returns a new instance of the BeaconStateMarshallable.
 		New(
 			forkVersion uint32,
@@ -224,6 +243,7 @@ type (
 			nextWithdrawalIndex uint64,
 			nextWithdrawalValidatorIndex math.U64,
 			slashings []math.U64, totalSlashing math.U64,
+			depositRequestsStartIndex uint64,
 		) (T, error)
 	}
 
@@ -242,6 +262,7 @@ type (
 		// VerifySidecars verifies the blobs and ensures they match the local
 		// state.
 		VerifySidecars(
+			ctx context.Context,
 			sidecars ConsensusSidecarsT,
 		) error
 	}
@@ -316,7 +337,10 @@ type (
 
 	// BlockStore is the interface for block storage.
 	BlockStore[BeaconBlockT any] interface {
+		// Set persists the block and its indexes in the store.
 		Set(blk BeaconBlockT) error
+		// GetBlockBySlot retrieves the block at a given slot from the store.
+		GetBlockBySlot(slot math.Slot) (BeaconBlockT, error)
 		// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 		// GetSlotByStateRoot retrieves the slot by a given root from the store.
@@ -324,6 +348,8 @@ type (
 		// GetParentSlotByTimestamp retrieves the parent slot by a given
 		// timestamp from the store.
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+		// Prune prunes the block store of [start, end).
+		Prune(start, end uint64) error
 	}
 
 	ConsensusEngine interface {
@@ -386,6 +412,9 @@ type (
 		) T
 		// GetIndex returns the index of the deposit.
 		GetIndex() math.U64
+		// GetDepositDataRoot returns the root of the deposit as a leaf of
+		// the deposit contract's deposit tree.
+		GetDepositDataRoot() common.Root
 		// GetAmount returns the amount of the deposit.
 		GetAmount() math.Gwei
 		// GetPubkey returns the public key of the validator.
@@ -409,6 +438,9 @@ type (
 			startIndex uint64,
 			numView uint64,
 		) ([]DepositT, error)
+		// GetDepositRoot returns the root of the deposit tree when it held
+		// the given number of deposits.
+		GetDepositRoot(count uint64) (common.Root, error)
 		// Prune prunes the deposit store of [start, end)
 		Prune(start, end uint64) error
 		// EnqueueDeposits adds a list of deposits to the deposit store.
@@ -676,6 +708,10 @@ type (
 			st BeaconStateT,
 			blk BeaconBlockT,
 		) (transition.ValidatorUpdates, error)
+		// VerifyVoluntaryExit verifies a voluntary exit against the state.
+		VerifyVoluntaryExit(
+			st BeaconStateT, exit *SignedVoluntaryExit,
+		) error
 	}
 
 	SidecarFactory[BeaconBlockT any, BlobSidecarsT any] interface {
@@ -854,6 +890,8 @@ type (
 		) T
 		// Copy returns a copy of the key-value store.
 		Copy() T
+		// Upgrade upgrades the state to the fork of the given version.
+		Upgrade(forkVersion uint32) error
 		// GetLatestExecutionPayloadHeader retrieves the latest execution
 		// payload
 		// header.
@@ -871,6 +909,13 @@ type (
 		SetEth1DepositIndex(
 			index uint64,
 		) error
+		// GetDepositRequestsStartIndex retrieves the deposit requests start
+		// index.
+		GetDepositRequestsStartIndex() (uint64, error)
+		// SetDepositRequestsStartIndex sets the deposit requests start index.
+		SetDepositRequestsStartIndex(
+			index uint64,
+		) error
 		// GetBalance retrieves the balance of a validator.
 		GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 		// SetBalance sets the balance of a validator.
@@ -1015,6 +1060,7 @@ type (
 		SetNextWithdrawalIndex(uint64) error
 		SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
 		SetTotalSlashing(math.Gwei) error
+		Upgrade(forkVersion uint32) error
 	}
 
 	// WriteOnlyStateRoots defines a struct which only has write access to state
@@ -1069,6 +1115,7 @@ type (
 	WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 		SetEth1Data(Eth1DataT) error
 		SetEth1DepositIndex(uint64) error
+		SetDepositRequestsStartIndex(uint64) error
 		SetLatestExecutionPayloadHeader(
 			ExecutionPayloadHeaderT,
 		) error
@@ -1078,6 +1125,7 @@ type (
 	ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 		GetEth1Data() (Eth1DataT, error)
 		GetEth1DepositIndex() (uint64, error)
+		GetDepositRequestsStartIndex() (uint64, error)
 		GetLatestExecutionPayloadHeader() (
 			ExecutionPayloadHeaderT, error,
 		)
@@ -1103,12 +1151,22 @@ type (
 	// Engine is a generic interface for an API engine.
 	NodeAPIEngine[ContextT NodeAPIContext] interface {
 		Run(addr string) error
+		Shutdown(ctx context.Context) error
 		RegisterRoutes(*handlers.RouteSet[ContextT], log.Logger)
 	}
 
+	// NodeAPIBeaconBlock is the beacon block served and streamed by the node
+	// API.
+	NodeAPIBeaconBlock[BlindedBeaconBlockT any] interface {
+		types.BeaconBlock[BlindedBeaconBlockT]
+		eventstypes.BeaconBlock
+	}
+
 	NodeAPIBackend[
+		BeaconBlockT any,
 		BeaconBlockHeaderT any,
 		BeaconStateT any,
+		BlobSidecarsT any,
 		ForkT any,
 		NodeT any,
 		ValidatorT any,
@@ -1120,19 +1178,28 @@ type (
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
 
 		NodeAPIBeaconBackend[
-			BeaconStateT, BeaconBlockHeaderT, ForkT, ValidatorT,
+			BeaconBlockT, BeaconStateT, BeaconBlockHeaderT, BlobSidecarsT,
+			ForkT, ValidatorT,
 		]
 		NodeAPIProofBackend[
 			BeaconBlockHeaderT, BeaconStateT, ForkT, ValidatorT,
 		]
+		NodeAPIDebugBackend[BeaconStateT]
+		NodeAPINodeBackend
 	}
 
 	// NodeAPIBackend is the interface for backend of the beacon API.
 	NodeAPIBeaconBackend[
-		BeaconStateT, BeaconBlockHeaderT, ForkT, ValidatorT any,
+		BeaconBlockT, BeaconStateT, BeaconBlockHeaderT, BlobSidecarsT, ForkT,
+		ValidatorT any,
 	] interface {
 		GenesisBackend
 		BlockBackend[BeaconBlockHeaderT]
+		// BlockAtSlot retrieves the beacon block at the given slot.
+		BlockAtSlot(slot math.Slot) (BeaconBlockT, error)
+		// BlobSidecarsAtSlot retrieves the blob sidecars stored at the given
+		// slot.
+		BlobSidecarsAtSlot(slot math.Slot) (BlobSidecarsT, error)
 		RandaoBackend
 		StateBackend[BeaconStateT, ForkT]
 		ValidatorBackend[ValidatorT]
@@ -1152,6 +1219,22 @@ type (
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
 	}
 
+	// NodeAPIDebugBackend is the interface for backend of the debug API.
+	NodeAPIDebugBackend[BeaconStateT any] interface {
+		// StateAtSlot returns the beacon state committed at the given slot.
+		StateAtSlot(slot math.Slot) (BeaconStateT, math.Slot, error)
+	}
+
+	// NodeAPINodeBackend is the interface for backend of the node API.
+	NodeAPINodeBackend interface {
+		HeadSlot() (math.Slot, error)
+		SyncDistance() math.Slot
+		IsSyncing() bool
+		IsOptimistic() bool
+		IsELOffline() bool
+		ServicesHealth() map[string]error
+	}
+
 	GenesisBackend interface {
 		GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
 	}
@@ -1169,6 +1252,7 @@ type (
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
 		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+		CommitAtSlot(slot math.Slot) (*ctypes.LightClientCommit, error)
 	}
 
 	StateBackend[BeaconStateT, ForkT any] interface {
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
 	"github.com/spf13/afero"
 	"github.com/spf13/cast"
 )
@@ -38,8 +39,18 @@ type JWTSecretInput struct {
 }
 
 // ProvideJWTSecret is a function that provides the module to the application.
+// Execution clients dialed over IPC are not authenticated, so no secret is
+// provided for them.
 func ProvideJWTSecret(in JWTSecretInput) (*jwt.Secret, error) {
-	return LoadJWTFromFile(cast.ToString(in.AppOpts.Get(flags.JWTSecretPath)))
+	dialURL, err := url.NewFromRaw(
+		cast.ToString(in.AppOpts.Get(flags.RPCDialURL)),
+	)
+	if err == nil && dialURL.IsIPC() {
+		return nil, nil //nolint:nilnil // no secret is needed over IPC.
+	}
+	return LoadJWTFromFile(
+		cast.ToString(in.AppOpts.Get(flags.JWTSecretPath)),
+	)
 }
 
 // LoadJWTFromFile reads the JWT secret from a file and returns it.
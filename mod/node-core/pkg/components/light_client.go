@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	lightclient "github.com/berachain/beacon-kit/mod/node-api/light_client"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	lightclientstore "github.com/berachain/beacon-kit/mod/storage/pkg/lightclient"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// LightClientStoreInput is the input for the dep inject framework.
+type LightClientStoreInput[LoggerT log.AdvancedLogger[LoggerT]] struct {
+	depinject.In
+
+	AppOpts config.AppOptions
+	Logger  LoggerT
+}
+
+// ProvideLightClientStore is a function that provides the light client update
+// store to the application.
+func ProvideLightClientStore[LoggerT log.AdvancedLogger[LoggerT]](
+	in LightClientStoreInput[LoggerT],
+) (*LightClientStore, error) {
+	name := "light_client"
+	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return lightclientstore.NewStore[*LightClientUpdate](
+		storage.NewKVStoreProvider(kvp),
+		in.Logger.With("service", manager.LightClientStoreName),
+	), nil
+}
+
+// LightClientServiceInput is the input for the light client service.
+type LightClientServiceInput[
+	BeaconBlockT any,
+	BeaconBlockHeaderT any,
+	BeaconStateT any,
+	BlobSidecarsT any,
+	LoggerT any,
+	NodeT any,
+] struct {
+	depinject.In
+
+	Backend NodeAPIBackend[
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT,
+		*Fork, NodeT, *Validator,
+	]
+	Config     *config.Config
+	Dispatcher Dispatcher
+	Logger     LoggerT
+	Store      *LightClientStore
+}
+
+// ProvideLightClientService provides the light client service.
+func ProvideLightClientService[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
+		*Validator, Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT BeaconStateMarshallable[
+		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
+		ExecutionPayloadHeaderT, *Fork, *Validator,
+	],
+	BlobSidecarsT any,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	KVStoreT any,
+	LoggerT log.AdvancedLogger[LoggerT],
+	NodeT any,
+	WithdrawalT Withdrawal[WithdrawalT],
+](
+	in LightClientServiceInput[
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT,
+		LoggerT, NodeT,
+	],
+) *lightclient.Service[
+	BeaconBlockT, BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
+	*LightClientStore,
+] {
+	return lightclient.NewService[
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT,
+		BeaconStateMarshallableT, *LightClientStore,
+	](
+		in.Config.LightClientService,
+		in.Logger.With("service", "light-client"),
+		in.Dispatcher,
+		in.Backend,
+		in.Store,
+	)
+}
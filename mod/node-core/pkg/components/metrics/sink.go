@@ -21,8 +21,10 @@
 package metrics
 
 import (
+	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/observability/pkg/tracing"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/hashicorp/go-metrics"
 )
@@ -65,6 +67,17 @@ func (TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
 	)
 }
 
+// StartSpan starts a span with the given name and key-value attributes,
+// returning the context carrying the span and a function ending it with the
+// error of the traced operation, if any.
+func (TelemetrySink) StartSpan(
+	ctx context.Context,
+	name string,
+	args ...any,
+) (context.Context, func(error)) {
+	return tracing.StartSpan(ctx, name, args...)
+}
+
 // argsToLabels converts a list of key-value pairs to a list of metrics labels.
 //
 //nolint:mnd // its okay.
@@ -29,6 +29,7 @@ import (
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -49,6 +50,7 @@ type LocalBuilderInput[
 	]
 	Cfg             *config.Config
 	ChainSpec       common.ChainSpec
+	Dispatcher      Dispatcher
 	ExecutionEngine *engine.Engine[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
@@ -56,6 +58,7 @@ type LocalBuilderInput[
 		WithdrawalsT,
 	]
 	Logger LoggerT
+	Signer crypto.BLSSigner
 }
 
 // ProvideLocalBuilder provides a local payload builder for the
@@ -98,5 +101,7 @@ func ProvideLocalBuilder[
 			[32]byte, math.Slot,
 		](),
 		in.AttributesFactory,
+		in.Signer,
+		in.Dispatcher,
 	)
 }
@@ -21,8 +21,11 @@
 package components
 
 import (
+	"time"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/pool"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
@@ -32,12 +35,17 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
+	lightclient "github.com/berachain/beacon-kit/mod/node-api/light_client"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
 	"github.com/berachain/beacon-kit/mod/observability/pkg/telemetry"
+	"github.com/berachain/beacon-kit/mod/observability/pkg/tracing"
 )
 
+// cometBFTStopTimeout is the time CometBFT is given to stop.
+const cometBFTStopTimeout = 30 * time.Second
+
 // ServiceRegistryInput is the input for the service registry provider.
 type ServiceRegistryInput[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
@@ -54,7 +62,10 @@ type ServiceRegistryInput[
 		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
 		*Validator, Validators, WithdrawalT,
 	],
-	BeaconStateMarshallableT any,
+	BeaconStateMarshallableT BeaconStateMarshallable[
+		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
+		ExecutionPayloadHeaderT, *Fork, *Validator,
+	],
 	ConsensusSidecarsT ConsensusSidecars[BlobSidecarsT, BeaconBlockHeaderT],
 	BlobSidecarT any,
 	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
@@ -99,16 +110,23 @@ type ServiceRegistryInput[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
+	LightClientService *lightclient.Service[
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT,
+		BeaconStateMarshallableT, *LightClientStore,
+	]
 	Logger           LoggerT
 	NodeAPIServer    *server.Server[NodeAPIContextT]
 	ReportingService *ReportingService
 	TelemetrySink    *metrics.TelemetrySink
 	TelemetryService *telemetry.Service
+	TracingService   *tracing.Service
 	ValidatorService *validator.Service[
-		*AttestationData, BeaconBlockT, BeaconBlockBodyT,
-		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT,
-		*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
-		*ForkData, *SlashingInfo, *SlotData,
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, BlobSidecarsT, DepositT,
+		DepositStoreT, *Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		*ForkData, *SlotData, *SignedVoluntaryExit,
+	]
+	VoluntaryExitPool *pool.VoluntaryExitPool[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, *SignedVoluntaryExit,
 	]
 	CometBFTService *cometbft.Service[LoggerT]
 }
@@ -129,7 +147,10 @@ func ProvideServiceRegistry[
 		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
 		*Validator, Validators, WithdrawalT,
 	],
-	BeaconStateMarshallableT any,
+	BeaconStateMarshallableT BeaconStateMarshallable[
+		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
+		ExecutionPayloadHeaderT, *Fork, *Validator,
+	],
 	ConsensusSidecarsT ConsensusSidecars[BlobSidecarsT, BeaconBlockHeaderT],
 	BlobSidecarT any,
 	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
@@ -157,18 +178,37 @@ func ProvideServiceRegistry[
 ) *service.Registry {
 	return service.NewRegistry(
 		service.WithLogger(in.Logger),
-		service.WithService(in.ABCIService),
+		// The tracing service is started first and so stopped last, once
+		// the spans of every other service have been flushed.
+		service.WithService(in.TracingService),
+		service.WithService(in.ABCIService, in.Dispatcher),
 		service.WithService(in.Dispatcher),
-		service.WithService(in.ValidatorService),
-		service.WithService(in.BlockStoreService),
-		service.WithService(in.ChainService),
-		service.WithService(in.DAService),
-		service.WithService(in.DepositService),
-		service.WithService(in.NodeAPIServer),
+		service.WithService(
+			in.ValidatorService, in.Dispatcher, in.EngineClient,
+		),
+		service.WithService(in.VoluntaryExitPool, in.Dispatcher),
+		service.WithService(in.BlockStoreService, in.Dispatcher),
+		service.WithService(in.LightClientService, in.Dispatcher),
+		service.WithService(in.ChainService, in.Dispatcher, in.EngineClient),
+		service.WithService(in.DAService, in.Dispatcher),
+		service.WithService(
+			in.DepositService, in.Dispatcher, in.EngineClient,
+		),
+		service.WithService(
+			in.NodeAPIServer, in.BlockStoreService, in.LightClientService,
+			in.EngineClient,
+		),
 		service.WithService(in.ReportingService),
-		service.WithService(in.DBManager),
+		service.WithService(in.DBManager, in.Dispatcher),
 		service.WithService(in.EngineClient),
 		service.WithService(in.TelemetryService),
-		service.WithService(in.CometBFTService),
+		// CometBFT drives the other services, so it is started after and
+		// stopped before all of them.
+		service.WithService(
+			in.CometBFTService, in.ABCIService, in.ChainService,
+			in.ValidatorService, in.DAService, in.DepositService,
+		),
+		// CometBFT flushes the block being committed and its stores.
+		service.WithStopTimeout(in.CometBFTService, cometBFTStopTimeout),
 	)
 }
@@ -25,6 +25,7 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
@@ -34,6 +35,7 @@ import (
 // framework.
 type StateProcessorInput[
 	LoggerT log.AdvancedLogger[LoggerT],
+	DepositStoreT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -44,13 +46,15 @@ type StateProcessorInput[
 	depinject.In
 	Logger          LoggerT
 	ChainSpec       common.ChainSpec
+	DepositStore    DepositStoreT
 	ExecutionEngine *engine.Engine[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 		PayloadID,
 		WithdrawalsT,
 	]
-	Signer crypto.BLSSigner
+	Signer        crypto.BLSSigner
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideStateProcessor provides the state processor to the depinject
@@ -70,6 +74,7 @@ func ProvideStateProcessor[
 	],
 	BeaconStateMarshallableT any,
 	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
+	DepositStoreT DepositStore[DepositT],
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -82,7 +87,7 @@ func ProvideStateProcessor[
 	WithdrawalT Withdrawal[WithdrawalT],
 ](
 	in StateProcessorInput[
-		LoggerT,
+		LoggerT, DepositStoreT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT,
 		WithdrawalT, WithdrawalsT,
 	],
@@ -114,7 +119,9 @@ func ProvideStateProcessor[
 		in.Logger.With("service", "state-processor"),
 		in.ChainSpec,
 		in.ExecutionEngine,
+		in.DepositStore,
 		in.Signer,
 		crypto.GetAddressFromPubKey,
+		in.TelemetrySink,
 	)
 }
@@ -23,35 +23,44 @@ package components
 import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/config"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/statestore"
 )
 
 // KVStoreInput is the input for the ProvideKVStore function.
 type KVStoreInput struct {
 	depinject.In
+	Config         *config.Config
 	KVStoreService store.KVStoreService
 }
 
-// ProvideKVStore is the depinject provider that returns a beacon KV store.
+// ProvideKVStore is the depinject provider that returns a beacon KV store,
+// backed by the state store backend selected in the configuration.
 func ProvideKVStore[
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
-](in KVStoreInput) *beacondb.KVStore[
+](in KVStoreInput) (*statestore.KVStore[
 	BeaconBlockHeaderT,
 	*Eth1Data,
 	ExecutionPayloadHeaderT,
 	*Fork,
 	*Validator,
 	Validators,
-] {
+], error) {
 	payloadCodec := &encoding.SSZInterfaceCodec[ExecutionPayloadHeaderT]{}
-	return beacondb.New[
+	return statestore.New[
 		BeaconBlockHeaderT,
 		*Eth1Data,
 		ExecutionPayloadHeaderT,
 		*Fork,
 		*Validator,
 		Validators,
-	](in.KVStoreService, payloadCodec)
+	](
+		in.Config.StateStore,
+		in.KVStoreService,
+		payloadCodec,
+		ctypes.BeaconStateSchema,
+	)
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	lightclientstore "github.com/berachain/beacon-kit/mod/storage/pkg/lightclient"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 )
 
@@ -41,6 +42,9 @@ type (
 	// DBManager is a type alias for the database manager.
 	DBManager = manager.DBManager
 
+	// LightClientStore is a type alias for the light client update store.
+	LightClientStore = lightclientstore.KVStore[*LightClientUpdate]
+
 	// ReportingService is a type alias for the reporting service.
 	ReportingService = version.ReportingService
 )
@@ -71,6 +75,9 @@ type (
 		*SlashingInfo,
 	]
 
+	// LightClientUpdate is a type alias for the light client update.
+	LightClientUpdate = types.LightClientUpdate
+
 	// LegacyKey type alias to LegacyKey used for LegacySinger construction.
 	LegacyKey = signer.LegacyKey
 
@@ -80,6 +87,9 @@ type (
 	// PayloadID is a type alias for the payload ID.
 	PayloadID = engineprimitives.PayloadID
 
+	// SignedVoluntaryExit is a type alias for the signed voluntary exit.
+	SignedVoluntaryExit = types.SignedVoluntaryExit
+
 	// SlashingInfo is a type alias for the slashing info.
 	SlashingInfo = types.SlashingInfo
 
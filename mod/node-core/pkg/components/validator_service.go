@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/pool"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -33,10 +34,12 @@ import (
 // ValidatorServiceInput is the input for the validator service provider.
 type ValidatorServiceInput[
 	AvailabilityStoreT any,
-	BeaconBlockT any,
+	BeaconBlockT pool.BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT pool.BeaconBlockBody[*SignedVoluntaryExit],
 	BeaconStateT any,
 	BlobSidecarsT any,
 	DepositT any,
+	DepositContractT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -47,18 +50,22 @@ type ValidatorServiceInput[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ] struct {
 	depinject.In
-	Cfg            *config.Config
-	ChainSpec      common.ChainSpec
-	Dispatcher     Dispatcher
-	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
-	Logger         LoggerT
-	StateProcessor StateProcessor[
+	Cfg             *config.Config
+	ChainSpec       common.ChainSpec
+	DepositContract DepositContractT
+	Dispatcher      Dispatcher
+	LocalBuilder    LocalBuilder[BeaconStateT, ExecutionPayloadT]
+	Logger          LoggerT
+	StateProcessor  StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context, DepositT, ExecutionPayloadHeaderT,
 	]
-	StorageBackend StorageBackendT
-	Signer         crypto.BLSSigner
-	SidecarFactory SidecarFactory[BeaconBlockT, BlobSidecarsT]
-	TelemetrySink  *metrics.TelemetrySink
+	StorageBackend    StorageBackendT
+	Signer            crypto.BLSSigner
+	SidecarFactory    SidecarFactory[BeaconBlockT, BlobSidecarsT]
+	TelemetrySink     *metrics.TelemetrySink
+	VoluntaryExitPool *pool.VoluntaryExitPool[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, *SignedVoluntaryExit,
+	]
 }
 
 // ProvideValidatorService is a depinject provider for the validator service.
@@ -81,6 +88,7 @@ func ProvideValidatorService[
 	BeaconBlockStoreT any,
 	BlobSidecarsT any,
 	DepositT any,
+	DepositContractT validator.DepositContract,
 	DepositStoreT DepositStore[DepositT],
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
@@ -95,19 +103,18 @@ func ProvideValidatorService[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ](
 	in ValidatorServiceInput[
-		AvailabilityStoreT, BeaconBlockT, BeaconStateT,
-		BlobSidecarsT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-		LoggerT, StorageBackendT, WithdrawalT, WithdrawalsT,
+		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+		BlobSidecarsT, DepositT, DepositContractT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT, LoggerT, StorageBackendT, WithdrawalT,
+		WithdrawalsT,
 	],
 ) (*validator.Service[
-	*AttestationData, BeaconBlockT, BeaconBlockBodyT,
-	BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT,
-	*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	*ForkData, *SlashingInfo, *SlotData,
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, BlobSidecarsT, DepositT,
+	DepositStoreT, *Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	*ForkData, *SlotData, *SignedVoluntaryExit,
 ], error) {
 	// Build the builder service.
 	return validator.NewService[
-		*AttestationData,
 		BeaconBlockT,
 		BeaconBlockBodyT,
 		BeaconStateT,
@@ -118,13 +125,14 @@ func ProvideValidatorService[
 		ExecutionPayloadT,
 		ExecutionPayloadHeaderT,
 		*ForkData,
-		*SlashingInfo,
 		*SlotData,
+		*SignedVoluntaryExit,
 	](
 		&in.Cfg.Validator,
 		in.Logger.With("service", "validator"),
 		in.ChainSpec,
 		in.StorageBackend,
+		in.DepositContract,
 		in.StateProcessor,
 		in.Signer,
 		in.SidecarFactory,
@@ -132,6 +140,7 @@ func ProvideValidatorService[
 		[]validator.PayloadBuilder[BeaconStateT, ExecutionPayloadT]{
 			in.LocalBuilder,
 		},
+		in.VoluntaryExitPool,
 		in.TelemetrySink,
 		in.Dispatcher,
 	), nil
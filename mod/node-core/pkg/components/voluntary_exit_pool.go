@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/pool"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// VoluntaryExitPoolInput is the input for the voluntary exit pool provider.
+type VoluntaryExitPoolInput[
+	BeaconBlockT any,
+	BeaconStateT any,
+	DepositT any,
+	ExecutionPayloadHeaderT any,
+	LoggerT any,
+	StorageBackendT any,
+] struct {
+	depinject.In
+	Dispatcher     Dispatcher
+	Logger         LoggerT
+	StateProcessor StateProcessor[
+		BeaconBlockT, BeaconStateT, *Context, DepositT, ExecutionPayloadHeaderT,
+	]
+	StorageBackend StorageBackendT
+}
+
+// ProvideVoluntaryExitPool is a depinject provider for the pool of pending
+// voluntary exits.
+func ProvideVoluntaryExitPool[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT BeaconBlockBody[
+		BeaconBlockBodyT, *AttestationData, DepositT,
+		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+	],
+	BeaconBlockHeaderT any,
+	BeaconStateT any,
+	DepositT any,
+	ExecutionPayloadT any,
+	ExecutionPayloadHeaderT any,
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT pool.StorageBackend[BeaconStateT],
+](
+	in VoluntaryExitPoolInput[
+		BeaconBlockT, BeaconStateT, DepositT, ExecutionPayloadHeaderT,
+		LoggerT, StorageBackendT,
+	],
+) *pool.VoluntaryExitPool[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT, *SignedVoluntaryExit,
+] {
+	return pool.NewVoluntaryExitPool[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, *SignedVoluntaryExit,
+	](
+		in.Logger.With("service", "voluntary-exit-pool"),
+		in.Dispatcher,
+		in.StorageBackend,
+		in.StateProcessor,
+	)
+}
@@ -26,6 +26,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
@@ -64,19 +65,33 @@ func (n *node) Start(
 	g, gctx := errgroup.WithContext(cctx)
 
 	// listen for quit signals so the calling parent process can gracefully exit
-	n.listenForQuitSignals(g, true, cancelFn)
-
-	// Start all the registered services.
-	if err := n.registry.StartAll(gctx); err != nil {
-		return err
+	n.listenForQuitSignals(gctx, g, true, cancelFn)
+
+	// The services run until they are stopped rather than until the node
+	// exits, so that they can flush their in-flight work first.
+	sctx, cancelServices := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelServices()
+
+	// Start all the registered services, aborting if the node exits while
+	// they are starting.
+	stopAbort := context.AfterFunc(cctx, cancelServices)
+	err := n.registry.StartAll(sctx)
+	stopAbort()
+	if err == nil {
+		// Wait for those aforementioned exit signals.
+		err = g.Wait()
+	} else {
+		cancelFn()
 	}
 
-	// Wait for those aforementioned exit signals.
-	return g.Wait()
+	// Stop the services that were started, each within its stop timeout.
+	return errors.Join(
+		err, n.registry.StopAll(context.WithoutCancel(ctx)),
+	)
 }
 
-// listenForQuitSignals listens for SIGINT and SIGTERM. When a signal is
-// received,
+// listenForQuitSignals listens for SIGINT and SIGTERM until the context is
+// done. When a signal is received,
 // the cleanup function is called, indicating the caller can gracefully exit or
 // return.
 //
@@ -84,6 +99,7 @@ func (n *node) Start(
 // The caller must ensure the corresponding context derived from the cancelFn is
 // used correctly.
 func (n *node) listenForQuitSignals(
+	ctx context.Context,
 	g *errgroup.Group,
 	block bool,
 	cancelFn context.CancelFunc,
@@ -92,10 +108,13 @@ func (n *node) listenForQuitSignals(
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 
 	f := func() {
-		sig := <-sigCh
-		cancelFn()
-
-		n.logger.Info("caught exit signal", "signal", sig.String())
+		defer signal.Stop(sigCh)
+		select {
+		case sig := <-sigCh:
+			cancelFn()
+			n.logger.Info("caught exit signal", "signal", sig.String())
+		case <-ctx.Done():
+		}
 	}
 
 	if block {
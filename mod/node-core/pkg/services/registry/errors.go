@@ -44,4 +44,20 @@ var (
 		errors.New("unknown service"),
 		"%T",
 	)
+
+	// ErrUnknownDependency is returned when a service depends on a service
+	// that is not registered.
+	ErrUnknownDependency = errors.New("unknown service dependency")
+
+	// ErrDependencyCycle is returned when the dependencies of the services
+	// form a cycle.
+	ErrDependencyCycle = errors.New("service dependency cycle")
+
+	// ErrServiceNotStarted is reported as the health of a service that has
+	// not been started.
+	ErrServiceNotStarted = errors.New("service not started")
+
+	// ErrServiceStopped is reported as the health of a service that has been
+	// stopped.
+	ErrServiceStopped = errors.New("service stopped")
 )
@@ -21,6 +21,8 @@
 package service
 
 import (
+	"time"
+
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
@@ -35,9 +37,19 @@ func WithLogger(logger log.Logger) RegistryOption {
 	}
 }
 
-// WithService is an Option that registers a service with the Registry.
-func WithService(svc Basic) RegistryOption {
+// WithService is an Option that registers a service with the Registry,
+// along with the services it depends on.
+func WithService(svc Basic, dependencies ...Basic) RegistryOption {
+	return func(r *Registry) error {
+		return r.RegisterService(svc, dependencies...)
+	}
+}
+
+// WithStopTimeout is an Option that sets the time the given service is given
+// to stop, overriding DefaultStopTimeout.
+func WithStopTimeout(svc Basic, timeout time.Duration) RegistryOption {
 	return func(r *Registry) error {
-		return r.RegisterService(svc)
+		r.stopTimeouts[svc.Name()] = timeout
+		return nil
 	}
 }
@@ -23,7 +23,11 @@ package service
 import (
 	"context"
 	"reflect"
+	"slices"
+	"sync"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
@@ -35,6 +39,24 @@ type Basic interface {
 	Name() string
 }
 
+// Stoppable is implemented by the services that must flush their in-flight
+// work before the node exits.
+type Stoppable interface {
+	// Stop stops the service, returning once its in-flight work is flushed
+	// or the context is done.
+	Stop(ctx context.Context) error
+}
+
+// HealthChecker is implemented by the services that report their health.
+type HealthChecker interface {
+	// Health returns a non-nil error if the service is unhealthy.
+	Health() error
+}
+
+// DefaultStopTimeout is the time a service is given to stop, unless
+// configured otherwise with WithStopTimeout.
+const DefaultStopTimeout = 10 * time.Second
+
 type Dispatcher interface {
 	Start(ctx context.Context) error
 }
@@ -49,13 +71,29 @@ type Registry struct {
 	services map[string]Basic
 	// serviceTypes is an ordered slice of registered service types.
 	serviceTypes []string
+	// dependencies is a map of service type -> the service types it depends
+	// on.
+	dependencies map[string][]string
+	// stopTimeouts is a map of service type -> the time the service is given
+	// to stop, for the services not using DefaultStopTimeout.
+	stopTimeouts map[string]time.Duration
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// started is the ordered slice of started service types.
+	started []string
+	// stopped is the set of stopped service types.
+	stopped map[string]struct{}
 }
 
 // NewRegistry starts a registry instance for convenience.
 func NewRegistry(
 	opts ...RegistryOption) *Registry {
 	r := &Registry{
-		services: make(map[string]Basic),
+		services:     make(map[string]Basic),
+		dependencies: make(map[string][]string),
+		stopTimeouts: make(map[string]time.Duration),
+		stopped:      make(map[string]struct{}),
 	}
 
 	for _, opt := range opts {
@@ -66,34 +104,119 @@ func NewRegistry(
 	return r
 }
 
-// StartAll initialized each service in order of registration.
+// StartAll initialized each service after the services it depends on, in
+// order of registration otherwise.
 func (s *Registry) StartAll(ctx context.Context) error {
+	order, err := s.startOrder()
+	if err != nil {
+		return err
+	}
+
 	// start all services
-	s.logger.Info("Starting services", "num", len(s.serviceTypes))
-	for _, typeName := range s.serviceTypes {
+	s.logger.Info("Starting services", "num", len(order))
+	for _, typeName := range order {
 		s.logger.Info("Starting service", "type", typeName)
-		svc := s.services[typeName]
-		if svc == nil {
-			s.logger.Error("service not found", "type", typeName)
+		if err = s.services[typeName].Start(ctx); err != nil {
+			return errors.Wrapf(err, "failed to start %s", typeName)
+		}
+
+		s.mu.Lock()
+		s.started = append(s.started, typeName)
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// StopAll stops the started services in the reverse order they were started
+// in, so that no service is stopped before the services depending on it.
+// Each service is given its own stop timeout to flush its in-flight work, so
+// that a slow service does not cut the shutdown of the next ones short.
+func (s *Registry) StopAll(ctx context.Context) error {
+	s.mu.RLock()
+	started := slices.Clone(s.started)
+	s.mu.RUnlock()
+
+	s.logger.Info("Stopping services", "num", len(started))
+	var errs []error
+	for _, typeName := range slices.Backward(started) {
+		s.mu.Lock()
+		_, stopped := s.stopped[typeName]
+		s.stopped[typeName] = struct{}{}
+		s.mu.Unlock()
+		if stopped {
 			continue
 		}
 
-		if err := svc.Start(ctx); err != nil {
-			return err
+		svc, ok := s.services[typeName].(Stoppable)
+		if !ok {
+			continue
+		}
+		s.logger.Info("Stopping service", "type", typeName)
+		if err := s.stop(ctx, typeName, svc); err != nil {
+			s.logger.Error(
+				"Failed to stop service", "type", typeName, "err", err,
+			)
+			errs = append(errs, errors.Wrapf(
+				err, "failed to stop %s", typeName,
+			))
 		}
 	}
-	return nil
+	return errors.Join(errs...)
+}
+
+// stop stops the service within its stop timeout.
+func (s *Registry) stop(
+	ctx context.Context, typeName string, svc Stoppable,
+) error {
+	timeout, ok := s.stopTimeouts[typeName]
+	if !ok {
+		timeout = DefaultStopTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return svc.Stop(ctx)
+}
+
+// Health returns the health of every registered service by type, nil for the
+// healthy services.
+func (s *Registry) Health() map[string]error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	health := make(map[string]error, len(s.serviceTypes))
+	for _, typeName := range s.serviceTypes {
+		switch {
+		case !slices.Contains(s.started, typeName):
+			health[typeName] = ErrServiceNotStarted
+		case s.isStopped(typeName):
+			health[typeName] = ErrServiceStopped
+		default:
+			if svc, ok := s.services[typeName].(HealthChecker); ok {
+				health[typeName] = svc.Health()
+			} else {
+				health[typeName] = nil
+			}
+		}
+	}
+	return health
 }
 
 // RegisterService appends a service constructor function to the service
-// registry.
-func (s *Registry) RegisterService(service Basic) error {
+// registry, along with the services it depends on.
+func (s *Registry) RegisterService(
+	service Basic, dependencies ...Basic,
+) error {
 	typeName := service.Name()
 	if _, exists := s.services[typeName]; exists {
 		return errServiceAlreadyExists
 	}
 	s.services[typeName] = service
 	s.serviceTypes = append(s.serviceTypes, typeName)
+	for _, dependency := range dependencies {
+		s.dependencies[typeName] = append(
+			s.dependencies[typeName], dependency.Name(),
+		)
+	}
 	return nil
 }
 
@@ -129,3 +252,52 @@ func (s *Registry) FetchService(service interface{}) error {
 	}
 	return errUnknownService
 }
+
+// startOrder sorts the service types topologically, so that every service
+// comes after the services it depends on. Services that do not depend on
+// each other keep their order of registration.
+func (s *Registry) startOrder() ([]string, error) {
+	// inDegree is the number of unsorted dependencies of each service.
+	inDegree := make(map[string]int, len(s.serviceTypes))
+	for _, typeName := range s.serviceTypes {
+		for _, dependency := range s.dependencies[typeName] {
+			if _, ok := s.services[dependency]; !ok {
+				return nil, errors.Wrapf(
+					ErrUnknownDependency, "%s depends on %s",
+					typeName, dependency,
+				)
+			}
+			inDegree[typeName]++
+		}
+	}
+
+	order := make([]string, 0, len(s.serviceTypes))
+	for len(order) < len(s.serviceTypes) {
+		// Pick the first registered service whose dependencies are sorted.
+		next := slices.IndexFunc(s.serviceTypes, func(typeName string) bool {
+			return inDegree[typeName] == 0 &&
+				!slices.Contains(order, typeName)
+		})
+		if next < 0 {
+			return nil, ErrDependencyCycle
+		}
+
+		typeName := s.serviceTypes[next]
+		order = append(order, typeName)
+		for _, dependent := range s.serviceTypes {
+			for _, dependency := range s.dependencies[dependent] {
+				if dependency == typeName {
+					inDegree[dependent]--
+				}
+			}
+		}
+	}
+	return order, nil
+}
+
+// isStopped returns true if the service has been stopped. The caller must
+// hold the lock.
+func (s *Registry) isStopped(typeName string) bool {
+	_, ok := s.stopped[typeName]
+	return ok
+}
@@ -22,6 +22,7 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -81,3 +82,163 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+// lifecycleService is a service recording when it is started and stopped.
+type lifecycleService struct {
+	name   string
+	events *[]string
+	stop   func(context.Context) error
+	health error
+}
+
+func (s *lifecycleService) Name() string { return s.name }
+
+func (s *lifecycleService) Start(context.Context) error {
+	*s.events = append(*s.events, "start "+s.name)
+	return nil
+}
+
+func (s *lifecycleService) Stop(ctx context.Context) error {
+	*s.events = append(*s.events, "stop "+s.name)
+	if s.stop != nil {
+		return s.stop(ctx)
+	}
+	return nil
+}
+
+func (s *lifecycleService) Health() error { return s.health }
+
+func TestRegistry_DependencyOrder(t *testing.T) {
+	var events []string
+	a := &lifecycleService{name: "a", events: &events}
+	b := &lifecycleService{name: "b", events: &events}
+	c := &lifecycleService{name: "c", events: &events}
+	d := &lifecycleService{name: "d", events: &events}
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(a, c),
+		service.WithService(b),
+		service.WithService(c, b),
+		service.WithService(d),
+	)
+
+	require.NoError(t, registry.StartAll(context.Background()))
+	require.NoError(t, registry.StopAll(context.Background()))
+	require.Equal(t, []string{
+		"start b", "start c", "start a", "start d",
+		"stop d", "stop a", "stop c", "stop b",
+	}, events)
+
+	// Stopping again is a no-op.
+	require.NoError(t, registry.StopAll(context.Background()))
+	require.Len(t, events, 8)
+}
+
+func TestRegistry_InvalidDependencies(t *testing.T) {
+	var events []string
+	a := &lifecycleService{name: "a", events: &events}
+	b := &lifecycleService{name: "b", events: &events}
+	c := &lifecycleService{name: "c", events: &events}
+
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(a, b),
+	)
+	require.ErrorIs(
+		t, registry.StartAll(context.Background()),
+		service.ErrUnknownDependency,
+	)
+
+	registry = service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(a, c),
+		service.WithService(b, a),
+		service.WithService(c, b),
+	)
+	require.ErrorIs(
+		t, registry.StartAll(context.Background()),
+		service.ErrDependencyCycle,
+	)
+	require.Empty(t, events)
+}
+
+func TestRegistry_StopAllTimeout(t *testing.T) {
+	var (
+		events  []string
+		stopErr error
+	)
+	errStop := errors.New("stop failed")
+	a := &lifecycleService{
+		name:   "a",
+		events: &events,
+		stop: func(ctx context.Context) error {
+			stopErr = ctx.Err()
+			return nil
+		},
+	}
+	b := &lifecycleService{
+		name:   "b",
+		events: &events,
+		stop:   func(context.Context) error { return errStop },
+	}
+	c := &lifecycleService{
+		name:   "c",
+		events: &events,
+		stop: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(a),
+		service.WithService(b, a),
+		service.WithService(c, b),
+		service.WithStopTimeout(c, 10*time.Millisecond),
+	)
+	require.NoError(t, registry.StartAll(context.Background()))
+
+	err := registry.StopAll(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, []string{
+		"start a", "start b", "start c", "stop c", "stop b", "stop a",
+	}, events)
+	// The services stopped after a slow one get their own timeout.
+	require.NoError(t, stopErr)
+}
+
+func TestRegistry_Health(t *testing.T) {
+	var events []string
+	errUnhealthy := errors.New("unhealthy")
+	a := &lifecycleService{name: "a", events: &events}
+	b := &lifecycleService{name: "b", events: &events, health: errUnhealthy}
+	c := &mocks.Basic{}
+	c.On("Name").Return("c")
+	c.On("Start", mock.Anything).Return(nil)
+	registry := service.NewRegistry(
+		service.WithLogger(noop.NewLogger[any]()),
+		service.WithService(a),
+		service.WithService(b),
+		service.WithService(c),
+	)
+	require.Equal(t, map[string]error{
+		"a": service.ErrServiceNotStarted,
+		"b": service.ErrServiceNotStarted,
+		"c": service.ErrServiceNotStarted,
+	}, registry.Health())
+
+	require.NoError(t, registry.StartAll(context.Background()))
+	require.Equal(t, map[string]error{
+		"a": nil,
+		"b": errUnhealthy,
+		"c": nil,
+	}, registry.Health())
+
+	require.NoError(t, registry.StopAll(context.Background()))
+	require.Equal(t, map[string]error{
+		"a": service.ErrServiceStopped,
+		"b": service.ErrServiceStopped,
+		"c": service.ErrServiceStopped,
+	}, registry.Health())
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+const (
+	// ExporterOTLP exports spans to an OTLP collector over gRPC.
+	ExporterOTLP = "otlp"
+	// ExporterFile writes spans as JSON lines to a local file.
+	ExporterFile = "file"
+
+	defaultEndpoint    = "localhost:4317"
+	defaultFilePath    = "traces.jsonl"
+	defaultSampleRatio = 1.0
+)
+
+// Config is the configuration for the tracing service.
+type Config struct {
+	// Enabled is the flag to enable tracing.
+	Enabled bool `mapstructure:"enabled"`
+	// Exporter is the span exporter to use, either "otlp" or "file".
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint is the address of the OTLP collector.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables TLS when connecting to the OTLP collector.
+	Insecure bool `mapstructure:"insecure"`
+	// FilePath is the path of the file written by the file exporter.
+	FilePath string `mapstructure:"file-path"`
+	// SampleRatio is the fraction of traces to sample, in [0, 1]. Spans
+	// whose parent is sampled are always sampled.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+}
+
+// DefaultConfig returns the default configuration for the tracing service.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		Exporter:    ExporterOTLP,
+		Endpoint:    defaultEndpoint,
+		Insecure:    true,
+		FilePath:    defaultFilePath,
+		SampleRatio: defaultSampleRatio,
+	}
+}
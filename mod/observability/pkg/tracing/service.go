@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// serviceName is the name reported by the spans of the node.
+const serviceName = "beacon-kit"
+
+// Service owns the tracer provider of the node. Once started, spans created
+// through StartSpan are sampled and exported according to its configuration.
+type Service struct {
+	cfg      Config
+	provider *sdktrace.TracerProvider
+}
+
+// NewService creates a new tracing service. When tracing is disabled the
+// service does nothing and spans are dropped by the no-op global provider.
+func NewService(cfg Config) (*Service, error) {
+	s := &Service{cfg: cfg}
+	if !cfg.Enabled {
+		return s, nil
+	}
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, errors.Wrapf(
+			ErrInvalidSampleRatio, "%v", cfg.SampleRatio,
+		)
+	}
+
+	exporter, err := newExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(
+			sdktrace.TraceIDRatioBased(cfg.SampleRatio),
+		)),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+		)),
+	)
+	return s, nil
+}
+
+// newExporter creates the span exporter selected by the configuration.
+func newExporter(cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(cfg.Endpoint),
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		// The gRPC connection is established lazily, so the collector does
+		// not need to be up when the node starts.
+		return otlptracegrpc.New(context.Background(), opts...)
+	case ExporterFile:
+		return newFileExporter(cfg.FilePath)
+	default:
+		return nil, errors.Wrapf(ErrUnknownExporter, "%s", cfg.Exporter)
+	}
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "tracing"
+}
+
+// Start installs the tracer provider as the global one.
+func (s *Service) Start(context.Context) error {
+	if s.provider != nil {
+		otel.SetTracerProvider(s.provider)
+		enabled.Store(true)
+	}
+	return nil
+}
+
+// Stop flushes the pending spans and shuts down the exporter.
+func (s *Service) Stop(ctx context.Context) error {
+	if s.provider == nil {
+		return nil
+	}
+	enabled.Store(false)
+	return s.provider.Shutdown(ctx)
+}
@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// tracerName is the instrumentation scope of the spans of the node.
+	tracerName = "github.com/berachain/beacon-kit"
+	// maxLinkedValues bounds the number of slots and block roots for which
+	// a span is remembered to be linked to.
+	maxLinkedValues = 1024
+)
+
+// linkKeys are the attributes by which spans of different traces are linked.
+// A block goes through several ABCI calls, each starting a new trace, so the
+// traces of PrepareProposal, ProcessProposal and FinalizeBlock are tied
+// together by linking them to the first span seen for the same slot or
+// block root.
+//
+//nolint:gochecknoglobals // read-only.
+var linkKeys = []attribute.Key{"slot", "block_root"}
+
+//nolint:gochecknoglobals // shared by every span of the process.
+var (
+	links = newLinker(maxLinkedValues)
+	// enabled is set while a tracing service is running.
+	enabled atomic.Bool
+)
+
+// linkedKeysKey is the context key of the link keys already linked by a span
+// of the current trace.
+type linkedKeysKey struct{}
+
+// StartSpan starts a span with the given name using the global tracer
+// provider. The args are key-value pairs, as for the logger, which are
+// recorded as attributes of the span. The first span of a trace carrying a
+// slot or a block root is linked to the first span seen for that value.
+// Values of type func() any are only evaluated when tracing is enabled, for
+// attributes that are costly to compute.
+//
+// It returns the context carrying the span and a function ending the span
+// with the error of the traced operation, if any.
+func StartSpan(
+	ctx context.Context,
+	name string,
+	args ...any,
+) (context.Context, func(error)) {
+	if !enabled.Load() {
+		return ctx, func(error) {}
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var (
+		attrs     = attributes(args)
+		linked, _ = ctx.Value(linkedKeysKey{}).([]attribute.Key)
+		toLink    = make([]attribute.KeyValue, 0, len(linkKeys))
+	)
+	for _, attr := range attrs {
+		if slices.Contains(linkKeys, attr.Key) &&
+			!slices.Contains(linked, attr.Key) {
+			toLink = append(toLink, attr)
+		}
+	}
+
+	ctx, span := otel.Tracer(tracerName).Start(
+		ctx, name,
+		trace.WithAttributes(attrs...),
+		trace.WithLinks(links.get(toLink)...),
+	)
+	if len(toLink) > 0 && span.SpanContext().IsSampled() {
+		links.add(toLink, span.SpanContext())
+		linked = slices.Clip(linked)
+		for _, attr := range toLink {
+			linked = append(linked, attr.Key)
+		}
+		ctx = context.WithValue(ctx, linkedKeysKey{}, linked)
+	}
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// attributes converts key-value pairs to span attributes. A trailing key
+// without a value is dropped.
+func attributes(args []any) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, len(args)/2)
+	for i := 0; i+1 < len(args); i += 2 {
+		key, ok := args[i].(string)
+		if !ok {
+			key = fmt.Sprint(args[i])
+		}
+		attrs = append(attrs, toAttribute(key, args[i+1]))
+	}
+	return attrs
+}
+
+// toAttribute converts a value to a span attribute, falling back to its
+// string representation for types without a native attribute type.
+func toAttribute(key string, value any) attribute.KeyValue {
+	switch v := value.(type) {
+	case func() any:
+		return toAttribute(key, v())
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case uint64:
+		return uint64Attribute(key, v)
+	case interface{ Unwrap() uint64 }:
+		return uint64Attribute(key, v.Unwrap())
+	case error:
+		return attribute.String(key, v.Error())
+	case fmt.Stringer:
+		return attribute.String(key, v.String())
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
+
+// uint64Attribute returns an int64 attribute, or a string one if the value
+// does not fit.
+func uint64Attribute(key string, value uint64) attribute.KeyValue {
+	if value > math.MaxInt64 {
+		return attribute.String(key, fmt.Sprint(value))
+	}
+	//#nosec:G701 // checked above.
+	return attribute.Int64(key, int64(value))
+}
+
+// linker remembers the first span seen for the values of the link keys,
+// evicting the oldest values once full.
+type linker struct {
+	mu       sync.Mutex
+	capacity int
+	spans    map[attribute.KeyValue]trace.SpanContext
+	order    []attribute.KeyValue
+}
+
+// newLinker creates a linker remembering up to capacity values.
+func newLinker(capacity int) *linker {
+	return &linker{
+		capacity: capacity,
+		spans: make(
+			map[attribute.KeyValue]trace.SpanContext, capacity,
+		),
+		order: make([]attribute.KeyValue, 0, capacity),
+	}
+}
+
+// get returns the links to the spans remembered for the given attributes.
+func (l *linker) get(attrs []attribute.KeyValue) []trace.Link {
+	if len(attrs) == 0 {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var result []trace.Link
+	for _, attr := range attrs {
+		if sc, ok := l.spans[attr]; ok {
+			result = append(result, trace.Link{
+				SpanContext: sc,
+				Attributes:  []attribute.KeyValue{attr},
+			})
+		}
+	}
+	return result
+}
+
+// add remembers the span for the values in attrs that are not remembered
+// yet.
+func (l *linker) add(attrs []attribute.KeyValue, sc trace.SpanContext) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, attr := range attrs {
+		if _, ok := l.spans[attr]; ok {
+			continue
+		}
+		if len(l.order) == l.capacity {
+			delete(l.spans, l.order[0])
+			l.order = l.order[1:]
+		}
+		l.spans[attr] = sc
+		l.order = append(l.order, attr)
+	}
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/observability/pkg/tracing"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// exportedSpan is the subset of an exported span checked by the tests.
+type exportedSpan struct {
+	Name        string
+	SpanContext spanContext
+	Parent      spanContext
+	Links       []struct{ SpanContext spanContext }
+	Status      struct{ Description string }
+}
+
+type spanContext struct {
+	TraceID string
+	SpanID  string
+}
+
+func TestStartSpan_FileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	cfg := tracing.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Exporter = tracing.ExporterFile
+	cfg.FilePath = path
+
+	svc, err := tracing.NewService(cfg)
+	require.NoError(t, err)
+	require.NoError(t, svc.Start(context.Background()))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	ctx, end := tracing.StartSpan(
+		context.Background(), "PrepareProposal", "slot", uint64(7),
+	)
+	_, endChild := tracing.StartSpan(ctx, "ProcessSlots", "slot", uint64(7))
+	endChild(nil)
+	_, endChild = tracing.StartSpan(
+		ctx, "VerifyBlock", "block_root", func() any { return "0x01" },
+	)
+	endChild(nil)
+	end(nil)
+	ctx, end = tracing.StartSpan(
+		context.Background(), "FinalizeBlock", "slot", uint64(7),
+	)
+	_, endChild = tracing.StartSpan(ctx, "ProcessBlock", "block_root", "0x01")
+	endChild(nil)
+	end(errors.New("boom"))
+	require.NoError(t, svc.Stop(context.Background()))
+
+	spans := readSpans(t, path)
+	require.Len(t, spans, 5)
+	prepare, child, finalize := spans["PrepareProposal"],
+		spans["ProcessSlots"], spans["FinalizeBlock"]
+
+	// Child spans belong to the trace of their parent and are not linked
+	// again by a key already linked in their trace.
+	require.Equal(t, prepare.SpanContext, child.Parent)
+	require.Equal(t, prepare.SpanContext.TraceID, child.SpanContext.TraceID)
+	require.Empty(t, child.Links)
+
+	// The first span of a trace carrying a block root is linked to the
+	// first span seen for it.
+	require.Len(t, spans["ProcessBlock"].Links, 1)
+	require.Equal(
+		t,
+		spans["VerifyBlock"].SpanContext,
+		spans["ProcessBlock"].Links[0].SpanContext,
+	)
+
+	// Spans of the same slot are linked to the first one.
+	require.NotEqual(
+		t, prepare.SpanContext.TraceID, finalize.SpanContext.TraceID,
+	)
+	require.Len(t, finalize.Links, 1)
+	require.Equal(t, prepare.SpanContext, finalize.Links[0].SpanContext)
+	require.Equal(t, "boom", finalize.Status.Description)
+}
+
+func TestStartSpan_Disabled(t *testing.T) {
+	var evaluated bool
+	ctx := context.Background()
+	spanCtx, end := tracing.StartSpan(
+		ctx, "VerifyBlock", "block_root", func() any {
+			evaluated = true
+			return "0x01"
+		},
+	)
+	end(nil)
+	require.Equal(t, ctx, spanCtx)
+	require.False(t, evaluated)
+}
+
+func TestNewService_InvalidConfig(t *testing.T) {
+	cfg := tracing.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Exporter = "zipkin"
+	_, err := tracing.NewService(cfg)
+	require.ErrorIs(t, err, tracing.ErrUnknownExporter)
+
+	cfg = tracing.DefaultConfig()
+	cfg.Enabled = true
+	cfg.SampleRatio = 1.5
+	_, err = tracing.NewService(cfg)
+	require.ErrorIs(t, err, tracing.ErrInvalidSampleRatio)
+}
+
+func readSpans(t *testing.T, path string) map[string]exportedSpan {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	spans := make(map[string]exportedSpan)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var span exportedSpan
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &span))
+		spans[span.Name] = span
+	}
+	require.NoError(t, scanner.Err())
+	return spans
+}
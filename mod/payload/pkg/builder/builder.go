@@ -23,6 +23,7 @@ package builder
 import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -50,6 +51,11 @@ type PayloadBuilder[
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot]
 	// attributesFactory is used to create attributes for the
 	attributesFactory AttributesFactory[BeaconStateT, PayloadAttributesT]
+	// signer is used to look up the index of the proposing validator.
+	signer crypto.BLSSigner
+	// dispatcher is used to publish the payload attributes sent to the
+	// execution client.
+	dispatcher EventPublisher
 }
 
 // New creates a new service.
@@ -67,6 +73,8 @@ func New[
 	ee ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT],
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot],
 	af AttributesFactory[BeaconStateT, PayloadAttributesT],
+	signer crypto.BLSSigner,
+	dispatcher EventPublisher,
 ) *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -81,6 +89,8 @@ func New[
 		ee:                ee,
 		pc:                pc,
 		attributesFactory: af,
+		signer:            signer,
+		dispatcher:        dispatcher,
 	}
 }
 
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -87,9 +88,57 @@ func (pb *PayloadBuilder[
 		pb.pc.Set(slot, parentBlockRoot, *payloadID)
 	}
 
+	pb.publishPayloadAttributes(
+		ctx, st, slot, parentBlockRoot, headEth1BlockHash, attrs,
+	)
 	return payloadID, nil
 }
 
+// publishPayloadAttributes publishes the payload attributes sent to the
+// execution client for the given slot. Failing to publish them does not fail
+// the payload build, so errors are only logged.
+func (pb *PayloadBuilder[
+	BeaconStateT, _, _, PayloadAttributesT, _, _,
+]) publishPayloadAttributes(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	parentBlockRoot common.Root,
+	parentEth1BlockHash common.ExecutionHash,
+	attrs PayloadAttributesT,
+) {
+	// A node that is not a validator never proposes the payload it builds.
+	proposerIndex, err := st.ValidatorIndexByPubkey(pb.signer.PublicKey())
+	if err != nil {
+		return
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		pb.logger.Error(
+			"Failed to get latest execution payload header",
+			"error", err,
+		)
+		return
+	}
+
+	if err = pb.dispatcher.Publish(async.NewEvent(
+		ctx,
+		async.PayloadAttributesSent,
+		&engineprimitives.PayloadBuildData[PayloadAttributesT]{
+			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
+			ProposalSlot:      slot,
+			ProposerIndex:     proposerIndex,
+			ParentBlockRoot:   parentBlockRoot,
+			ParentBlockNumber: lph.GetNumber(),
+			ParentBlockHash:   parentEth1BlockHash,
+			PayloadAttributes: attrs,
+		},
+	)); err != nil {
+		pb.logger.Error("Failed to publish payload attributes", "error", err)
+	}
+}
+
 // RequestPayloadSync request a payload for the given slot and
 // blocks until the payload is delivered.
 func (pb *PayloadBuilder[
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -52,6 +53,12 @@ type BeaconState[
 	GetBlockRootAtIndex(uint64) (common.Root, error)
 }
 
+// EventPublisher is the interface for publishing events.
+type EventPublisher interface {
+	// Publish publishes the given event.
+	Publish(event async.BaseEvent) error
+}
+
 type PayloadCache[PayloadIDT, RootT, SlotT any] interface {
 	Get(slot SlotT, stateRoot RootT) (PayloadIDT, bool)
 	Has(slot SlotT, stateRoot RootT) bool
@@ -76,6 +83,8 @@ type ExecutionPayloadHeader interface {
 	GetBlockHash() common.ExecutionHash
 	// GetParentHash returns the parent hash.
 	GetParentHash() common.ExecutionHash
+	// GetNumber returns the block number.
+	GetNumber() math.U64
 }
 
 // AttributesFactory is the interface for the attributes factory.
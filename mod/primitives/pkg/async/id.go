@@ -31,9 +31,10 @@ const (
 	GenesisDataProcessed = "genesis-data-processed"
 
 	// pre proposal events.
-	NewSlot          = "new-slot"
-	BuiltBeaconBlock = "built-beacon-block"
-	BuiltSidecars    = "built-sidecars"
+	NewSlot               = "new-slot"
+	PayloadAttributesSent = "payload-attributes-sent"
+	BuiltBeaconBlock      = "built-beacon-block"
+	BuiltSidecars         = "built-sidecars"
 
 	// proposal processing events.
 	BeaconBlockReceived = "beacon-block-received"
@@ -32,3 +32,10 @@ const (
 	// FarFutureEpoch represents a far future epoch value.
 	FarFutureEpoch = ^uint64(0)
 )
+
+// UnsetDepositRequestsStartIndex is the deposit requests start index of a
+// state that has not processed a deposit request yet, as defined in:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#misc
+//
+//nolint:lll // link.
+const UnsetDepositRequestsStartIndex = ^uint64(0)
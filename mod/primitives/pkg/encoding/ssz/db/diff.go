@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+)
+
+// Difference is a value that differs between two SSZ serializations of the
+// same type.
+type Difference struct {
+	// Path is the object path of the value.
+	Path string
+	// Type is the schema type of the value.
+	Type schema.SSZType
+	// A is the SSZ serialization of the value in the first serialization, or
+	// nil if the value is not present in it.
+	A []byte
+	// B is the SSZ serialization of the value in the second serialization, or
+	// nil if the value is not present in it.
+	B []byte
+}
+
+// Diff returns the values that differ between two SSZ serializations of a
+// value of the given type, down to basic values and byte vectors or lists.
+// Lists of different lengths report their `__len__` and the elements present
+// in only one of them.
+func Diff(typ schema.SSZType, a, b []byte) ([]Difference, error) {
+	var diffs []Difference
+	return diffs, diffValue(typ, "", a, b, &diffs)
+}
+
+// diffValue appends the differences between the given SSZ serializations of a
+// value of the given type at the given object path.
+func diffValue(
+	typ schema.SSZType,
+	path string,
+	a, b []byte,
+	diffs *[]Difference,
+) error {
+	if bytes.Equal(a, b) {
+		return nil
+	}
+
+	switch typ.ID() {
+	case schema.Container:
+		fieldsA, err := splitFields(typ, a)
+		if err != nil {
+			return err
+		}
+		fieldsB, err := splitFields(typ, b)
+		if err != nil {
+			return err
+		}
+		for i, name := range schema.FieldNames(typ) {
+			if err = diffValue(
+				schema.ChunkType(typ, uint64(i)), joinPath(path, name),
+				fieldsA[i], fieldsB[i], diffs,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	case schema.Vector, schema.List:
+		elemType := typ.ElementType("")
+		if elemType.ID().IsBasic() && elemType.ItemLength() == 1 {
+			// Byte vectors and lists are reported as a whole.
+			break
+		}
+		return diffElements(typ, elemType, path, a, b, diffs)
+	}
+
+	*diffs = append(*diffs, Difference{Path: path, Type: typ, A: a, B: b})
+	return nil
+}
+
+// diffElements appends the differences between the elements of the given SSZ
+// serializations of a vector or list.
+func diffElements(
+	typ, elemType schema.SSZType,
+	path string,
+	a, b []byte,
+	diffs *[]Difference,
+) error {
+	elemsA, err := splitElements(elemType, a)
+	if err != nil {
+		return err
+	}
+	elemsB, err := splitElements(elemType, b)
+	if err != nil {
+		return err
+	}
+
+	if typ.ID() == schema.List && len(elemsA) != len(elemsB) {
+		*diffs = append(*diffs, Difference{
+			Path: joinPath(path, "__len__"),
+			Type: schema.U64(),
+			A:    binary.LittleEndian.AppendUint64(nil, uint64(len(elemsA))),
+			B:    binary.LittleEndian.AppendUint64(nil, uint64(len(elemsB))),
+		})
+	}
+	for i := range max(len(elemsA), len(elemsB)) {
+		elemPath := joinPath(path, strconv.Itoa(i))
+		switch {
+		case i >= len(elemsA):
+			*diffs = append(*diffs, Difference{
+				Path: elemPath, Type: elemType, B: elemsB[i],
+			})
+		case i >= len(elemsB):
+			*diffs = append(*diffs, Difference{
+				Path: elemPath, Type: elemType, A: elemsA[i],
+			})
+		default:
+			if err = diffValue(
+				elemType, elemPath, elemsA[i], elemsB[i], diffs,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// joinPath returns the object path of the given child of the value at the
+// given object path.
+func joinPath(path, child string) string {
+	if path == "" {
+		return child
+	}
+	return path + "/" + child
+}
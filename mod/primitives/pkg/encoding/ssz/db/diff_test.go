@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/db"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	a := newTestValue()
+	diffs, err := db.Diff(testTreeType, a.marshal(), a.marshal())
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	b := newTestValue()
+	b.uint64 = 8
+	b.listUint64[1] = 20
+	b.listNested[1].bytes48[0] = 9
+	b.listNested = append(b.listNested, testNested{uint64: 3})
+	b.byteList = []byte("another byte list")
+
+	diffs, err = db.Diff(testTreeType, a.marshal(), b.marshal())
+	require.NoError(t, err)
+	require.Equal(t, []db.Difference{
+		{
+			Path: "uint64",
+			Type: schema.U64(),
+			A:    binary.LittleEndian.AppendUint64(nil, 7),
+			B:    binary.LittleEndian.AppendUint64(nil, 8),
+		},
+		{
+			Path: "list_uint64/1",
+			Type: schema.U64(),
+			A:    binary.LittleEndian.AppendUint64(nil, 2),
+			B:    binary.LittleEndian.AppendUint64(nil, 20),
+		},
+		{
+			Path: "list_nested/__len__",
+			Type: schema.U64(),
+			A:    binary.LittleEndian.AppendUint64(nil, 2),
+			B:    binary.LittleEndian.AppendUint64(nil, 3),
+		},
+		{
+			Path: "list_nested/1/bytes48",
+			Type: schema.B48(),
+			A:    a.listNested[1].bytes48[:],
+			B:    b.listNested[1].bytes48[:],
+		},
+		{
+			Path: "list_nested/2",
+			Type: testNestedType,
+			B:    b.listNested[2].marshal(),
+		},
+		{
+			Path: "byte_list",
+			Type: schema.DefineByteList(40),
+			A:    a.byteList,
+			B:    b.byteList,
+		},
+	}, diffs)
+
+	_, err = db.Diff(testTreeType, a.marshal(), []byte{1, 2, 3})
+	require.ErrorIs(t, err, db.ErrUnexpectedSize)
+}
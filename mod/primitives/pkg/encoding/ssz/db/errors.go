@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import "errors"
+
+var (
+	// ErrInvalidGeneralizedIndex is returned when a generalized index does
+	// not refer to a node of the tree schema.
+	ErrInvalidGeneralizedIndex = errors.New("invalid generalized index")
+
+	// ErrUnexpectedSize is returned when the SSZ serialization of a value does
+	// not match the size of its schema type.
+	ErrUnexpectedSize = errors.New("unexpected ssz size")
+
+	// ErrInvalidOffset is returned when an offset of a variable-size SSZ
+	// serialization is out of bounds.
+	ErrInvalidOffset = errors.New("invalid ssz offset")
+
+	// ErrLimitExceeded is returned when a list has more elements than its
+	// schema limit.
+	ErrLimitExceeded = errors.New("list limit exceeded")
+)
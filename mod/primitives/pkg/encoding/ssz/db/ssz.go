@@ -0,0 +1,475 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// bytesPerOffset is the size of an offset of a variable-size SSZ value.
+const bytesPerOffset = 4
+
+// lengthed is implemented by the vector, list and container schema types.
+type lengthed interface {
+	// Length returns the length of a vector, the limit of a list or the
+	// number of fields of a container.
+	Length() uint64
+}
+
+// GetSSZ returns the SSZ serialization of the value at the given object path,
+// read from the leaves of the tree.
+func (t *Tree[GIndexT, RootT]) GetSSZ(
+	path merkle.ObjectPath[GIndexT, RootT],
+) ([]byte, error) {
+	node, err := NewTreeNode(t.root, path)
+	if err != nil {
+		return nil, err
+	}
+	if node.ID().IsBasic() {
+		var chunk RootT
+		if chunk, err = t.Get(node.GIndex()); err != nil {
+			return nil, err
+		}
+		start := uint64(node.Offset())
+		return chunk[start : start+node.ItemLength()], nil
+	}
+	return t.serialize(node.SSZType, node.GIndex())
+}
+
+// SetSSZ sets the value at the given object path from its SSZ serialization,
+// updating the leaves of the value and rehashing the branches above them.
+// Setting a list element does not change the length of the list, which is set
+// at the `__len__` path of the list.
+func (t *Tree[GIndexT, RootT]) SetSSZ(
+	path merkle.ObjectPath[GIndexT, RootT],
+	data []byte,
+) error {
+	node, err := NewTreeNode(t.root, path)
+	if err != nil {
+		return err
+	}
+
+	nodes := make(map[GIndexT]RootT)
+	if node.ID().IsBasic() {
+		// Basic values may be packed with others in a chunk.
+		if uint64(len(data)) != node.ItemLength() {
+			return errors.Wrapf(
+				ErrUnexpectedSize, "path: %s, size: %d", path, len(data),
+			)
+		}
+		var chunk RootT
+		if chunk, err = t.Get(node.GIndex()); err != nil {
+			return err
+		}
+		copy(chunk[node.Offset():], data)
+		nodes[node.GIndex()] = chunk
+	} else if _, err = t.merkleize(
+		node.SSZType, data, node.GIndex(), nodes,
+	); err != nil {
+		return err
+	}
+	return t.Set(nodes)
+}
+
+// merkleize collects the nodes of the subtree of the given SSZ serialization
+// of a value of the given type at the given generalized index, and returns the
+// root of the subtree. Chunks of lists beyond their new length are cleared.
+func (t *Tree[GIndexT, RootT]) merkleize(
+	typ schema.SSZType,
+	data []byte,
+	gIndex GIndexT,
+	nodes map[GIndexT]RootT,
+) (RootT, error) {
+	var root RootT
+	switch {
+	case typ.ID().IsBasic():
+		if uint64(len(data)) != typ.ItemLength() {
+			return root, errors.Wrapf(ErrUnexpectedSize, "size: %d", len(data))
+		}
+		copy(root[:], data)
+		nodes[gIndex] = root
+		return root, nil
+
+	case typ.ID().IsList():
+		elemType := typ.ElementType("")
+		parts, err := splitElements(elemType, data)
+		if err != nil {
+			return root, err
+		}
+		length := uint64(len(parts))
+		if length > typ.(lengthed).Length() {
+			return root, errors.Wrapf(ErrLimitExceeded, "length: %d", length)
+		}
+
+		// The previous elements of the list must be cleared.
+		prevLength, err := t.listLength(gIndex)
+		if err != nil {
+			return root, err
+		}
+		dataRoot, err := t.merkleizeElements(
+			elemType, parts, prevLength, 2*gIndex, chunkDepth(typ), nodes,
+		)
+		if err != nil {
+			return root, err
+		}
+
+		var lengthChunk RootT
+		binary.LittleEndian.PutUint64(lengthChunk[:], length)
+		nodes[2*gIndex+1] = lengthChunk
+		root = hashPair(dataRoot, lengthChunk)
+		nodes[gIndex] = root
+		return root, nil
+
+	case typ.ID().IsEnumerable():
+		elemType := typ.ElementType("")
+		parts, err := splitElements(elemType, data)
+		if err != nil {
+			return root, err
+		}
+		if length := typ.(lengthed).Length(); uint64(len(parts)) != length {
+			return root, errors.Wrapf(
+				ErrUnexpectedSize, "length: %d, expected: %d",
+				len(parts), length,
+			)
+		}
+		return t.merkleizeElements(
+			elemType, parts, 0, gIndex, chunkDepth(typ), nodes,
+		)
+
+	default:
+		parts, err := splitFields(typ, data)
+		if err != nil {
+			return root, err
+		}
+		first := gIndex << chunkDepth(typ)
+		chunks := make([]RootT, len(parts))
+		for i, part := range parts {
+			if chunks[i], err = t.merkleize(
+				schema.ChunkType(typ, uint64(i)),
+				part,
+				first+GIndexT(i),
+				nodes,
+			); err != nil {
+				return root, err
+			}
+		}
+		return merkleizeChunks(chunks, gIndex, chunkDepth(typ), nodes), nil
+	}
+}
+
+// merkleizeElements collects the nodes of the subtree of the given elements
+// of a vector or list at the given generalized index, and returns the root of
+// the subtree. Chunks of the previous elements beyond the given elements are
+// cleared.
+func (t *Tree[GIndexT, RootT]) merkleizeElements(
+	elemType schema.SSZType,
+	parts [][]byte,
+	prevLength uint64,
+	gIndex GIndexT,
+	depth uint8,
+	nodes map[GIndexT]RootT,
+) (RootT, error) {
+	var chunks []RootT
+	if elemType.ID().IsBasic() {
+		// Basic elements are packed into chunks.
+		size := elemType.ItemLength()
+		count := chunkCount(uint64(len(parts)) * size)
+		chunks = make([]RootT, max(count, chunkCount(prevLength*size)))
+		for i, part := range parts {
+			offset := uint64(i) * size
+			copy(chunks[offset/constants.BytesPerChunk][offset%constants.
+				BytesPerChunk:], part)
+		}
+	} else {
+		chunks = make([]RootT, max(uint64(len(parts)), prevLength))
+		first := gIndex << depth
+		for i, part := range parts {
+			var err error
+			if chunks[i], err = t.merkleize(
+				elemType, part, first+GIndexT(i), nodes,
+			); err != nil {
+				return chunks[i], err
+			}
+		}
+	}
+	return merkleizeChunks(chunks, gIndex, depth, nodes), nil
+}
+
+// serialize returns the SSZ serialization of the value of the given type at
+// the given generalized index, read from the leaves of the tree.
+func (t *Tree[GIndexT, RootT]) serialize(
+	typ schema.SSZType,
+	gIndex GIndexT,
+) ([]byte, error) {
+	switch {
+	case typ.ID().IsBasic():
+		chunk, err := t.Get(gIndex)
+		if err != nil {
+			return nil, err
+		}
+		return chunk[:typ.ItemLength()], nil
+
+	case typ.ID().IsEnumerable():
+		length := typ.(lengthed).Length()
+		first := gIndex << chunkDepth(typ)
+		if typ.ID().IsList() {
+			var err error
+			if length, err = t.listLength(gIndex); err != nil {
+				return nil, err
+			}
+			first = 2 * gIndex << chunkDepth(typ)
+		}
+
+		elemType := typ.ElementType("")
+		if elemType.ID().IsBasic() {
+			size := length * elemType.ItemLength()
+			data := make([]byte, 0, chunkCount(size)*constants.BytesPerChunk)
+			for i := range chunkCount(size) {
+				chunk, err := t.Get(first + GIndexT(i))
+				if err != nil {
+					return nil, err
+				}
+				data = append(data, chunk[:]...)
+			}
+			return data[:size], nil
+		}
+
+		parts := make([][]byte, length)
+		for i := range length {
+			var err error
+			if parts[i], err = t.serialize(
+				elemType, first+GIndexT(i),
+			); err != nil {
+				return nil, err
+			}
+		}
+		_, fixed := schema.FixedSize(elemType)
+		return joinParts(parts, func(int) bool { return !fixed }), nil
+
+	default:
+		count := typ.(lengthed).Length()
+		first := gIndex << chunkDepth(typ)
+		parts := make([][]byte, count)
+		for i := range count {
+			var err error
+			if parts[i], err = t.serialize(
+				schema.ChunkType(typ, i), first+GIndexT(i),
+			); err != nil {
+				return nil, err
+			}
+		}
+		return joinParts(parts, func(i int) bool {
+			//#nosec:G701 // i is a field index.
+			_, fixed := schema.FixedSize(schema.ChunkType(typ, uint64(i)))
+			return !fixed
+		}), nil
+	}
+}
+
+// listLength returns the length of the list at the given generalized index.
+func (t *Tree[GIndexT, RootT]) listLength(gIndex GIndexT) (uint64, error) {
+	chunk, err := t.Get(2*gIndex + 1)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(chunk[:]), nil
+}
+
+// merkleizeChunks collects the nodes of the subtree of the given depth with
+// the given chunks as leaves, padded with zero chunks, at the given
+// generalized index, and returns the root of the subtree.
+func merkleizeChunks[GIndexT ~uint64, RootT ~[32]byte](
+	chunks []RootT,
+	gIndex GIndexT,
+	depth uint8,
+	nodes map[GIndexT]RootT,
+) RootT {
+	if len(chunks) == 0 {
+		nodes[gIndex] = zero.Hashes[depth]
+		return nodes[gIndex]
+	}
+
+	layer, first := chunks, gIndex<<depth
+	for height := range depth {
+		for i, node := range layer {
+			nodes[first+GIndexT(i)] = node
+		}
+		next := make([]RootT, (len(layer)+1)/2)
+		for i := range next {
+			right := RootT(zero.Hashes[height])
+			if 2*i+1 < len(layer) {
+				right = layer[2*i+1]
+			}
+			next[i] = hashPair(layer[2*i], right)
+		}
+		layer, first = next, first/2
+	}
+	nodes[gIndex] = layer[0]
+	return layer[0]
+}
+
+// splitElements splits the SSZ serialization of the elements of a vector or
+// list of the given element type.
+func splitElements(elemType schema.SSZType, data []byte) ([][]byte, error) {
+	if size, fixed := schema.FixedSize(elemType); fixed {
+		if size == 0 || uint64(len(data))%size != 0 {
+			return nil, errors.Wrapf(ErrUnexpectedSize, "size: %d", len(data))
+		}
+		parts := make([][]byte, uint64(len(data))/size)
+		for i := range parts {
+			start := uint64(i) * size
+			parts[i] = data[start : start+size]
+		}
+		return parts, nil
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if len(data) < bytesPerOffset {
+		return nil, errors.Wrapf(ErrInvalidOffset, "size: %d", len(data))
+	}
+	first := binary.LittleEndian.Uint32(data)
+	if first%bytesPerOffset != 0 {
+		return nil, errors.Wrapf(ErrInvalidOffset, "offset: %d", first)
+	}
+	variable := make([]bool, first/bytesPerOffset)
+	for i := range variable {
+		variable[i] = true
+	}
+	return splitParts(data, variable, nil)
+}
+
+// splitFields splits the SSZ serialization of a container of the given type
+// into the serializations of its fields.
+func splitFields(typ schema.SSZType, data []byte) ([][]byte, error) {
+	count := typ.(lengthed).Length()
+	variable := make([]bool, count)
+	sizes := make([]uint64, count)
+	for i := range count {
+		var fixed bool
+		sizes[i], fixed = schema.FixedSize(schema.ChunkType(typ, i))
+		variable[i] = !fixed
+	}
+	return splitParts(data, variable, sizes)
+}
+
+// splitParts splits an SSZ serialization into its parts, given which parts
+// are variable-size and the sizes of the fixed-size parts. The fixed part of
+// the serialization holds the fixed-size parts and the offsets of the
+// variable-size parts, which follow in order.
+func splitParts(
+	data []byte,
+	variable []bool,
+	sizes []uint64,
+) ([][]byte, error) {
+	var (
+		parts   = make([][]byte, len(variable))
+		offsets = make([]uint64, 0, len(variable))
+		pos     uint64
+	)
+	for i, isVariable := range variable {
+		size := uint64(bytesPerOffset)
+		if !isVariable {
+			size = sizes[i]
+		}
+		if pos+size > uint64(len(data)) {
+			return nil, errors.Wrapf(ErrUnexpectedSize, "size: %d", len(data))
+		}
+		if isVariable {
+			offsets = append(offsets, uint64(
+				binary.LittleEndian.Uint32(data[pos:]),
+			))
+		} else {
+			parts[i] = data[pos : pos+size]
+		}
+		pos += size
+	}
+
+	if len(offsets) == 0 {
+		if pos != uint64(len(data)) {
+			return nil, errors.Wrapf(ErrUnexpectedSize, "size: %d", len(data))
+		}
+		return parts, nil
+	}
+	if offsets[0] != pos {
+		return nil, errors.Wrapf(ErrInvalidOffset, "offset: %d", offsets[0])
+	}
+	offsets = append(offsets, uint64(len(data)))
+
+	next := 0
+	for i, isVariable := range variable {
+		if !isVariable {
+			continue
+		}
+		start, end := offsets[next], offsets[next+1]
+		if start > end {
+			return nil, errors.Wrapf(ErrInvalidOffset, "offset: %d", start)
+		}
+		parts[i] = data[start:end]
+		next++
+	}
+	return parts, nil
+}
+
+// joinParts returns the SSZ serialization of the given parts, given which
+// parts are variable-size.
+func joinParts(parts [][]byte, isVariable func(int) bool) []byte {
+	var fixedSize, size int
+	for i, part := range parts {
+		if isVariable(i) {
+			fixedSize += bytesPerOffset
+		} else {
+			fixedSize += len(part)
+		}
+		size += len(part)
+	}
+
+	data := make([]byte, 0, fixedSize+size)
+	offset := fixedSize
+	for i, part := range parts {
+		if isVariable(i) {
+			//#nosec:G115 // offsets of valid ssz values fit in 32 bits.
+			data = binary.LittleEndian.AppendUint32(data, uint32(offset))
+			offset += len(part)
+		} else {
+			data = append(data, part...)
+		}
+	}
+	for i, part := range parts {
+		if isVariable(i) {
+			data = append(data, part...)
+		}
+	}
+	return data
+}
+
+// chunkCount returns the number of chunks needed to pack the given number of
+// bytes.
+func chunkCount(size uint64) uint64 {
+	return (size + constants.BytesPerChunk - 1) / constants.BytesPerChunk
+}
@@ -0,0 +1,306 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"container/heap"
+	"math/bits"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math/pow"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// NodeStore persists the nodes of a Tree by generalized index.
+type NodeStore[GIndexT ~uint64, RootT ~[32]byte] interface {
+	// Get returns the node at the given generalized index and whether it has
+	// been set.
+	Get(gIndex GIndexT) (RootT, bool, error)
+	// Set stores the node at the given generalized index.
+	Set(gIndex GIndexT, node RootT) error
+}
+
+// Tree is an SSZ Merkle tree of a value of the given schema, stored node by
+// node by generalized index. Nodes that have never been set are the nodes of
+// the default value, so only the populated branches of the tree are stored.
+type Tree[GIndexT ~uint64, RootT ~[32]byte] struct {
+	// root is the schema of the value at the root of the tree.
+	root schema.SSZType
+	// store persists the nodes of the tree.
+	store NodeStore[GIndexT, RootT]
+}
+
+// NewTree returns a tree of a value of the given schema, backed by the given
+// node store.
+func NewTree[GIndexT ~uint64, RootT ~[32]byte](
+	root schema.SSZType,
+	store NodeStore[GIndexT, RootT],
+) *Tree[GIndexT, RootT] {
+	return &Tree[GIndexT, RootT]{root: root, store: store}
+}
+
+// HashTreeRoot returns the root of the tree.
+func (t *Tree[GIndexT, RootT]) HashTreeRoot() (RootT, error) {
+	return t.Get(1)
+}
+
+// Get returns the node at the given generalized index.
+func (t *Tree[GIndexT, RootT]) Get(gIndex GIndexT) (RootT, error) {
+	node, ok, err := t.store.Get(gIndex)
+	if err != nil || ok {
+		return node, err
+	}
+	return defaultNode[RootT](t.root, uint64(gIndex))
+}
+
+// Set stores the given nodes and rehashes their ancestors up to the root of
+// the tree. Only the branches of the given nodes are rehashed. The parent of a
+// given node is rehashed unless it is given too, and every ancestor of a
+// rehashed node is rehashed, given or not. A given node that is an ancestor of
+// other given nodes is thus only kept as is if all the nodes between them are
+// given as well, as they are for the subtrees merkleized by SetSSZ.
+func (t *Tree[GIndexT, RootT]) Set(nodes map[GIndexT]RootT) error {
+	dirty := make(gIndexHeap, 0, len(nodes))
+	queued := make(map[GIndexT]struct{}, len(nodes))
+	for gIndex, node := range nodes {
+		if gIndex == 0 {
+			return errors.Wrapf(
+				ErrInvalidGeneralizedIndex, "index: %d", gIndex,
+			)
+		}
+		if err := t.store.Set(gIndex, node); err != nil {
+			return err
+		}
+		if parent := gIndex / 2; parent > 0 {
+			if _, ok := nodes[parent]; !ok {
+				if _, ok = queued[parent]; !ok {
+					queued[parent] = struct{}{}
+					dirty = append(dirty, uint64(parent))
+				}
+			}
+		}
+	}
+
+	// Descendants have greater generalized indices than their ancestors, so
+	// rehashing in decreasing order of generalized index rehashes each node
+	// after all of its dirty descendants.
+	heap.Init(&dirty)
+	for dirty.Len() > 0 {
+		//nolint:errcheck // the heap only holds uint64s.
+		gIndex := GIndexT(heap.Pop(&dirty).(uint64))
+		left, err := t.Get(2 * gIndex)
+		if err != nil {
+			return err
+		}
+		right, err := t.Get(2*gIndex + 1)
+		if err != nil {
+			return err
+		}
+		if err = t.store.Set(gIndex, hashPair(left, right)); err != nil {
+			return err
+		}
+		if parent := gIndex / 2; parent > 0 {
+			if _, ok := queued[parent]; !ok {
+				queued[parent] = struct{}{}
+				heap.Push(&dirty, uint64(parent))
+			}
+		}
+	}
+	return nil
+}
+
+// Proof is a Merkle multiproof of nodes of a tree.
+type Proof[RootT ~[32]byte] struct {
+	// Root is the root of the tree.
+	Root RootT
+	// GeneralizedIndices are the generalized indices of the proven nodes.
+	GeneralizedIndices merkle.GeneralizedIndices
+	// Leaves are the proven nodes.
+	Leaves []RootT
+	// Proof are the helper nodes of the multiproof.
+	Proof []RootT
+}
+
+// Prove returns a Merkle multiproof of the nodes at the given object paths,
+// built from the stored nodes of the tree.
+func (t *Tree[GIndexT, RootT]) Prove(
+	paths ...merkle.ObjectPath[GIndexT, RootT],
+) (*Proof[RootT], error) {
+	var err error
+	proof := &Proof[RootT]{
+		GeneralizedIndices: make(merkle.GeneralizedIndices, len(paths)),
+		Leaves:             make([]RootT, len(paths)),
+	}
+	for i, path := range paths {
+		var node Node[GIndexT, RootT]
+		if node, err = NewTreeNode(t.root, path); err != nil {
+			return nil, err
+		}
+		proof.GeneralizedIndices[i] = merkle.GeneralizedIndex(node.GIndex())
+		if proof.Leaves[i], err = t.Get(node.GIndex()); err != nil {
+			return nil, err
+		}
+	}
+
+	if proof.Root, err = t.HashTreeRoot(); err != nil {
+		return nil, err
+	}
+	proof.Proof, err = merkle.BuildMultiproof(
+		proof.GeneralizedIndices,
+		func(gIndex merkle.GeneralizedIndex) (RootT, error) {
+			return t.Get(GIndexT(gIndex))
+		},
+	)
+	return proof, err
+}
+
+// defaultNode returns the node at the given generalized index of the tree of
+// the default value of the given type. Nodes of a tree that have never been
+// set are the nodes of the default value.
+func defaultNode[RootT ~[32]byte](
+	typ schema.SSZType,
+	gIndex uint64,
+) (RootT, error) {
+	if gIndex == 0 {
+		return RootT{}, errors.Wrapf(
+			ErrInvalidGeneralizedIndex, "index: %d", gIndex,
+		)
+	}
+
+	// remaining is the number of levels of the path left to descend.
+	//#nosec:G701 // bits.Len64 is at most 64.
+	remaining := uint8(bits.Len64(gIndex) - 1)
+	for remaining > 0 {
+		if typ.ID().IsBasic() {
+			return RootT{}, errors.Wrapf(
+				ErrInvalidGeneralizedIndex, "index: %d", gIndex,
+			)
+		}
+
+		// Lists mix in their length on the right of their data, and are empty
+		// by default.
+		depth := chunkDepth(typ)
+		if typ.ID().IsList() {
+			remaining--
+			if gIndex>>remaining&1 == 1 {
+				if remaining > 0 {
+					return RootT{}, errors.Wrapf(
+						ErrInvalidGeneralizedIndex, "index: %d", gIndex,
+					)
+				}
+				return RootT{}, nil
+			}
+			if remaining <= depth {
+				// Elements beyond the length of a list are zero chunks.
+				return zero.Hashes[depth-remaining], nil
+			}
+		} else if remaining < depth {
+			return defaultSubtree[RootT](
+				typ,
+				depth-remaining,
+				gIndex&(1<<remaining-1),
+			), nil
+		}
+		remaining -= depth
+
+		chunk := (gIndex >> remaining) & (1<<depth - 1)
+		next := schema.ChunkType(typ, chunk)
+		if next == nil {
+			// Packed basic values and padding are leaves.
+			if remaining > 0 {
+				return RootT{}, errors.Wrapf(
+					ErrInvalidGeneralizedIndex, "index: %d", gIndex,
+				)
+			}
+			return RootT{}, nil
+		}
+		typ = next
+	}
+	return defaultRoot[RootT](typ), nil
+}
+
+// defaultRoot returns the hash tree root of the default value of the given
+// type.
+func defaultRoot[RootT ~[32]byte](typ schema.SSZType) RootT {
+	switch {
+	case typ.ID().IsBasic():
+		return RootT{}
+	case typ.ID().IsList():
+		return hashPair(RootT(zero.Hashes[chunkDepth(typ)]), RootT{})
+	default:
+		return defaultSubtree[RootT](typ, chunkDepth(typ), 0)
+	}
+}
+
+// defaultSubtree returns the root of the subtree of the given height at the
+// given index of its layer in the chunk tree of the default value of the
+// given vector or container type.
+func defaultSubtree[RootT ~[32]byte](
+	typ schema.SSZType,
+	height uint8,
+	index uint64,
+) RootT {
+	// Subtrees of packed basic values and padding are zero.
+	if schema.ChunkType(typ, index<<height) == nil {
+		return zero.Hashes[height]
+	}
+	if height == 0 {
+		return defaultRoot[RootT](schema.ChunkType(typ, index))
+	}
+	return hashPair(
+		defaultSubtree[RootT](typ, height-1, 2*index),
+		defaultSubtree[RootT](typ, height-1, 2*index+1),
+	)
+}
+
+// chunkDepth returns the depth of the tree of the chunks of the given type,
+// not including the length mix in of lists.
+func chunkDepth(typ schema.SSZType) uint8 {
+	return log.ILog2Ceil(pow.NextPowerOfTwo(typ.HashChunkCount()))
+}
+
+// hashPair returns the hash of the concatenation of two nodes.
+func hashPair[RootT ~[32]byte](left, right RootT) RootT {
+	return sha256.Hash(append(left[:], right[:]...))
+}
+
+// gIndexHeap is a max-heap of generalized indices.
+type gIndexHeap []uint64
+
+func (h gIndexHeap) Len() int           { return len(h) }
+func (h gIndexHeap) Less(i, j int) bool { return h[i] > h[j] }
+func (h gIndexHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *gIndexHeap) Push(x any) {
+	//nolint:errcheck // the heap only holds uint64s.
+	*h = append(*h, x.(uint64))
+}
+
+func (h *gIndexHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,413 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/db"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/stretchr/testify/require"
+)
+
+type testPath = merkle.ObjectPath[uint64, [32]byte]
+
+// memNodeStore is an in-memory node store.
+type memNodeStore map[uint64][32]byte
+
+func (s memNodeStore) Get(gIndex uint64) ([32]byte, bool, error) {
+	node, ok := s[gIndex]
+	return node, ok, nil
+}
+
+func (s memNodeStore) Set(gIndex uint64, node [32]byte) error {
+	s[gIndex] = node
+	return nil
+}
+
+var (
+	testNestedType = schema.DefineContainer(
+		schema.NewField("uint64", schema.U64()),
+		schema.NewField("bytes48", schema.B48()),
+	)
+	testTreeType = schema.DefineContainer(
+		schema.NewField("uint64", schema.U64()),
+		schema.NewField("bytes32", schema.B32()),
+		schema.NewField("list_uint64", schema.DefineList(schema.U64(), 10)),
+		schema.NewField("list_nested", schema.DefineList(testNestedType, 4)),
+		schema.NewField("byte_list", schema.DefineByteList(40)),
+	)
+)
+
+// testValue is a value of testTreeType.
+type testValue struct {
+	uint64     uint64
+	bytes32    [32]byte
+	listUint64 []uint64
+	listNested []testNested
+	byteList   []byte
+}
+
+type testNested struct {
+	uint64  uint64
+	bytes48 [48]byte
+}
+
+func (n testNested) marshal() []byte {
+	return append(binary.LittleEndian.AppendUint64(nil, n.uint64), n.bytes48[:]...)
+}
+
+func (n testNested) root() [32]byte {
+	var pubkey [2][32]byte
+	copy(pubkey[0][:], n.bytes48[:32])
+	copy(pubkey[1][:], n.bytes48[32:])
+	return merkleize([][32]byte{
+		uint64Chunk(n.uint64), merkleize(pubkey[:], 2),
+	}, 2)
+}
+
+// marshal returns the SSZ serialization of the value.
+func (v testValue) marshal() []byte {
+	data := binary.LittleEndian.AppendUint64(nil, v.uint64)
+	data = append(data, v.bytes32[:]...)
+
+	fixedSize := uint32(len(data)) + 3*4
+	listUint64 := make([]byte, 0, len(v.listUint64)*8)
+	for _, u := range v.listUint64 {
+		listUint64 = binary.LittleEndian.AppendUint64(listUint64, u)
+	}
+	listNested := make([]byte, 0, len(v.listNested)*56)
+	for _, n := range v.listNested {
+		listNested = append(listNested, n.marshal()...)
+	}
+
+	data = binary.LittleEndian.AppendUint32(data, fixedSize)
+	data = binary.LittleEndian.AppendUint32(
+		data, fixedSize+uint32(len(listUint64)),
+	)
+	data = binary.LittleEndian.AppendUint32(
+		data, fixedSize+uint32(len(listUint64)+len(listNested)),
+	)
+	data = append(data, listUint64...)
+	data = append(data, listNested...)
+	return append(data, v.byteList...)
+}
+
+// root returns the hash tree root of the value.
+func (v testValue) root() [32]byte {
+	listUint64 := make([][32]byte, (len(v.listUint64)+3)/4)
+	for i, u := range v.listUint64 {
+		binary.LittleEndian.PutUint64(listUint64[i/4][i%4*8:], u)
+	}
+	listNested := make([][32]byte, len(v.listNested))
+	for i, n := range v.listNested {
+		listNested[i] = n.root()
+	}
+	byteList := make([][32]byte, (len(v.byteList)+31)/32)
+	for i := range byteList {
+		copy(byteList[i][:], v.byteList[i*32:])
+	}
+
+	return merkleize([][32]byte{
+		uint64Chunk(v.uint64),
+		v.bytes32,
+		mixInLength(merkleize(listUint64, 4), len(v.listUint64)),
+		mixInLength(merkleize(listNested, 4), len(v.listNested)),
+		mixInLength(merkleize(byteList, 2), len(v.byteList)),
+	}, 8)
+}
+
+func uint64Chunk(u uint64) [32]byte {
+	var chunk [32]byte
+	binary.LittleEndian.PutUint64(chunk[:], u)
+	return chunk
+}
+
+// merkleize returns the root of the given chunks padded with zero chunks to
+// the given power of two.
+func merkleize(chunks [][32]byte, limit int) [32]byte {
+	layer := make([][32]byte, limit)
+	copy(layer, chunks)
+	for len(layer) > 1 {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = sha256.Hash(append(layer[2*i][:], layer[2*i+1][:]...))
+		}
+		layer = next
+	}
+	return layer[0]
+}
+
+func mixInLength(root [32]byte, length int) [32]byte {
+	chunk := uint64Chunk(uint64(length))
+	return sha256.Hash(append(root[:], chunk[:]...))
+}
+
+func newTestValue() testValue {
+	return testValue{
+		uint64:     7,
+		bytes32:    [32]byte{1, 2, 3},
+		listUint64: []uint64{1, 2, 3, 4, 5},
+		listNested: []testNested{
+			{uint64: 1, bytes48: [48]byte{1}},
+			{uint64: 2, bytes48: [48]byte{47: 2}},
+		},
+		byteList: []byte("a byte list spanning more than one chunk"),
+	}
+}
+
+func TestTree_EmptyRoot(t *testing.T) {
+	tree := db.NewTree[uint64, [32]byte](testTreeType, memNodeStore{})
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, testValue{}.root(), root)
+
+	data, err := tree.GetSSZ("")
+	require.Error(t, err)
+	require.Nil(t, data)
+
+	data, err = tree.GetSSZ("list_nested")
+	require.NoError(t, err)
+	require.Empty(t, data)
+}
+
+func TestTree_SetSSZ(t *testing.T) {
+	tree := db.NewTree[uint64, [32]byte](testTreeType, memNodeStore{})
+	value := newTestValue()
+
+	// Set the fields of the value one by one.
+	data := value.marshal()
+	require.NoError(t, tree.SetSSZ("uint64", data[:8]))
+	require.NoError(t, tree.SetSSZ("bytes32", value.bytes32[:]))
+	for i, u := range value.listUint64 {
+		require.NoError(t, tree.SetSSZ(
+			testPath("list_uint64/"+string(rune('0'+i))),
+			binary.LittleEndian.AppendUint64(nil, u),
+		))
+	}
+	require.NoError(t, tree.SetSSZ(
+		"list_uint64/__len__",
+		binary.LittleEndian.AppendUint64(nil, uint64(len(value.listUint64))),
+	))
+	require.NoError(t, tree.SetSSZ(
+		"list_nested",
+		append(value.listNested[0].marshal(), value.listNested[1].marshal()...),
+	))
+	require.NoError(t, tree.SetSSZ("byte_list", value.byteList))
+
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, value.root(), root)
+
+	got, err := tree.GetSSZ("list_uint64/3")
+	require.NoError(t, err)
+	require.Equal(t, binary.LittleEndian.AppendUint64(nil, 4), got)
+
+	got, err = tree.GetSSZ("list_nested/1/bytes48")
+	require.NoError(t, err)
+	require.Equal(t, value.listNested[1].bytes48[:], got)
+
+	got, err = tree.GetSSZ("list_nested/1")
+	require.NoError(t, err)
+	require.Equal(t, value.listNested[1].marshal(), got)
+
+	got, err = tree.GetSSZ("byte_list")
+	require.NoError(t, err)
+	require.Equal(t, value.byteList, got)
+}
+
+func TestTree_SetSSZRoot(t *testing.T) {
+	tree := db.NewTree[uint64, [32]byte](testTreeType, memNodeStore{})
+	value := newTestValue()
+
+	// Set the whole value at once and read it back.
+	setRoot(t, tree, value)
+	requireFields(t, tree, value)
+
+	// Shrinking lists must clear their previous elements.
+	value.listUint64 = value.listUint64[:1]
+	value.listNested = value.listNested[:1]
+	value.byteList = value.byteList[:3]
+	setRoot(t, tree, value)
+	requireFields(t, tree, value)
+
+	// Updating a single element only rehashes its branch.
+	value.listNested[0].uint64 = 42
+	require.NoError(t, tree.SetSSZ(
+		"list_nested/0/uint64", binary.LittleEndian.AppendUint64(nil, 42),
+	))
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, value.root(), root)
+}
+
+func TestTree_SetSSZErrors(t *testing.T) {
+	tree := db.NewTree[uint64, [32]byte](testTreeType, memNodeStore{})
+
+	cases := []struct {
+		name    string
+		path    testPath
+		data    []byte
+		wantErr error
+	}{
+		{
+			name:    "basic size",
+			path:    "uint64",
+			data:    []byte{1},
+			wantErr: db.ErrUnexpectedSize,
+		},
+		{
+			name:    "vector size",
+			path:    "bytes32",
+			data:    make([]byte, 31),
+			wantErr: db.ErrUnexpectedSize,
+		},
+		{
+			name:    "list element size",
+			path:    "list_uint64",
+			data:    make([]byte, 12),
+			wantErr: db.ErrUnexpectedSize,
+		},
+		{
+			name:    "list limit",
+			path:    "list_uint64",
+			data:    make([]byte, 11*8),
+			wantErr: db.ErrLimitExceeded,
+		},
+		{
+			name:    "container offset",
+			path:    "list_nested/0",
+			data:    make([]byte, 55),
+			wantErr: db.ErrUnexpectedSize,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorIs(t, tree.SetSSZ(tc.path, tc.data), tc.wantErr)
+		})
+	}
+
+	// Paths must exist in the schema.
+	require.Error(t, tree.SetSSZ("list_nested/4", make([]byte, 56)))
+	require.Error(t, tree.SetSSZ("nonexistent", nil))
+}
+
+func TestTree_SetAncestors(t *testing.T) {
+	tree := db.NewTree[uint64, [32]byte](testTreeType, memNodeStore{})
+	a, b, x := [32]byte{1}, [32]byte{2}, [32]byte{3}
+
+	// Both children of node 2 are given, so node 2 is kept as is.
+	require.NoError(t, tree.Set(map[uint64][32]byte{4: a, 5: b, 2: x}))
+	node, err := tree.Get(2)
+	require.NoError(t, err)
+	require.Equal(t, x, node)
+	right, err := tree.Get(3)
+	require.NoError(t, err)
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, sha256.Hash(append(x[:], right[:]...)), root)
+
+	// Node 4 is rehashed from the given node 8, and so is node 2 from it.
+	require.NoError(t, tree.Set(map[uint64][32]byte{8: a, 2: x}))
+	left, err := tree.Get(4)
+	require.NoError(t, err)
+	leaf, err := tree.Get(9)
+	require.NoError(t, err)
+	require.Equal(t, sha256.Hash(append(a[:], leaf[:]...)), left)
+	node, err = tree.Get(2)
+	require.NoError(t, err)
+	require.Equal(t, sha256.Hash(append(left[:], b[:]...)), node)
+}
+
+func TestTree_Prove(t *testing.T) {
+	tree := db.NewTree[uint64, [32]byte](testTreeType, memNodeStore{})
+	value := newTestValue()
+	setRoot(t, tree, value)
+
+	proof, err := tree.Prove(
+		"uint64", "list_nested/1/bytes48", "list_uint64/__len__",
+	)
+	require.NoError(t, err)
+	require.Equal(t, value.root(), proof.Root)
+	require.Equal(t, uint64Chunk(value.uint64), proof.Leaves[0])
+	require.Equal(t, uint64Chunk(5), proof.Leaves[2])
+	require.True(t, merkle.VerifyMultiproof(
+		proof.GeneralizedIndices, proof.Leaves, proof.Proof, proof.Root,
+	))
+
+	// Unset nodes are proven as zero subtrees.
+	proof, err = tree.Prove("list_nested/3")
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{}, proof.Leaves[0])
+	require.True(t, merkle.VerifyMultiproof(
+		proof.GeneralizedIndices, proof.Leaves, proof.Proof, proof.Root,
+	))
+}
+
+// setRoot sets the whole value of the tree. The root of the tree has no
+// object path, so its fields are set one by one from the serialization.
+func setRoot(t *testing.T, tree *db.Tree[uint64, [32]byte], v testValue) {
+	t.Helper()
+	listUint64 := make([]byte, 0, len(v.listUint64)*8)
+	for _, u := range v.listUint64 {
+		listUint64 = binary.LittleEndian.AppendUint64(listUint64, u)
+	}
+	listNested := make([]byte, 0, len(v.listNested)*56)
+	for _, n := range v.listNested {
+		listNested = append(listNested, n.marshal()...)
+	}
+	require.NoError(t, tree.SetSSZ(
+		"uint64", binary.LittleEndian.AppendUint64(nil, v.uint64),
+	))
+	require.NoError(t, tree.SetSSZ("bytes32", v.bytes32[:]))
+	require.NoError(t, tree.SetSSZ("list_uint64", listUint64))
+	require.NoError(t, tree.SetSSZ("list_nested", listNested))
+	require.NoError(t, tree.SetSSZ("byte_list", v.byteList))
+
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, v.root(), root)
+}
+
+// requireFields requires the fields of the tree to be serialized as the
+// fields of the given value.
+func requireFields(
+	t *testing.T,
+	tree *db.Tree[uint64, [32]byte],
+	v testValue,
+) {
+	t.Helper()
+	data := v.marshal()
+	for path, want := range map[testPath][]byte{
+		"uint64":      data[:8],
+		"bytes32":     data[8:40],
+		"list_uint64": data[52 : 52+8*len(v.listUint64)],
+		"list_nested": data[52+8*len(v.listUint64) : len(data)-len(v.byteList)],
+		"byte_list":   v.byteList,
+	} {
+		got, err := tree.GetSSZ(path)
+		require.NoError(t, err)
+		require.Equal(t, want, got, path)
+	}
+}
@@ -30,3 +30,11 @@ var ErrUnexpectedProofLength = errors.New("unexpected proof length")
 var ErrMismatchLeavesIndicesLength = errors.New(
 	"mismatched leaves and indices length",
 )
+
+// ErrNoGeneralizedIndices is returned when a multiproof is requested for no
+// generalized indices.
+var ErrNoGeneralizedIndices = errors.New("no generalized indices to prove")
+
+// ErrInvalidGeneralizedIndex is returned when a generalized index does not
+// refer to a node of the tree.
+var ErrInvalidGeneralizedIndex = errors.New("invalid generalized index")
@@ -69,6 +69,9 @@ func Test_ObjectPath(t *testing.T) {
 
 		// error cases
 		{path: "nested/__len__", error: "__len__ is only valid"},
+		{path: "list_nested/1000", error: "out of bounds"},
+		{path: "vector_uint64/40", error: "out of bounds"},
+		{path: "nested/unknown", error: "not found"},
 	}
 	for _, tc := range cases {
 		t.Run(strings.ReplaceAll(tc.path, "/", "."), func(t *testing.T) {
@@ -37,6 +37,71 @@ func BuildProofFromLeaves[RootT ~[32]byte](
 	return buildSingleProofFromTree(tree, NewGeneralizedIndex(depth, index))
 }
 
+// BuildMultiproofFromLeaves builds a Merkle multiproof from the given leaves
+// and the indices of the leaves to prove. The leaves are assumed to be hashed
+// into 32 byte roots. Returns the proof along with the generalized indices of
+// the proven leaves, as expected by VerifyMultiproof.
+func BuildMultiproofFromLeaves[RootT ~[32]byte](
+	leaves []RootT,
+	indices []uint64,
+) ([]RootT, GeneralizedIndices, error) {
+	tree, depth := newTree(leaves)
+	gIndices := make(GeneralizedIndices, len(indices))
+	for i, index := range indices {
+		if index >= uint64(len(leaves)) {
+			return nil, nil, errors.Wrapf(
+				ErrInvalidGeneralizedIndex,
+				"leaf index: %d, number of leaves: %d", index, len(leaves),
+			)
+		}
+		gIndices[i] = NewGeneralizedIndex(depth, index)
+	}
+
+	proof, err := buildMultiproofFromTree(tree, gIndices)
+	if err != nil {
+		return nil, nil, err
+	}
+	return proof, gIndices, nil
+}
+
+// BuildMultiproof returns a Merkle multiproof of the nodes at the given
+// generalized indices, using the given function to get the nodes of the tree
+// by generalized index. The proof is the minimal set of helper nodes, in the
+// decreasing order of their generalized indices, as expected by
+// VerifyMultiproof. For a single generalized index, this is a regular Merkle
+// proof, as expected by VerifyProof.
+//
+// As defined in the Ethereum 2.0 Spec:
+// https://github.com/ethereum/consensus-specs/blob/dev/ssz/merkle-proofs.md#merkle-multiproofs
+//
+//nolint:lll // link.
+func BuildMultiproof[RootT ~[32]byte](
+	indices GeneralizedIndices,
+	getNode func(GeneralizedIndex) (RootT, error),
+) ([]RootT, error) {
+	if len(indices) == 0 {
+		return nil, ErrNoGeneralizedIndices
+	}
+	for _, index := range indices {
+		if index == 0 {
+			return nil, errors.Wrapf(
+				ErrInvalidGeneralizedIndex, "index: %d", index,
+			)
+		}
+	}
+
+	helperIndices := indices.GetHelperIndices()
+	proof := make([]RootT, len(helperIndices))
+	for i, helperIndex := range helperIndices {
+		node, err := getNode(helperIndex)
+		if err != nil {
+			return nil, err
+		}
+		proof[i] = node
+	}
+	return proof, nil
+}
+
 // newTree returns a Merkle tree of the given leaves. Returns an array
 // representing the tree nodes by generalized index: [0, 1, 2, 3, 4, 5, 6, 7],
 // where each layer is a power of 2. The 0 index is ignored. The 1 index is the
@@ -106,3 +171,35 @@ func buildSingleProofFromTree[RootT ~[32]byte](
 	}
 	return proof, nil
 }
+
+// buildMultiproofFromTree returns a Merkle multiproof of the given tree for
+// the nodes at the given generalized indices. Tree nodes are assumed to be
+// ordered by generalized index.
+func buildMultiproofFromTree[RootT ~[32]byte](
+	tree []RootT,
+	indices GeneralizedIndices,
+) ([]RootT, error) {
+	//#nosec:G701 // len(tree) cannot be greater than max uint64.
+	treeLen := GeneralizedIndex(len(tree))
+	if pow.PrevPowerOfTwo(treeLen) != treeLen {
+		return nil, errors.Wrapf(
+			errors.New("invalid tree length"),
+			"tree length: %d, must be power of 2", treeLen,
+		)
+	}
+	for _, index := range indices {
+		if index >= treeLen {
+			return nil, errors.Wrapf(
+				ErrInvalidGeneralizedIndex,
+				"index: %d, tree length: %d", index, treeLen,
+			)
+		}
+	}
+
+	return BuildMultiproof(
+		indices,
+		func(index GeneralizedIndex) (RootT, error) {
+			return tree[index], nil
+		},
+	)
+}
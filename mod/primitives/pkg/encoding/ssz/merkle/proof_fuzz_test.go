@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// maxFuzzLeaves is the maximum number of leaves of the fuzzed trees.
+const maxFuzzLeaves = 300
+
+func FuzzBuildMultiproofFromLeaves(f *testing.F) {
+	f.Add(uint16(1), []byte{0})
+	f.Add(uint16(7), []byte{2, 3})
+	f.Add(uint16(64), []byte{0, 63, 17, 18, 40})
+	f.Add(uint16(maxFuzzLeaves), []byte{255, 1, 128, 7})
+
+	f.Fuzz(func(t *testing.T, numLeaves uint16, rawIndices []byte) {
+		numLeaves = numLeaves%maxFuzzLeaves + 1
+		leaves := newTestLeaves(int(numLeaves))
+		root := rootOfLeaves(t, leaves)
+
+		// Prove a non-empty set of distinct leaves.
+		indices := make([]uint64, 0, len(rawIndices))
+		for _, raw := range rawIndices {
+			index := uint64(raw) % uint64(numLeaves)
+			if !slices.Contains(indices, index) {
+				indices = append(indices, index)
+			}
+		}
+		if len(indices) == 0 {
+			indices = append(indices, 0)
+		}
+
+		proof, gIndices, err := merkle.BuildMultiproofFromLeaves(
+			leaves, indices,
+		)
+		require.NoError(t, err)
+		require.Len(t, proof, len(gIndices.GetHelperIndices()))
+
+		proven := make([][32]byte, len(indices))
+		for i, index := range indices {
+			proven[i] = leaves[index]
+		}
+		require.True(t, merkle.VerifyMultiproof(gIndices, proven, proof, root))
+
+		// Tampering with a proven leaf or a helper node fails verification.
+		tampered := slices.Clone(proven)
+		tampered[0][0] ^= 1
+		require.False(
+			t, merkle.VerifyMultiproof(gIndices, tampered, proof, root),
+		)
+		if len(proof) > 0 {
+			tamperedProof := slices.Clone(proof)
+			tamperedProof[len(proof)-1][31] ^= 1
+			require.False(t, merkle.VerifyMultiproof(
+				gIndices, proven, tamperedProof, root,
+			))
+		}
+	})
+}
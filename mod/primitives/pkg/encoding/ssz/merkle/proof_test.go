@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLeaves returns the given number of distinct leaves.
+func newTestLeaves(n int) [][32]byte {
+	leaves := make([][32]byte, n)
+	for i := range leaves {
+		leaves[i] = sha256.Hash([]byte{byte(i), byte(i >> 8)})
+	}
+	return leaves
+}
+
+// rootOfLeaves returns the Merkle root of the given leaves.
+func rootOfLeaves(t *testing.T, leaves [][32]byte) [32]byte {
+	t.Helper()
+	proof, gIndices, err := merkle.BuildMultiproofFromLeaves(
+		leaves, []uint64{0},
+	)
+	require.NoError(t, err)
+	root, err := merkle.CalculateRoot(gIndices[0], leaves[0], proof)
+	require.NoError(t, err)
+	return root
+}
+
+func TestBuildMultiproofFromLeaves(t *testing.T) {
+	leaves := newTestLeaves(7)
+	root := rootOfLeaves(t, leaves)
+
+	tests := []struct {
+		name          string
+		indices       []uint64
+		expectedProof int
+	}{
+		{name: "single leaf", indices: []uint64{5}, expectedProof: 3},
+		{name: "siblings", indices: []uint64{2, 3}, expectedProof: 2},
+		// The tree is padded to 8 leaves.
+		{
+			name:          "all leaves",
+			indices:       []uint64{0, 1, 2, 3, 4, 5, 6},
+			expectedProof: 1,
+		},
+		{name: "unordered", indices: []uint64{6, 0, 3}, expectedProof: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof, gIndices, err := merkle.BuildMultiproofFromLeaves(
+				leaves, tt.indices,
+			)
+			require.NoError(t, err)
+			require.Len(t, proof, tt.expectedProof)
+
+			proven := make([][32]byte, len(tt.indices))
+			for i, index := range tt.indices {
+				proven[i] = leaves[index]
+			}
+			require.True(
+				t, merkle.VerifyMultiproof(gIndices, proven, proof, root),
+			)
+		})
+	}
+}
+
+func TestBuildMultiproofMatchesSingleProof(t *testing.T) {
+	leaves := newTestLeaves(13)
+	for index := range uint64(len(leaves)) {
+		single, err := merkle.BuildProofFromLeaves(leaves, index)
+		require.NoError(t, err)
+		multi, _, err := merkle.BuildMultiproofFromLeaves(
+			leaves, []uint64{index},
+		)
+		require.NoError(t, err)
+		require.Equal(t, single, multi)
+	}
+}
+
+func TestBuildMultiproofErrors(t *testing.T) {
+	leaves := newTestLeaves(4)
+
+	_, _, err := merkle.BuildMultiproofFromLeaves(leaves, nil)
+	require.ErrorIs(t, err, merkle.ErrNoGeneralizedIndices)
+
+	_, _, err = merkle.BuildMultiproofFromLeaves(leaves, []uint64{4})
+	require.ErrorIs(t, err, merkle.ErrInvalidGeneralizedIndex)
+
+	_, err = merkle.BuildMultiproof(
+		merkle.GeneralizedIndices{0},
+		func(merkle.GeneralizedIndex) ([32]byte, error) {
+			return [32]byte{}, nil
+		},
+	)
+	require.ErrorIs(t, err, merkle.ErrInvalidGeneralizedIndex)
+}
@@ -80,6 +80,11 @@ func (v vector) ItemPosition(p string) (uint64, uint8, uint8, error) {
 	if err != nil {
 		return 0, 0, 0, fmt.Errorf("expected index, got name %s", p)
 	}
+	if i >= v.length {
+		return 0, 0, 0, fmt.Errorf(
+			"index %d out of bounds for length %d", i, v.length,
+		)
+	}
 	start := i * v.elementType.ItemLength()
 	return start / constants.BytesPerChunk,
 		//#nosec:G701 // can't overflow.
@@ -146,6 +151,11 @@ func (l list) ItemPosition(p string) (uint64, uint8, uint8, error) {
 	if err != nil {
 		return 0, 0, 0, fmt.Errorf("expected index, got name %s", p)
 	}
+	if i >= l.limit {
+		return 0, 0, 0, fmt.Errorf(
+			"index %d out of bounds for limit %d", i, l.limit,
+		)
+	}
 	start := i * l.elementType.ItemLength()
 	return start / constants.BytesPerChunk,
 		//#nosec:G701 // can't overflow.
@@ -194,3 +204,67 @@ func (c container) ElementType(p string) SSZType {
 func (c container) Length() uint64 { return uint64(len(c.Fields)) }
 
 func (c container) HashChunkCount() uint64 { return uint64(len(c.Fields)) }
+
+/* -------------------------------------------------------------------------- */
+/*                                  Traversal                                 */
+/* -------------------------------------------------------------------------- */
+
+// ChunkType returns the SSZ type whose hash tree root is the chunk at the
+// given index of a composite type. It returns nil if the chunk holds packed
+// basic values or is padding.
+func ChunkType(typ SSZType, index uint64) SSZType {
+	switch t := typ.(type) {
+	case container:
+		if index < t.Length() {
+			return t.Fields[index]
+		}
+	case vector:
+		if !t.elementType.ID().IsBasic() && index < t.length {
+			return t.elementType
+		}
+	case list:
+		if !t.elementType.ID().IsBasic() && index < t.limit {
+			return t.elementType
+		}
+	}
+	return nil
+}
+
+// FixedSize returns the size in bytes of the SSZ serialization of the given
+// type, and whether the size is fixed. Lists and composite types containing
+// them are variable-size.
+func FixedSize(typ SSZType) (uint64, bool) {
+	switch t := typ.(type) {
+	case basic:
+		return t.ItemLength(), true
+	case vector:
+		size, fixed := FixedSize(t.elementType)
+		return t.length * size, fixed
+	case container:
+		var total uint64
+		for _, field := range t.Fields {
+			size, fixed := FixedSize(field)
+			if !fixed {
+				return 0, false
+			}
+			total += size
+		}
+		return total, true
+	default:
+		return 0, false
+	}
+}
+
+// FieldNames returns the names of the fields of a container type, in order.
+// It returns nil for other types.
+func FieldNames(typ SSZType) []string {
+	c, ok := typ.(container)
+	if !ok {
+		return nil
+	}
+	names := make([]string, len(c.Fields))
+	for name, i := range c.FieldIndex {
+		names[i] = name
+	}
+	return names
+}
@@ -45,3 +45,26 @@ func FromUint32[VersionT ~[4]byte](version uint32) VersionT {
 func ToUint32[VersionT ~[4]byte](version VersionT) uint32 {
 	return binary.LittleEndian.Uint32(version[:])
 }
+
+// Name returns the lowercase name of the given fork version, as used by the
+// Beacon API in the `version` field of versioned responses.
+func Name(version uint32) string {
+	switch version {
+	case Phase0:
+		return "phase0"
+	case Altair:
+		return "altair"
+	case Bellatrix:
+		return "bellatrix"
+	case Capella:
+		return "capella"
+	case Deneb:
+		return "deneb"
+	case DenebPlus:
+		return "deneb_plus"
+	case Electra:
+		return "electra"
+	default:
+		return "unknown"
+	}
+}
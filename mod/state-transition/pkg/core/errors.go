@@ -48,6 +48,44 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrDepositCountMismatch is returned when a block does not include the
+	// expected number of deposits.
+	ErrDepositCountMismatch = errors.New("deposit count mismatch")
+
+	// ErrDepositIndexMismatch is returned when a deposit in a block does not
+	// have the expected index.
+	ErrDepositIndexMismatch = errors.New("deposit index mismatch")
+
+	// ErrDepositMismatch is returned when a deposit in a block does not match
+	// the deposit made to the deposit contract.
+	ErrDepositMismatch = errors.New("deposit mismatch")
+
+	// ErrDepositRootMismatch is returned when the deposit root in the eth1
+	// data of a block does not match the deposit tree.
+	ErrDepositRootMismatch = errors.New("deposit root mismatch")
+
+	// ErrDepositCountDecreased is returned when the deposit count in the eth1
+	// data of a block is lower than the one in the state.
+	ErrDepositCountDecreased = errors.New("eth1 deposit count decreased")
+
+	// ErrDepositStoreBehind is returned when the deposit store does not hold
+	// the deposits accounted for by the eth1 data of a block yet, so the
+	// block cannot be verified until the deposit store catches up.
+	ErrDepositStoreBehind = errors.New("deposit store is behind the eth1 data")
+
+	// ErrExceedsBlockVoluntaryExitLimit is returned when the block exceeds
+	// the voluntary exit limit.
+	ErrExceedsBlockVoluntaryExitLimit = errors.New(
+		"block exceeds voluntary exit limit")
+
+	// ErrValidatorAlreadyExited is returned when a voluntary exit is
+	// processed for a validator that has already initiated its exit.
+	ErrValidatorAlreadyExited = errors.New("validator has already exited")
+
+	// ErrVoluntaryExitTooEarly is returned when a voluntary exit is processed
+	// before the epoch it becomes valid at.
+	ErrVoluntaryExitTooEarly = errors.New("voluntary exit is not yet valid")
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -80,4 +118,14 @@ var (
 	// ErrNumWithdrawalsMismatch is returned when the number of withdrawals
 	// in a block does not match the expected value.
 	ErrNumWithdrawalsMismatch = errors.New("number of withdrawals mismatch")
+
+	// ErrNilExecutionRequests is returned when a block from Electra on does
+	// not carry execution requests.
+	ErrNilExecutionRequests = errors.New("nil execution requests")
+
+	// ErrConsolidationRequestsNotSupported is returned when a block carries
+	// consolidation requests, which are not supported.
+	ErrConsolidationRequestsNotSupported = errors.New(
+		"consolidation requests not supported",
+	)
 )
@@ -30,17 +30,22 @@ import (
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	nodemetrics "github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -84,6 +89,21 @@ type (
 	]
 )
 
+// electraChainSpec returns a chain spec with Electra active from genesis.
+func electraChainSpec() chain.Spec[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+] {
+	specData := spec.BaseSpec()
+	specData.DepositEth1ChainID = spec.BetnetEth1ChainID
+	specData.DenebPlusForkEpoch = 0
+	specData.ElectraForkEpoch = 0
+	return chain.NewChainSpec(specData)
+}
+
 func createStateProcessor(
 	cs common.ChainSpec,
 	execEngine core.ExecutionEngine[
@@ -91,6 +111,7 @@ func createStateProcessor(
 		*types.ExecutionPayloadHeader,
 		engineprimitives.Withdrawals,
 	],
+	ds core.DepositStore[*types.Deposit],
 	signer crypto.BLSSigner,
 	fGetAddressFromPubKey func(crypto.BLSPubkey) ([]byte, error),
 ) *core.StateProcessor[
@@ -134,19 +155,22 @@ func createStateProcessor(
 		noop.NewLogger[any](),
 		cs,
 		execEngine,
+		ds,
 		signer,
 		fGetAddressFromPubKey,
+		nodemetrics.NewTelemetrySink(),
 	)
 }
 
 type testKVStoreService struct {
 	ctx sdk.Context
+	key *storetypes.KVStoreKey
 }
 
 func (kvs *testKVStoreService) OpenKVStore(context.Context) corestore.KVStore {
 	//nolint:contextcheck // fine with tests
 	return components.NewKVStore(
-		sdk.UnwrapSDKContext(kvs.ctx).KVStore(testStoreKey),
+		sdk.UnwrapSDKContext(kvs.ctx).KVStore(kvs.key),
 	)
 }
 
@@ -155,6 +179,8 @@ var (
 	testCodec    = &encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{}
 )
 
+var testDepositStoreKey = storetypes.NewKVStoreKey("deposit-store-tests")
+
 func initStore() (
 	*beacondb.KVStore[
 		*types.BeaconBlockHeader,
@@ -164,6 +190,35 @@ func initStore() (
 		*types.Validator,
 		types.Validators,
 	], error) {
+	testStoreService, err := newTestKVStoreService(testStoreKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	](
+		testStoreService,
+		testCodec,
+	), nil
+}
+
+func initDepositStore() (*depositstore.KVStore[*types.Deposit], error) {
+	testStoreService, err := newTestKVStoreService(testDepositStoreKey)
+	if err != nil {
+		return nil, err
+	}
+	return depositstore.NewStore[*types.Deposit](testStoreService), nil
+}
+
+func newTestKVStoreService(
+	key *storetypes.KVStoreKey,
+) (*testKVStoreService, error) {
 	db, err := db.OpenDB("", dbm.MemDBBackend)
 	if err != nil {
 		return nil, fmt.Errorf("failed opening mem db: %w", err)
@@ -180,23 +235,11 @@ func initStore() (
 	)
 
 	ctx := sdk.NewContext(cms, true, nopLog)
-	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
 	if err = cms.LoadLatestVersion(); err != nil {
 		return nil, fmt.Errorf("failed to load latest version: %w", err)
 	}
-	testStoreService := &testKVStoreService{ctx: ctx}
-
-	return beacondb.New[
-		*types.BeaconBlockHeader,
-		*types.Eth1Data,
-		*types.ExecutionPayloadHeader,
-		*types.Fork,
-		*types.Validator,
-		types.Validators,
-	](
-		testStoreService,
-		testCodec,
-	), nil
+	return &testKVStoreService{ctx: ctx, key: key}, nil
 }
 
 func buildNextBlock(
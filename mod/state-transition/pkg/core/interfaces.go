@@ -109,6 +109,7 @@ type WriteOnlyBeaconState[
 	SetNextWithdrawalIndex(uint64) error
 	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
 	SetTotalSlashing(math.Gwei) error
+	Upgrade(forkVersion uint32) error
 }
 
 // WriteOnlyStateRoots defines a struct which only has write access to state
@@ -161,6 +162,7 @@ type ReadOnlyValidators[ValidatorT any] interface {
 type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	SetEth1Data(Eth1DataT) error
 	SetEth1DepositIndex(uint64) error
+	SetDepositRequestsStartIndex(uint64) error
 	SetLatestExecutionPayloadHeader(
 		ExecutionPayloadHeaderT,
 	) error
@@ -170,6 +172,7 @@ type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	GetEth1Data() (Eth1DataT, error)
 	GetEth1DepositIndex() (uint64, error)
+	GetDepositRequestsStartIndex() (uint64, error)
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
 	)
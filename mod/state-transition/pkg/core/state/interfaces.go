@@ -44,6 +44,8 @@ type KVStore[
 	WithContext(ctx context.Context) T
 	// Copy returns a copy of the key-value store.
 	Copy() T
+	// Upgrade upgrades the state to the fork of the given version.
+	Upgrade(forkVersion uint32) error
 	// GetLatestExecutionPayloadHeader retrieves the latest execution payload
 	// header.
 	GetLatestExecutionPayloadHeader() (
@@ -59,6 +61,13 @@ type KVStore[
 	SetEth1DepositIndex(
 		index uint64,
 	) error
+	// GetDepositRequestsStartIndex retrieves the deposit requests start
+	// index.
+	GetDepositRequestsStartIndex() (uint64, error)
+	// SetDepositRequestsStartIndex sets the deposit requests start index.
+	SetDepositRequestsStartIndex(
+		index uint64,
+	) error
 	// GetBalance retrieves the balance of a validator.
 	GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 	// SetBalance sets the balance of a validator.
@@ -144,3 +153,13 @@ type KVStore[
 	// balance.
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
 }
+
+// merkleizedKVStore is a key-value store that persists the beacon state as a
+// Merkle tree, and so can return its root without marshalling the state.
+type merkleizedKVStore interface {
+	// IsMerkleized returns true if the store keeps the beacon state as a
+	// Merkle tree.
+	IsMerkleized() bool
+	// HashTreeRoot returns the root of the beacon state.
+	HashTreeRoot() (common.Root, error)
+}
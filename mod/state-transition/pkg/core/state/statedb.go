@@ -395,6 +395,11 @@ func (s *StateDB[
 		return empty, err
 	}
 
+	depositRequestsStartIndex, err := s.GetDepositRequestsStartIndex()
+	if err != nil {
+		return empty, err
+	}
+
 	// TODO: Properly move BeaconState into full generics.
 	return (*new(BeaconStateMarshallableT)).New(
 		s.cs.ActiveForkVersionForSlot(slot),
@@ -414,6 +419,7 @@ func (s *StateDB[
 		nextWithdrawalValidatorIndex,
 		slashings,
 		totalSlashings,
+		depositRequestsStartIndex,
 	)
 }
 
@@ -421,6 +427,16 @@ func (s *StateDB[
 func (s *StateDB[
 	_, _, _, _, _, _, _, _, _, _,
 ]) HashTreeRoot() common.Root {
+	// Stores that keep the state as a Merkle tree already hold its root.
+	if tree, ok := any(s.KVStore).(merkleizedKVStore); ok &&
+		tree.IsMerkleized() {
+		root, err := tree.HashTreeRoot()
+		if err != nil {
+			panic(err)
+		}
+		return root
+	}
+
 	st, err := s.GetMarshallable()
 	if err != nil {
 		panic(err)
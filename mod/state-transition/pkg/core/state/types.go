@@ -55,6 +55,7 @@ type BeaconStateMarshallable[
 		nextWithdrawalIndex uint64,
 		nextWithdrawalValidatorIndex math.U64,
 		slashings []math.U64, totalSlashing math.U64,
+		depositRequestsStartIndex uint64,
 	) (T, error)
 }
 
@@ -36,11 +36,11 @@ import (
 // main state transition for the beacon chain.
 type StateProcessor[
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
+		DepositT, BeaconBlockBodyT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
@@ -51,9 +51,10 @@ type StateProcessor[
 		ValidatorT, ValidatorsT, WithdrawalT,
 	],
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
+		GetDepositRoot() common.Root
 		GetDepositCount() math.U64
 	},
 	ExecutionPayloadT ExecutionPayload[
@@ -92,6 +93,11 @@ type StateProcessor[
 	executionEngine ExecutionEngine[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	]
+	// ds is the store of the deposits made to the deposit contract, used to
+	// verify the eth1 data and deposits of blocks.
+	ds DepositStore[DepositT]
+	// telemetrySink is used to trace the stages of the state transition.
+	telemetrySink TelemetrySink
 
 	// processingGenesis allows initializing correctly
 	// eth1 deposit index upon genesis
@@ -101,14 +107,12 @@ type StateProcessor[
 // NewStateProcessor creates a new state processor.
 func NewStateProcessor[
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
+		DepositT, BeaconBlockBodyT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT,
-		DepositT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT,
-		WithdrawalsT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -116,9 +120,10 @@ func NewStateProcessor[
 		KVStoreT, ValidatorT, ValidatorsT, WithdrawalT,
 	],
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[DepositT, ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
+		GetDepositRoot() common.Root
 		GetDepositCount() math.U64
 	},
 	ExecutionPayloadT ExecutionPayload[
@@ -148,8 +153,10 @@ func NewStateProcessor[
 	executionEngine ExecutionEngine[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
+	ds DepositStore[DepositT],
 	signer crypto.BLSSigner,
 	fGetAddressFromPubKey func(crypto.BLSPubkey) ([]byte, error),
+	telemetrySink TelemetrySink,
 ) *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
@@ -165,8 +172,10 @@ func NewStateProcessor[
 		logger:                logger,
 		cs:                    cs,
 		executionEngine:       executionEngine,
+		ds:                    ds,
 		signer:                signer,
 		fGetAddressFromPubKey: fGetAddressFromPubKey,
+		telemetrySink:         telemetrySink,
 	}
 }
 
@@ -184,7 +193,11 @@ func (sp *StateProcessor[
 	}
 
 	// Process the slots.
+	_, endSpan := sp.telemetrySink.StartSpan(
+		ctx, "state_processor.ProcessSlots", "slot", blk.GetSlot(),
+	)
 	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
+	endSpan(err)
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +250,11 @@ func (sp *StateProcessor[
 		if err = st.SetSlot(stateSlot + 1); err != nil {
 			return nil, err
 		}
+
+		// Upgrade the state at the first slot of a fork.
+		if err = sp.processUpgrade(st, stateSlot+1); err != nil {
+			return nil, err
+		}
 	}
 
 	return validatorUpdates, nil
@@ -309,7 +327,7 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	if err := sp.processOperations(st, blk); err != nil {
+	if err := sp.processOperations(ctx, st, blk); err != nil {
 		return err
 	}
 
@@ -321,7 +339,11 @@ func (sp *StateProcessor[
 
 	// Ensure the calculated state root matches the state root on
 	// the block.
+	_, endSpan := sp.telemetrySink.StartSpan(
+		ctx, "state_processor.ComputeStateRoot",
+	)
 	stateRoot := st.HashTreeRoot()
+	endSpan(nil)
 	if blk.GetStateRoot() != stateRoot {
 		return errors.Wrapf(
 			ErrStateRootMismatch, "expected %s, got %s",
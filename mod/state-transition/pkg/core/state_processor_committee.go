@@ -22,28 +22,41 @@ package core
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
-	"github.com/sourcegraph/conc/iter"
 )
 
-// processSyncCommitteeUpdates processes the sync committee updates.
+// processSyncCommitteeUpdates processes the sync committee updates. Validators
+// exiting at the start of the next epoch are reported with no voting power so
+// that CometBFT removes them from the validator set, validators that exited
+// before are left out.
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
 ]) processSyncCommitteeUpdates(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
+
 	vals, err := st.GetValidatorsByEffectiveBalance()
 	if err != nil {
 		return nil, err
 	}
 
-	return iter.MapErr(
-		vals,
-		func(val *ValidatorT) (*transition.ValidatorUpdate, error) {
-			v := (*val)
-			return &transition.ValidatorUpdate{
-				Pubkey:           v.GetPubkey(),
-				EffectiveBalance: v.GetEffectiveBalance(),
-			}, nil
-		},
-	)
+	updates := make(transition.ValidatorUpdates, 0, len(vals))
+	for _, val := range vals {
+		update := &transition.ValidatorUpdate{
+			Pubkey:           val.GetPubkey(),
+			EffectiveBalance: val.GetEffectiveBalance(),
+		}
+		switch exitEpoch := val.GetExitEpoch(); {
+		case exitEpoch < nextEpoch:
+			continue
+		case exitEpoch == nextEpoch:
+			update.EffectiveBalance = 0
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
 }
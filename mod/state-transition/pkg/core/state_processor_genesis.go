@@ -59,6 +59,13 @@ func (sp *StateProcessor[
 		math.U64(constants.GenesisEpoch),
 	)
 
+	// The state is laid out for its fork before any field is set.
+	if version.ToUint32(genesisVersion) >= version.Electra {
+		if err := sp.upgradeToElectra(st); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := st.SetSlot(0); err != nil {
 		return nil, err
 	}
@@ -67,11 +74,24 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
-	// Eth1DepositIndex will be set in processDeposit
+	// From Deneb+ on, genesis deposits are premined rather than made to the
+	// deposit contract, so the eth1 data starts from the empty deposit tree.
+	// Before Deneb+, the eth1 data has a zero deposit root and the
+	// Eth1DepositIndex will be set in processDeposit.
+	depositRoot := common.Root{}
+	if version.ToUint32(genesisVersion) >= version.DenebPlus {
+		var err error
+		if depositRoot, err = sp.ds.GetDepositRoot(0); err != nil {
+			return nil, err
+		}
+		if err = st.SetEth1DepositIndex(0); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := st.SetEth1Data(
 		eth1Data.New(
-			common.Root{},
+			depositRoot,
 			0,
 			executionPayloadHeader.GetBlockHash(),
 		)); err != nil {
@@ -102,7 +122,9 @@ func (sp *StateProcessor[
 	}
 
 	for _, deposit := range deposits {
-		if err := sp.processDeposit(st, deposit); err != nil {
+		if err := sp.processGenesisDeposit(
+			st, deposit, genesisVersion,
+		); err != nil {
 			return nil, err
 		}
 	}
@@ -162,3 +184,19 @@ func (sp *StateProcessor[
 	}
 	return updates, nil
 }
+
+// processGenesisDeposit processes a premined deposit. From Deneb+ on,
+// genesis deposits are not made to the deposit contract, so they do not move
+// the Eth1DepositIndex.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+]) processGenesisDeposit(
+	st BeaconStateT,
+	dep DepositT,
+	genesisVersion common.Version,
+) error {
+	if version.ToUint32(genesisVersion) < version.DenebPlus {
+		return sp.processDeposit(st, dep)
+	}
+	return sp.applyDeposit(st, dep)
+}
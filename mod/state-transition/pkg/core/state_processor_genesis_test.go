@@ -39,8 +39,31 @@ import (
 )
 
 func TestInitialize(t *testing.T) {
-	// Create state processor to test
+	// Genesis deposits are accounted for by the eth1 data until Deneb+.
 	cs := spec.BetnetChainSpec()
+	for _, genesisVersion := range []uint32{
+		version.Deneb, version.DenebPlus, version.Electra,
+	} {
+		t.Run(version.Name(genesisVersion), func(t *testing.T) {
+			testInitialize(t, cs, genesisVersion)
+		})
+	}
+}
+
+func testInitialize(
+	t *testing.T,
+	cs chain.Spec[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	],
+	genesisVersion uint32,
+) {
+	t.Helper()
+
+	// Create state processor to test
 	execEngine := mocks.NewExecutionEngine[
 		*types.ExecutionPayload,
 		*types.ExecutionPayloadHeader,
@@ -48,9 +71,13 @@ func TestInitialize(t *testing.T) {
 	](t)
 	mocksSigner := &cryptomocks.BLSSigner{}
 
+	depositStore, err := initDepositStore()
+	require.NoError(t, err)
+
 	sp := createStateProcessor(
 		cs,
 		execEngine,
+		depositStore,
 		mocksSigner,
 		dummyProposerAddressVerifier,
 	)
@@ -90,8 +117,8 @@ func TestInitialize(t *testing.T) {
 		}
 		executionPayloadHeader = new(types.ExecutionPayloadHeader).Empty()
 		fork                   = &types.Fork{
-			PreviousVersion: version.FromUint32[common.Version](version.Deneb),
-			CurrentVersion:  version.FromUint32[common.Version](version.Deneb),
+			PreviousVersion: version.FromUint32[common.Version](genesisVersion),
+			CurrentVersion:  version.FromUint32[common.Version](genesisVersion),
 			Epoch:           math.Epoch(constants.GenesisEpoch),
 		}
 	)
@@ -127,10 +154,25 @@ func TestInitialize(t *testing.T) {
 		checkValidatorNonBartio(t, cs, beaconState, dep)
 	}
 
-	// check that validator index is duly set
 	latestValIdx, err := beaconState.GetEth1DepositIndex()
 	require.NoError(t, err)
-	require.Equal(t, uint64(len(deposits)-1), latestValIdx)
+	eth1Data, err := beaconState.GetEth1Data()
+	require.NoError(t, err)
+	require.Zero(t, eth1Data.GetDepositCount())
+
+	if genesisVersion < version.DenebPlus {
+		// check that validator index is duly set
+		require.Equal(t, uint64(len(deposits)-1), latestValIdx)
+		require.Equal(t, common.Root{}, eth1Data.GetDepositRoot())
+		return
+	}
+
+	// check that the premined deposits are not accounted for by the eth1
+	// deposit index and eth1 data, which start from the empty deposit tree
+	require.Zero(t, latestValIdx)
+	emptyDepositRoot, err := depositStore.GetDepositRoot(0)
+	require.NoError(t, err)
+	require.Equal(t, emptyDepositRoot, eth1Data.GetDepositRoot())
 }
 
 func checkValidatorNonBartio(
@@ -169,9 +211,13 @@ func TestInitializeBartio(t *testing.T) {
 	](t)
 	mocksSigner := &cryptomocks.BLSSigner{}
 
+	depositStore, err := initDepositStore()
+	require.NoError(t, err)
+
 	sp := createStateProcessor(
 		cs,
 		execEngine,
+		depositStore,
 		mocksSigner,
 		dummyProposerAddressVerifier,
 	)
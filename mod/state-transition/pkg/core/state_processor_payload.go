@@ -189,6 +189,8 @@ func (sp *StateProcessor[
 			payload,
 			body.GetBlobKzgCommitments().ToVersionedHashes(),
 			&parentBeaconBlockRoot,
+			body.GetExecutionRequests(),
+			sp.cs.ActiveForkVersionForSlot(blk.GetSlot()),
 			optimisticEngine,
 		),
 	); err != nil {
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// processExecutionRequests processes the requests made by the execution layer
+// in the execution payload of the block, from Electra on.
+//
+// Consolidation requests are not supported, since validators have no
+// compounding credentials to consolidate into, so blocks carrying them are
+// rejected.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processExecutionRequests(
+	st BeaconStateT,
+	requests *engineprimitives.ExecutionRequests,
+) error {
+	if requests == nil {
+		return ErrNilExecutionRequests
+	}
+	if len(requests.Consolidations) > 0 {
+		return errors.Wrapf(
+			ErrConsolidationRequestsNotSupported,
+			"got %d", len(requests.Consolidations),
+		)
+	}
+	for _, req := range requests.Deposits {
+		if err := sp.processDepositRequest(st, req); err != nil {
+			return err
+		}
+	}
+	for _, req := range requests.Withdrawals {
+		if err := sp.processWithdrawalRequest(st, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processDepositRequest as defined in the Ethereum 2.0 specification, except
+// that the deposit is applied right away rather than queued. The first deposit
+// request sets the deposit requests start index, from which on deposits are
+// no longer included in blocks through the eth1 data.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_deposit_request
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _,
+	WithdrawalCredentialsT,
+]) processDepositRequest(
+	st BeaconStateT,
+	req *engineprimitives.DepositRequest,
+) error {
+	var dep DepositT
+	dep = dep.New(
+		req.Pubkey,
+		WithdrawalCredentialsT(req.Credentials),
+		req.Amount,
+		req.Signature,
+		req.Index.Unwrap(),
+	)
+
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	if startIndex == constants.UnsetDepositRequestsStartIndex {
+		if err = st.SetDepositRequestsStartIndex(
+			req.Index.Unwrap(),
+		); err != nil {
+			return err
+		}
+	}
+
+	if _, err = st.ValidatorIndexByPubkey(req.Pubkey); err == nil {
+		return sp.applyDeposit(st, dep)
+	}
+
+	// The execution layer passes on deposits regardless of their signature,
+	// so a deposit with an invalid signature does not invalidate the block,
+	// it is ignored instead.
+	if err = sp.verifyDepositSignature(st, dep); err != nil {
+		sp.logger.Warn(
+			"Ignoring deposit request with invalid signature",
+			"index", req.Index, "pubkey", req.Pubkey, "err", err,
+		)
+		return nil
+	}
+	return sp.addValidatorToRegistry(st, dep)
+}
+
+// processWithdrawalRequest as defined in the Ethereum 2.0 specification.
+// Validators have no pending partial withdrawals, so only full exit requests
+// are processed, partial withdrawal requests are ignored. Invalid requests
+// are ignored as well, since the execution layer cannot validate them.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_withdrawal_request
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processWithdrawalRequest(
+	st BeaconStateT,
+	req *engineprimitives.WithdrawalRequest,
+) error {
+	if req.Amount != engineprimitives.FullExitRequestAmount {
+		return nil
+	}
+
+	idx, err := st.ValidatorIndexByPubkey(req.ValidatorPubkey)
+	if err != nil {
+		//nolint:nilerr // unknown validators are ignored.
+		return nil
+	}
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Only the withdrawal address of the validator may request its exit.
+	credentials := val.GetWithdrawalCredentials()
+	if credentials[0] != types.EthSecp256k1CredentialPrefix ||
+		[20]byte(credentials[12:]) != req.SourceAddress {
+		return nil
+	}
+
+	// Verify the validator has not initiated an exit already.
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+	return sp.initiateValidatorExit(st, idx)
+}
@@ -27,22 +27,124 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/davecgh/go-spew/spew"
 )
 
 // processOperations processes the operations and ensures they match the
 // local state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	BeaconBlockT, _, _, BeaconStateT, ContextT,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) processOperations(
+	ctx ContextT,
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) error {
-	// Verify that outstanding deposits are processed up to the maximum number
-	// of deposits.
-	deposits := blk.GetBody().GetDeposits()
+	body := blk.GetBody()
+	deposits := body.GetDeposits()
+
+	// Before Deneb+, the deposits included by the block are processed as
+	// is, without any eth1 data to account for them.
+	forkVersion := sp.cs.ActiveForkVersionForSlot(blk.GetSlot())
+	if forkVersion < version.DenebPlus {
+		return sp.processDeposits(st, deposits)
+	}
+
+	// From Deneb+ on, the block votes for the eth1 data of the deposit
+	// contract and must include exactly the deposits it accounts for.
+	if err := sp.processEth1Data(ctx, st, body.GetEth1Data()); err != nil {
+		return err
+	}
+	if err := sp.verifyDeposits(ctx, st, deposits); err != nil {
+		return err
+	}
+	if err := sp.processDeposits(st, deposits); err != nil {
+		return err
+	}
+	if forkVersion < version.Electra {
+		return nil
+	}
+
+	// From Electra on, the execution client passes its requests alongside,
+	// and the block may carry voluntary exits.
+	if err := sp.processExecutionRequests(
+		st, body.GetExecutionRequests(),
+	); err != nil {
+		return err
+	}
+	return sp.processVoluntaryExits(st, body.GetVoluntaryExits())
+}
+
+// processEth1Data verifies the eth1 data voted for by the block against the
+// deposit tree and sets it in the state. The deposit count may never
+// decrease, as deposits up to it may already have been processed.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, ContextT, _, Eth1DataT, _, _, _, _, _, _, _, _, _, _,
+]) processEth1Data(
+	ctx ContextT,
+	st BeaconStateT,
+	eth1Data Eth1DataT,
+) error {
+	current, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+
+	count := eth1Data.GetDepositCount()
+	switch {
+	case count < current.GetDepositCount():
+		return errors.Wrapf(
+			ErrDepositCountDecreased, "expected at least %d, got %d",
+			current.GetDepositCount(), count,
+		)
+	case count == current.GetDepositCount():
+		// The deposit tree did not change, so neither can its root.
+		if eth1Data.GetDepositRoot() != current.GetDepositRoot() {
+			return errors.Wrapf(
+				ErrDepositRootMismatch, "expected %s, got %s",
+				current.GetDepositRoot(), eth1Data.GetDepositRoot(),
+			)
+		}
+	case !ctx.GetOptimisticEngine():
+		// The deposit store follows the execution client, so the new root
+		// is only checked when not optimistically trusting the latter.
+		var root common.Root
+		root, err = sp.ds.GetDepositRoot(count.Unwrap())
+		switch {
+		case errors.Is(err, deposit.ErrDepositRootNotFound):
+			// The deposit store lags behind the deposit contract, so the
+			// root cannot be verified yet. The block is rejected, and may be
+			// processed again once the deposit store has caught up.
+			return errors.Wrapf(
+				ErrDepositStoreBehind, "no deposit root for deposit count %d",
+				count,
+			)
+		case err != nil:
+			return err
+		case eth1Data.GetDepositRoot() != root:
+			return errors.Wrapf(
+				ErrDepositRootMismatch, "expected %s, got %s",
+				root, eth1Data.GetDepositRoot(),
+			)
+		}
+	}
+
+	return st.SetEth1Data(eth1Data)
+}
+
+// verifyDeposits verifies that the block includes exactly the outstanding
+// deposits, up to the maximum number of deposits per block, in order.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, ContextT, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+]) verifyDeposits(
+	ctx ContextT,
+	st BeaconStateT,
+	deposits []DepositT,
+) error {
 	index, err := st.GetEth1DepositIndex()
 	if err != nil {
 		return err
@@ -51,16 +153,64 @@ func (sp *StateProcessor[
 	if err != nil {
 		return err
 	}
-	depositCount := min(
-		sp.cs.MaxDepositsPerBlock(),
-		eth1Data.GetDepositCount().Unwrap()-index,
-	)
-	_ = depositCount
-	// TODO: Update eth1data count and check this.
-	// if uint64(len(deposits)) != depositCount {
-	// 	return errors.New("deposit count mismatch")
-	// }
-	return sp.processDeposits(st, deposits)
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+
+	// From the deposit requests start index on, deposits are passed on by
+	// the execution client as deposit requests instead (EIP-6110). The
+	// deposit count of the eth1 data may only be lower than the deposit
+	// index right after Deneb+, as deposits were included regardless of it
+	// before.
+	var depositCount uint64
+	limit := min(eth1Data.GetDepositCount().Unwrap(), startIndex)
+	if limit > index {
+		depositCount = min(sp.cs.MaxDepositsPerBlock(), limit-index)
+	}
+	if uint64(len(deposits)) != depositCount {
+		return errors.Wrapf(
+			ErrDepositCountMismatch, "expected %d, got %d",
+			depositCount, len(deposits),
+		)
+	}
+
+	for i, dep := range deposits {
+		//#nosec:G701 // i is bounded by the max deposits per block.
+		if dep.GetIndex().Unwrap() != index+uint64(i) {
+			return errors.Wrapf(
+				ErrDepositIndexMismatch, "expected %d, got %d",
+				index+uint64(i), dep.GetIndex(),
+			)
+		}
+	}
+
+	// As for the eth1 data, the deposits themselves are only checked against
+	// the deposit store when not optimistically trusting the execution
+	// client.
+	if ctx.GetOptimisticEngine() || len(deposits) == 0 {
+		return nil
+	}
+	stored, err := sp.ds.GetDepositsByIndex(index, depositCount)
+	if err != nil {
+		return err
+	}
+	if len(stored) < len(deposits) {
+		// The deposits the deposit store does not hold yet cannot be
+		// verified, so they may not be processed.
+		return errors.Wrapf(
+			ErrDepositStoreBehind, "deposit %d not found",
+			index+uint64(len(stored)),
+		)
+	}
+	for i, dep := range stored {
+		if dep.HashTreeRoot() != deposits[i].HashTreeRoot() {
+			return errors.Wrapf(
+				ErrDepositMismatch, "deposit %d", dep.GetIndex(),
+			)
+		}
+	}
+	return nil
 }
 
 // processDeposits processes the deposits and ensures  they match the
@@ -147,10 +297,27 @@ func (sp *StateProcessor[
 
 // createValidator creates a validator if the deposit is valid.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
 ]) createValidator(
 	st BeaconStateT,
 	dep DepositT,
+) error {
+	// Verify that the message was signed correctly.
+	if err := sp.verifyDepositSignature(st, dep); err != nil {
+		return err
+	}
+
+	// Add the validator to the registry.
+	return sp.addValidatorToRegistry(st, dep)
+}
+
+// verifyDepositSignature verifies the signature of a deposit creating a
+// validator.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+]) verifyDepositSignature(
+	st BeaconStateT,
+	dep DepositT,
 ) error {
 	// Get the current slot.
 	slot, err := st.GetSlot()
@@ -173,7 +340,7 @@ func (sp *StateProcessor[
 
 	// Verify that the message was signed correctly.
 	var d ForkDataT
-	if err = dep.VerifySignature(
+	return dep.VerifySignature(
 		d.New(
 			version.FromUint32[common.Version](
 				sp.cs.ActiveForkVersionForEpoch(epoch),
@@ -181,12 +348,7 @@ func (sp *StateProcessor[
 		),
 		sp.cs.DomainTypeDeposit(),
 		sp.signer.VerifySignature,
-	); err != nil {
-		return err
-	}
-
-	// Add the validator to the registry.
-	return sp.addValidatorToRegistry(st, dep)
+	)
 }
 
 // addValidatorToRegistry adds a validator to the registry.
@@ -310,3 +472,174 @@ func (sp *StateProcessor[
 
 	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
 }
+
+// processVoluntaryExits processes the voluntary exits and ensures they match
+// the local state.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processVoluntaryExits(
+	st BeaconStateT,
+	exits []*types.SignedVoluntaryExit,
+) error {
+	if uint64(len(exits)) > sp.cs.MaxVoluntaryExitsPerBlock() {
+		return errors.Wrapf(ErrExceedsBlockVoluntaryExitLimit,
+			"expected: %d, got: %d",
+			sp.cs.MaxVoluntaryExitsPerBlock(), len(exits),
+		)
+	}
+
+	for _, exit := range exits {
+		if err := sp.processVoluntaryExit(st, exit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntary-exits
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processVoluntaryExit(
+	st BeaconStateT,
+	exit *types.SignedVoluntaryExit,
+) error {
+	if err := sp.VerifyVoluntaryExit(st, exit); err != nil {
+		return err
+	}
+	return sp.initiateValidatorExit(st, exit.GetValidatorIndex())
+}
+
+// VerifyVoluntaryExit verifies that the voluntary exit can be applied to the
+// given state. Since validators are not activated through an activation
+// queue, any validator in the registry that has not exited yet may exit.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) VerifyVoluntaryExit(
+	st BeaconStateT,
+	exit *types.SignedVoluntaryExit,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	val, err := st.ValidatorByIndex(exit.GetValidatorIndex())
+	if err != nil {
+		return err
+	}
+
+	// Verify the validator has not initiated an exit already.
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return errors.Wrapf(
+			ErrValidatorAlreadyExited, "index: %d, exit epoch: %d",
+			exit.GetValidatorIndex(), val.GetExitEpoch(),
+		)
+	}
+
+	// Exits must specify an epoch when they become valid, they are not valid
+	// before then.
+	if epoch := sp.cs.SlotToEpoch(slot); epoch < exit.GetEpoch() {
+		return errors.Wrapf(
+			ErrVoluntaryExitTooEarly, "current epoch: %d, exit epoch: %d",
+			epoch, exit.GetEpoch(),
+		)
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+
+	// Verify that the exit was signed by the validator.
+	return exit.VerifySignature(
+		types.NewForkData(
+			version.FromUint32[common.Version](
+				sp.cs.ActiveForkVersionForEpoch(exit.GetEpoch()),
+			), genesisValidatorsRoot,
+		),
+		sp.cs.DomainTypeVoluntaryExit(),
+		val.GetPubkey(),
+		sp.signer.VerifySignature,
+	)
+}
+
+// initiateValidatorExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) error {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Return if the validator already initiated its exit.
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	if val.GetExitEpoch() != farFutureEpoch {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	currentEpoch := sp.cs.SlotToEpoch(slot)
+
+	vals, err := st.GetValidatorsByEffectiveBalance()
+	if err != nil {
+		return err
+	}
+
+	// Compute the exit queue epoch. With single slot finality there is no
+	// seed lookahead, so exits take effect at the next epoch at the earliest.
+	var (
+		exitQueueEpoch = currentEpoch + 1
+		exitQueueChurn uint64
+		activeCount    uint64
+	)
+	for _, v := range vals {
+		exitEpoch := v.GetExitEpoch()
+		if exitEpoch > currentEpoch {
+			activeCount++
+		}
+		switch {
+		case exitEpoch == farFutureEpoch:
+			continue
+		case exitEpoch > exitQueueEpoch:
+			exitQueueEpoch, exitQueueChurn = exitEpoch, 1
+		case exitEpoch == exitQueueEpoch:
+			exitQueueChurn++
+		}
+	}
+	if exitQueueChurn >= sp.getValidatorChurnLimit(activeCount) {
+		exitQueueEpoch++
+	}
+
+	// Set the validator exit epoch and withdrawable epoch.
+	val.SetExitEpoch(exitQueueEpoch)
+	val.SetWithdrawableEpoch(
+		exitQueueEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+	)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// getValidatorChurnLimit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_validator_churn_limit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) getValidatorChurnLimit(activeCount uint64) uint64 {
+	return max(
+		sp.cs.MinPerEpochChurnLimit(),
+		activeCount/sp.cs.ChurnLimitQuotient(),
+	)
+}
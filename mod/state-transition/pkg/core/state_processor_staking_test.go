@@ -21,6 +21,7 @@
 package core_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
@@ -28,10 +29,13 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -48,9 +52,13 @@ func TestTransitionUpdateValidators(t *testing.T) {
 	mocksSigner := &cryptomocks.BLSSigner{}
 	dummyProposerAddr := []byte{0xff}
 
+	depositStore, err := initDepositStore()
+	require.NoError(t, err)
+
 	sp := createStateProcessor(
 		cs,
 		execEngine,
+		depositStore,
 		mocksSigner,
 		func(bytes.B48) ([]byte, error) {
 			return dummyProposerAddr, nil
@@ -165,3 +173,618 @@ func TestTransitionUpdateValidators(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint64(len(genDeposits)), latestValIdx)
 }
+
+func TestTransitionVoluntaryExit(t *testing.T) {
+	// Create state processor to test. Voluntary exits are carried by the
+	// block body from Electra on.
+	cs := electraChainSpec()
+	execEngine := mocks.NewExecutionEngine[
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		engineprimitives.Withdrawals,
+	](t)
+	mocksSigner := &cryptomocks.BLSSigner{}
+	dummyProposerAddr := []byte{0xff}
+
+	depositStore, err := initDepositStore()
+	require.NoError(t, err)
+
+	sp := createStateProcessor(
+		cs,
+		execEngine,
+		depositStore,
+		mocksSigner,
+		func(bytes.B48) ([]byte, error) {
+			return dummyProposerAddr, nil
+		},
+	)
+
+	kvStore, err := initStore()
+	require.NoError(t, err)
+	beaconState := new(TestBeaconStateT).NewFromDB(kvStore, cs)
+
+	var (
+		maxBalance       = math.Gwei(cs.MaxEffectiveBalance())
+		emptyCredentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		)
+	)
+
+	// Setup initial state via genesis
+	var (
+		genDeposits = []*types.Deposit{
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(0),
+			},
+			{
+				Pubkey:      [48]byte{0x02},
+				Credentials: emptyCredentials,
+				Amount:      maxBalance,
+				Index:       uint64(1),
+			},
+		}
+		genPayloadHeader = new(types.ExecutionPayloadHeader).Empty()
+		genVersion       = version.FromUint32[common.Version](version.Electra)
+	)
+
+	mocksSigner.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		beaconState,
+		genDeposits,
+		genPayloadHeader,
+		genVersion,
+	)
+	require.NoError(t, err)
+
+	// no deposits were made, so the eth1 data of genesis is voted for again
+	eth1Data, err := beaconState.GetEth1Data()
+	require.NoError(t, err)
+
+	// create test inputs
+	var (
+		ctx = &transition.Context{
+			SkipPayloadVerification: true,
+			SkipValidateResult:      true,
+			ProposerAddress:         dummyProposerAddr,
+		}
+		exit = &types.SignedVoluntaryExit{
+			Message: &types.VoluntaryExit{
+				Epoch:          0,
+				ValidatorIndex: 1,
+			},
+		}
+	)
+
+	body := new(types.BeaconBlockBody).Empty(version.Electra)
+	body.ExecutionPayload.Timestamp = 10
+	body.ExecutionPayload.ExtraData = []byte("testing")
+	body.ExecutionPayload.Transactions = [][]byte{}
+	body.ExecutionPayload.Withdrawals = []*engineprimitives.Withdrawal{}
+	body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+	body.Eth1Data = eth1Data
+	body.Deposits = []*types.Deposit{}
+	body.VoluntaryExits = []*types.SignedVoluntaryExit{exit}
+	blk := buildNextBlock(t, beaconState, body)
+
+	// run the test
+	vals, err := sp.Transition(ctx, beaconState, blk)
+	require.NoError(t, err)
+	require.Zero(t, vals) // not an epoch boundary, no updates
+
+	// check the exit is scheduled for the next epoch
+	val, err := beaconState.ValidatorByIndex(exit.GetValidatorIndex())
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+	require.Equal(
+		t,
+		math.Epoch(1+cs.MinValidatorWithdrawabilityDelay()),
+		val.GetWithdrawableEpoch(),
+	)
+
+	// the other validator is left untouched
+	val, err = beaconState.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch())
+
+	// a validator may not exit twice
+	require.ErrorIs(
+		t,
+		sp.VerifyVoluntaryExit(beaconState, exit),
+		core.ErrValidatorAlreadyExited,
+	)
+}
+
+func TestTransitionDepositsMatchEth1Data(t *testing.T) {
+	cs := electraChainSpec()
+	dummyProposerAddr := []byte{0xff}
+	credentials := types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{},
+	)
+
+	// contractDeposits are the deposits made to the deposit contract after
+	// genesis.
+	contractDeposits := []*types.Deposit{
+		{
+			Pubkey:      [48]byte{0x02},
+			Credentials: credentials,
+			Amount:      math.Gwei(cs.MaxEffectiveBalance()),
+			Index:       0,
+		},
+		{
+			Pubkey:      [48]byte{0x03},
+			Credentials: credentials,
+			Amount:      math.Gwei(cs.MaxEffectiveBalance()),
+			Index:       1,
+		},
+	}
+
+	// processBlock processes a block voting for the given eth1 data and
+	// including the given deposits on top of a fresh genesis state.
+	processBlock := func(
+		eth1Data *types.Eth1Data,
+		deposits []*types.Deposit,
+	) error {
+		execEngine := mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t)
+		mocksSigner := &cryptomocks.BLSSigner{}
+		mocksSigner.On(
+			"VerifySignature",
+			mock.Anything, mock.Anything, mock.Anything,
+		).Return(nil)
+
+		depositStore, err := initDepositStore()
+		require.NoError(t, err)
+		require.NoError(t, depositStore.EnqueueDeposits(contractDeposits))
+
+		sp := createStateProcessor(
+			cs,
+			execEngine,
+			depositStore,
+			mocksSigner,
+			func(bytes.B48) ([]byte, error) {
+				return dummyProposerAddr, nil
+			},
+		)
+
+		kvStore, err := initStore()
+		require.NoError(t, err)
+		beaconState := new(TestBeaconStateT).NewFromDB(kvStore, cs)
+		_, err = sp.InitializePreminedBeaconStateFromEth1(
+			beaconState,
+			[]*types.Deposit{{
+				Pubkey:      [48]byte{0x01},
+				Credentials: credentials,
+				Amount:      math.Gwei(cs.MaxEffectiveBalance()),
+				Index:       0,
+			}},
+			new(types.ExecutionPayloadHeader).Empty(),
+			version.FromUint32[common.Version](version.Electra),
+		)
+		require.NoError(t, err)
+
+		blk := buildNextBlock(
+			t,
+			beaconState,
+			&types.BeaconBlockBody{
+				ExecutionPayload: &types.ExecutionPayload{
+					Timestamp:     10,
+					ExtraData:     []byte("testing"),
+					Transactions:  [][]byte{},
+					Withdrawals:   []*engineprimitives.Withdrawal{},
+					BaseFeePerGas: math.NewU256(0),
+				},
+				Eth1Data:          eth1Data,
+				Deposits:          deposits,
+				ExecutionRequests: &engineprimitives.ExecutionRequests{},
+			},
+		)
+		_, err = sp.Transition(
+			&transition.Context{
+				SkipPayloadVerification: true,
+				SkipValidateResult:      true,
+				ProposerAddress:         dummyProposerAddr,
+			},
+			beaconState,
+			blk,
+		)
+		return err
+	}
+
+	depositStore, err := initDepositStore()
+	require.NoError(t, err)
+	require.NoError(t, depositStore.EnqueueDeposits(contractDeposits))
+	depositRoot, err := depositStore.GetDepositRoot(2)
+	require.NoError(t, err)
+	eth1Data := &types.Eth1Data{DepositRoot: depositRoot, DepositCount: 2}
+
+	// all the outstanding deposits are included
+	require.NoError(t, processBlock(eth1Data, contractDeposits))
+
+	// an outstanding deposit is left out
+	require.ErrorIs(
+		t,
+		processBlock(eth1Data, contractDeposits[:1]),
+		core.ErrDepositCountMismatch,
+	)
+
+	// the deposits are included out of order
+	require.ErrorIs(
+		t,
+		processBlock(eth1Data, []*types.Deposit{
+			contractDeposits[1], contractDeposits[0],
+		}),
+		core.ErrDepositIndexMismatch,
+	)
+
+	// the voted deposit root does not match the deposit tree
+	require.ErrorIs(
+		t,
+		processBlock(
+			&types.Eth1Data{DepositRoot: common.Root{0x01}, DepositCount: 2},
+			contractDeposits,
+		),
+		core.ErrDepositRootMismatch,
+	)
+
+	// the deposits included by the block are verified against the deposit
+	// store
+	forgedDeposit := *contractDeposits[1]
+	forgedDeposit.Amount *= 2
+	require.ErrorIs(
+		t,
+		processBlock(
+			eth1Data,
+			[]*types.Deposit{contractDeposits[0], &forgedDeposit},
+		),
+		core.ErrDepositMismatch,
+	)
+
+	// the deposit store lags behind the deposit contract, so the block
+	// includes deposits missing from it and is rejected
+	lateDeposit := &types.Deposit{
+		Pubkey:      [48]byte{0x04},
+		Credentials: credentials,
+		Amount:      math.Gwei(cs.MaxEffectiveBalance()),
+		Index:       2,
+	}
+	require.ErrorIs(
+		t,
+		processBlock(
+			&types.Eth1Data{DepositRoot: common.Root{0x01}, DepositCount: 3},
+			append(contractDeposits, lateDeposit),
+		),
+		core.ErrDepositStoreBehind,
+	)
+}
+
+func TestTransitionExecutionRequests(t *testing.T) {
+	cs := electraChainSpec()
+
+	var (
+		dummyProposerAddr = []byte{0xff}
+		maxBalance        = math.Gwei(cs.MaxEffectiveBalance())
+		addr1             = common.ExecutionAddress{0x01}
+		addr2             = common.ExecutionAddress{0x02}
+		invalidSigPubkey  = crypto.BLSPubkey{0x04}
+	)
+
+	// processBlock processes a block with the given body on top of a fresh
+	// Electra genesis state, returning the post state.
+	processBlock := func(
+		setBody func(*types.BeaconBlockBody),
+	) (*TestBeaconStateT, error) {
+		execEngine := mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t)
+		mocksSigner := &cryptomocks.BLSSigner{}
+		mocksSigner.On(
+			"VerifySignature",
+			invalidSigPubkey, mock.Anything, mock.Anything,
+		).Return(errors.New("invalid signature"))
+		mocksSigner.On(
+			"VerifySignature",
+			mock.Anything, mock.Anything, mock.Anything,
+		).Return(nil)
+
+		depositStore, err := initDepositStore()
+		require.NoError(t, err)
+		sp := createStateProcessor(
+			cs,
+			execEngine,
+			depositStore,
+			mocksSigner,
+			func(bytes.B48) ([]byte, error) {
+				return dummyProposerAddr, nil
+			},
+		)
+
+		kvStore, err := initStore()
+		require.NoError(t, err)
+		beaconState := new(TestBeaconStateT).NewFromDB(kvStore, cs)
+		_, err = sp.InitializePreminedBeaconStateFromEth1(
+			beaconState,
+			[]*types.Deposit{
+				{
+					Pubkey: [48]byte{0x01},
+					Credentials: types.NewCredentialsFromExecutionAddress(
+						addr1,
+					),
+					Amount: maxBalance,
+					Index:  0,
+				},
+				{
+					Pubkey: [48]byte{0x02},
+					Credentials: types.NewCredentialsFromExecutionAddress(
+						addr2,
+					),
+					Amount: maxBalance,
+					Index:  1,
+				},
+			},
+			new(types.ExecutionPayloadHeader).Empty(),
+			version.FromUint32[common.Version](version.Electra),
+		)
+		require.NoError(t, err)
+
+		eth1Data, err := beaconState.GetEth1Data()
+		require.NoError(t, err)
+		body := new(types.BeaconBlockBody).Empty(version.Electra)
+		body.ExecutionPayload.Timestamp = 10
+		body.ExecutionPayload.ExtraData = []byte("testing")
+		body.ExecutionPayload.Transactions = [][]byte{}
+		body.ExecutionPayload.Withdrawals = []*engineprimitives.Withdrawal{}
+		body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+		body.Eth1Data = eth1Data
+		body.Deposits = []*types.Deposit{}
+		setBody(body)
+
+		_, err = sp.Transition(
+			&transition.Context{
+				SkipPayloadVerification: true,
+				SkipValidateResult:      true,
+				ProposerAddress:         dummyProposerAddr,
+			},
+			beaconState,
+			buildNextBlock(t, beaconState, body),
+		)
+		return beaconState, err
+	}
+
+	beaconState, err := processBlock(func(body *types.BeaconBlockBody) {
+		body.ExecutionRequests = &engineprimitives.ExecutionRequests{
+			Deposits: []*engineprimitives.DepositRequest{
+				// a new validator
+				{Pubkey: crypto.BLSPubkey{0x03}, Amount: maxBalance, Index: 0},
+				// a top up of an existing validator
+				{Pubkey: crypto.BLSPubkey{0x01}, Amount: 1e9, Index: 1},
+				// a new validator with an invalid signature
+				{Pubkey: invalidSigPubkey, Amount: maxBalance, Index: 2},
+			},
+			Withdrawals: []*engineprimitives.WithdrawalRequest{
+				// a full exit requested by the withdrawal address
+				{SourceAddress: addr2, ValidatorPubkey: crypto.BLSPubkey{0x02}},
+				// a full exit requested by another address
+				{SourceAddress: addr2, ValidatorPubkey: crypto.BLSPubkey{0x01}},
+				// a partial withdrawal
+				{
+					SourceAddress:   addr1,
+					ValidatorPubkey: crypto.BLSPubkey{0x01},
+					Amount:          1e9,
+				},
+			},
+		}
+	})
+	require.NoError(t, err)
+
+	// the first deposit request sets the deposit requests start index, the
+	// deposit index keeps following the bridged deposits
+	startIndex, err := beaconState.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), startIndex)
+	depositIndex, err := beaconState.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), depositIndex)
+
+	// the new validator is added, unless its signature is invalid
+	idx, err := beaconState.ValidatorIndexByPubkey(crypto.BLSPubkey{0x03})
+	require.NoError(t, err)
+	balance, err := beaconState.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, balance)
+	_, err = beaconState.ValidatorIndexByPubkey(invalidSigPubkey)
+	require.Error(t, err)
+
+	// the existing validator is topped up, and neither exits nor withdraws
+	balance, err = beaconState.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance+1e9, balance)
+	val, err := beaconState.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch())
+
+	// the exit requested by the withdrawal address is initiated
+	val, err = beaconState.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+
+	// bridged deposits are no longer accepted
+	_, err = processBlock(func(body *types.BeaconBlockBody) {
+		body.ExecutionRequests = new(engineprimitives.ExecutionRequests)
+		body.Deposits = []*types.Deposit{{Pubkey: [48]byte{0x03}}}
+	})
+	require.ErrorIs(t, err, core.ErrDepositCountMismatch)
+
+	// consolidation requests are not supported
+	_, err = processBlock(func(body *types.BeaconBlockBody) {
+		body.ExecutionRequests = &engineprimitives.ExecutionRequests{
+			Consolidations: []*engineprimitives.ConsolidationRequest{{
+				SourceAddress: addr1,
+				SourcePubkey:  crypto.BLSPubkey{0x01},
+				TargetPubkey:  crypto.BLSPubkey{0x02},
+			}},
+		}
+	})
+	require.ErrorIs(t, err, core.ErrConsolidationRequestsNotSupported)
+}
+
+func TestTransitionDepositRequestsStartIndex(t *testing.T) {
+	cs := electraChainSpec()
+	dummyProposerAddr := []byte{0xff}
+	credentials := types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{},
+	)
+	execEngine := mocks.NewExecutionEngine[
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		engineprimitives.Withdrawals,
+	](t)
+	mocksSigner := &cryptomocks.BLSSigner{}
+	mocksSigner.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+
+	// contractDeposits are the deposits made to the deposit contract after
+	// genesis, the last of which is passed on as a deposit request too.
+	var contractDeposits []*types.Deposit
+	for i := range uint64(3) {
+		contractDeposits = append(contractDeposits, &types.Deposit{
+			Pubkey:      [48]byte{0x02 + byte(i)},
+			Credentials: credentials,
+			Amount:      math.Gwei(cs.MaxEffectiveBalance()),
+			Index:       i,
+		})
+	}
+	depositStore, err := initDepositStore()
+	require.NoError(t, err)
+	require.NoError(t, depositStore.EnqueueDeposits(contractDeposits))
+	eth1Data := func(count uint64) *types.Eth1Data {
+		root, rErr := depositStore.GetDepositRoot(count)
+		require.NoError(t, rErr)
+		return &types.Eth1Data{
+			DepositRoot:  root,
+			DepositCount: math.U64(count),
+		}
+	}
+
+	sp := createStateProcessor(
+		cs,
+		execEngine,
+		depositStore,
+		mocksSigner,
+		func(bytes.B48) ([]byte, error) {
+			return dummyProposerAddr, nil
+		},
+	)
+	kvStore, err := initStore()
+	require.NoError(t, err)
+	beaconState := new(TestBeaconStateT).NewFromDB(kvStore, cs)
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		beaconState,
+		[]*types.Deposit{{
+			Pubkey:      [48]byte{0x01},
+			Credentials: credentials,
+			Amount:      math.Gwei(cs.MaxEffectiveBalance()),
+			Index:       0,
+		}},
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Electra),
+	)
+	require.NoError(t, err)
+
+	// processBlock processes a block on top of the state.
+	timestamp := math.U64(10)
+	processBlock := func(
+		eth1Data *types.Eth1Data,
+		deposits []*types.Deposit,
+		requests *engineprimitives.ExecutionRequests,
+	) error {
+		blk := buildNextBlock(
+			t,
+			beaconState,
+			&types.BeaconBlockBody{
+				ExecutionPayload: &types.ExecutionPayload{
+					Timestamp:     timestamp,
+					ExtraData:     []byte("testing"),
+					Transactions:  [][]byte{},
+					Withdrawals:   []*engineprimitives.Withdrawal{},
+					BaseFeePerGas: math.NewU256(0),
+				},
+				Eth1Data:          eth1Data,
+				Deposits:          deposits,
+				ExecutionRequests: requests,
+			},
+		)
+		timestamp++
+		_, pErr := sp.Transition(
+			&transition.Context{
+				SkipPayloadVerification: true,
+				SkipValidateResult:      true,
+				ProposerAddress:         dummyProposerAddr,
+			},
+			beaconState,
+			blk,
+		)
+		return pErr
+	}
+
+	// the first deposit is bridged before the deposit requests start
+	last := contractDeposits[2]
+	require.NoError(t, processBlock(
+		eth1Data(1),
+		contractDeposits[:1],
+		&engineprimitives.ExecutionRequests{
+			Deposits: []*engineprimitives.DepositRequest{{
+				Pubkey:      last.Pubkey,
+				Credentials: bytes.B32(last.Credentials),
+				Amount:      last.Amount,
+				Index:       math.U64(last.Index),
+			}},
+		},
+	))
+	startIndex, err := beaconState.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, last.Index, startIndex)
+
+	// the deposits are bridged up to the deposit requests start index only
+	require.NoError(t, processBlock(
+		eth1Data(3),
+		contractDeposits[1:2],
+		new(engineprimitives.ExecutionRequests),
+	))
+	depositIndex, err := beaconState.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, startIndex, depositIndex)
+
+	// once all the deposits before it are bridged, none are included anymore
+	require.NoError(t, processBlock(
+		eth1Data(3), nil, new(engineprimitives.ExecutionRequests),
+	))
+	for _, dep := range append(contractDeposits, &types.Deposit{
+		Pubkey: [48]byte{0x01},
+	}) {
+		_, err = beaconState.ValidatorIndexByPubkey(dep.Pubkey)
+		require.NoError(t, err)
+	}
+	require.ErrorIs(
+		t,
+		processBlock(
+			eth1Data(3),
+			contractDeposits[2:],
+			new(engineprimitives.ExecutionRequests),
+		),
+		core.ErrDepositCountMismatch,
+	)
+}
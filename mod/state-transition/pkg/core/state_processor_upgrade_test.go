@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessSlots_UpgradeToElectra(t *testing.T) {
+	specData := spec.BaseSpec()
+	specData.DepositEth1ChainID = spec.BetnetEth1ChainID
+	specData.DenebPlusForkEpoch = 0
+	specData.ElectraForkEpoch = 1
+	cs := chain.NewChainSpec(specData)
+
+	execEngine := mocks.NewExecutionEngine[
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		engineprimitives.Withdrawals,
+	](t)
+	mocksSigner := &cryptomocks.BLSSigner{}
+	mocksSigner.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	depositStore, err := initDepositStore()
+	require.NoError(t, err)
+	sp := createStateProcessor(
+		cs,
+		execEngine,
+		depositStore,
+		mocksSigner,
+		dummyProposerAddressVerifier,
+	)
+
+	kvStore, err := initStore()
+	require.NoError(t, err)
+	beaconState := new(TestBeaconStateT).NewFromDB(kvStore, cs)
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		beaconState,
+		[]*types.Deposit{{
+			Pubkey: [48]byte{0x01},
+			Amount: math.Gwei(cs.MaxEffectiveBalance()),
+			Index:  0,
+		}},
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.DenebPlus),
+	)
+	require.NoError(t, err)
+
+	// The state only commits to the deposit requests start index from the
+	// first slot of Electra on.
+	lastDenebPlusSlot := math.Slot(cs.SlotsPerEpoch() - 1)
+	_, err = sp.ProcessSlots(beaconState, lastDenebPlusSlot)
+	require.NoError(t, err)
+	bsm, err := beaconState.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, version.DenebPlus, bsm.Version())
+
+	_, err = sp.ProcessSlots(beaconState, lastDenebPlusSlot+1)
+	require.NoError(t, err)
+	bsm, err = beaconState.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, bsm.Version())
+
+	startIndex, err := beaconState.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, constants.UnsetDepositRequestsStartIndex, startIndex)
+
+	root := beaconState.HashTreeRoot()
+	require.NoError(t, beaconState.SetDepositRequestsStartIndex(5))
+	require.NotEqual(t, root, beaconState.HashTreeRoot())
+}
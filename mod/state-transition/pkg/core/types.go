@@ -24,6 +24,7 @@ import (
 	stdbytes "bytes"
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -37,9 +38,10 @@ import (
 type BeaconBlock[
 	DepositT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -64,6 +66,7 @@ type BeaconBlock[
 type BeaconBlockBody[
 	BeaconBlockBodyT any,
 	DepositT any,
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -75,8 +78,15 @@ type BeaconBlockBody[
 	GetRandaoReveal() crypto.BLSSignature
 	// GetExecutionPayload returns the execution payload.
 	GetExecutionPayload() ExecutionPayloadT
+	// GetEth1Data returns the eth1 data voted for by the block.
+	GetEth1Data() Eth1DataT
 	// GetDeposits returns the list of deposits.
 	GetDeposits() []DepositT
+	// GetVoluntaryExits returns the list of voluntary exits.
+	GetVoluntaryExits() []*types.SignedVoluntaryExit
+	// GetExecutionRequests returns the execution requests, which are nil
+	// before Electra.
+	GetExecutionRequests() *engineprimitives.ExecutionRequests
 	// HashTreeRoot returns the hash tree root of the block body.
 	HashTreeRoot() common.Root
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
@@ -127,11 +137,31 @@ type Context interface {
 	GetNextPayloadTimestamp() math.U64
 }
 
+// TelemetrySink is an interface for tracing the state transition.
+type TelemetrySink interface {
+	// StartSpan starts a span, returning the context carrying it and a
+	// function to end it.
+	StartSpan(
+		ctx context.Context, name string, args ...any,
+	) (context.Context, func(error))
+}
+
 // Deposit is the interface for a deposit.
 type Deposit[
+	DepositT any,
 	ForkDataT any,
 	WithdrawlCredentialsT ~[32]byte,
 ] interface {
+	// New creates a new deposit with the given parameters.
+	New(
+		pubkey crypto.BLSPubkey,
+		credentials WithdrawlCredentialsT,
+		amount math.Gwei,
+		signature crypto.BLSSignature,
+		index uint64,
+	) DepositT
+	// GetIndex returns the index of the deposit.
+	GetIndex() math.U64
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
 	// GetPubkey returns the public key of the validator.
@@ -147,6 +177,19 @@ type Deposit[
 			message []byte, signature crypto.BLSSignature,
 		) error,
 	) error
+	// HashTreeRoot returns the hash tree root of the deposit.
+	HashTreeRoot() common.Root
+}
+
+// DepositStore defines the interface for the store of the deposits made to
+// the deposit contract.
+type DepositStore[DepositT any] interface {
+	// GetDepositsByIndex returns `numView` deposits starting from the given
+	// index.
+	GetDepositsByIndex(startIndex uint64, numView uint64) ([]DepositT, error)
+	// GetDepositRoot returns the root of the deposit tree when it held the
+	// given number of deposits.
+	GetDepositRoot(count uint64) (common.Root, error)
 }
 
 type ExecutionPayload[
@@ -232,6 +275,9 @@ type Validator[
 	IsSlashed() bool
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
 	// GetEffectiveBalance returns the effective balance of the validator in
 	// Gwei.
 	GetEffectiveBalance() math.Gwei
@@ -239,6 +285,12 @@ type Validator[
 	SetEffectiveBalance(math.Gwei)
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
+	// GetExitEpoch returns the epoch when the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch when the validator exits.
+	SetExitEpoch(math.Epoch)
 }
 
 type Validators interface {
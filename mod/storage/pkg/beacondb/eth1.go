@@ -20,6 +20,12 @@
 
 package beacondb
 
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+)
+
 // GetLatestExecutionPayloadHeader retrieves the latest execution payload
 // header from the BeaconStore.
 func (kv *KVStore[
@@ -72,6 +78,32 @@ func (kv *KVStore[
 	return kv.eth1DepositIndex.Set(kv.ctx, index)
 }
 
+// GetDepositRequestsStartIndex retrieves the deposit requests start index
+// from the beacon state, which is unset until the first deposit request.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetDepositRequestsStartIndex() (uint64, error) {
+	index, err := kv.depositRequestsStartIndex.Get(kv.ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return constants.UnsetDepositRequestsStartIndex, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return index, nil
+}
+
+// SetDepositRequestsStartIndex sets the deposit requests start index in the
+// beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetDepositRequestsStartIndex(
+	index uint64,
+) error {
+	return kv.depositRequestsStartIndex.Set(kv.ctx, index)
+}
+
 // GetEth1Data retrieves the eth1 data from the beacon state.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
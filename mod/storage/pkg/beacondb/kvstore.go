@@ -75,6 +75,9 @@ type KVStore[
 	eth1Data sdkcollections.Item[Eth1DataT]
 	// eth1DepositIndex is the index of the latest eth1 deposit.
 	eth1DepositIndex sdkcollections.Item[uint64]
+	// depositRequestsStartIndex is the index of the first deposit made
+	// through a deposit request.
+	depositRequestsStartIndex sdkcollections.Item[uint64]
 	// latestExecutionPayloadVersion stores the latest execution payload
 	// version.
 	latestExecutionPayloadVersion sdkcollections.Item[uint32]
@@ -188,6 +191,14 @@ func New[
 			keys.Eth1DepositIndexPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		depositRequestsStartIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.DepositRequestsStartIndexPrefix},
+			),
+			keys.DepositRequestsStartIndexPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
 		latestExecutionPayloadVersion: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
@@ -306,3 +317,13 @@ func (kv *KVStore[
 	cpy.ctx = ctx
 	return &cpy
 }
+
+// Upgrade upgrades the state to the fork of the given version. It is a no-op,
+// as each field of the state is kept in a collection of its own rather than in
+// a tree whose layout depends on the fork.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) Upgrade(uint32) error {
+	return nil
+}
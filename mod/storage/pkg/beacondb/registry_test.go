@@ -206,9 +206,24 @@ func initTestStore() (
 		*types.Validator,
 		[]*types.Validator,
 	], error) {
+	store, _, err := initTestStoreWithContext()
+	return store, err
+}
+
+// initTestStoreWithContext returns a test beacon store along with the context
+// of the underlying store, so that raw store entries can be inspected.
+func initTestStoreWithContext() (
+	*beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		[]*types.Validator,
+	], sdk.Context, error) {
 	db, err := db.OpenDB("", dbm.MemDBBackend)
 	if err != nil {
-		return nil, fmt.Errorf("failed opening mem db: %w", err)
+		return nil, sdk.Context{}, fmt.Errorf("failed opening mem db: %w", err)
 	}
 	var (
 		nopLog     = log.NewNopLogger()
@@ -224,7 +239,9 @@ func initTestStore() (
 	ctx := sdk.NewContext(cms, true, nopLog)
 	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
 	if err = cms.LoadLatestVersion(); err != nil {
-		return nil, fmt.Errorf("failed to load latest version: %w", err)
+		return nil, sdk.Context{}, fmt.Errorf(
+			"failed to load latest version: %w", err,
+		)
 	}
 	testStoreService := &testKVStoreService{
 		ctx: ctx,
@@ -240,5 +257,5 @@ func initTestStore() (
 	](
 		testStoreService,
 		testCodec,
-	), nil
+	), ctx, nil
 }
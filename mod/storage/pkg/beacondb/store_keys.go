@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+)
+
+// The functions below return the raw keys that values are stored under in
+// the beacon store. They allow callers to request merkle proofs of the
+// underlying store for individual values of the beacon state.
+
+// LatestBlockHeaderKey returns the key of the latest beacon block header.
+func LatestBlockHeaderKey() []byte {
+	return []byte{keys.LatestBeaconBlockHeaderPrefix}
+}
+
+// ValidatorKey returns the key of the validator at the given index.
+func ValidatorKey(index uint64) ([]byte, error) {
+	return sdkcollections.EncodeKeyWithPrefix(
+		[]byte{keys.ValidatorByIndexPrefix}, sdkcollections.Uint64Key, index,
+	)
+}
+
+// BalanceKey returns the key of the balance of the validator at the given
+// index.
+func BalanceKey(index uint64) ([]byte, error) {
+	return sdkcollections.EncodeKeyWithPrefix(
+		[]byte{keys.BalancesPrefix}, sdkcollections.Uint64Key, index,
+	)
+}
+
+// RandaoMixKey returns the key of the RANDAO mix at the given index.
+func RandaoMixKey(index uint64) ([]byte, error) {
+	return sdkcollections.EncodeKeyWithPrefix(
+		[]byte{keys.RandaoMixPrefix}, sdkcollections.Uint64Key, index,
+	)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreKeys(t *testing.T) {
+	store, ctx, err := initTestStoreWithContext()
+	require.NoError(t, err)
+	raw := ctx.KVStore(testStoreKey)
+
+	// latest block header
+	header := &types.BeaconBlockHeader{
+		Slot:          10,
+		ProposerIndex: 1,
+		BodyRoot:      common.Root{0x01},
+	}
+	require.NoError(t, store.SetLatestBlockHeader(header))
+	headerBz, err := header.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, headerBz, raw.Get(beacondb.LatestBlockHeaderKey()))
+
+	// validator
+	val := &types.Validator{
+		Pubkey:           bytes.B48{0x01},
+		EffectiveBalance: 32e9,
+	}
+	require.NoError(t, store.AddValidator(val))
+	valIdx, err := store.ValidatorIndexByPubkey(val.GetPubkey())
+	require.NoError(t, err)
+	valKey, err := beacondb.ValidatorKey(valIdx.Unwrap())
+	require.NoError(t, err)
+	valBz, err := val.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, valBz, raw.Get(valKey))
+
+	// balance
+	require.NoError(t, store.SetBalance(valIdx, math.Gwei(32e9)))
+	balanceKey, err := beacondb.BalanceKey(valIdx.Unwrap())
+	require.NoError(t, err)
+	balanceBz, err := sdkcollections.Uint64Value.Encode(32e9)
+	require.NoError(t, err)
+	require.Equal(t, balanceBz, raw.Get(balanceKey))
+
+	// randao mix
+	mix := common.Bytes32{0x0a, 0x0b}
+	require.NoError(t, store.UpdateRandaoMixAtIndex(5, mix))
+	mixKey, err := beacondb.RandaoMixKey(5)
+	require.NoError(t, err)
+	require.Equal(t, mix[:], raw.Get(mixKey))
+}
@@ -21,78 +21,147 @@
 package block
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
-	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
-// KVStore is a simple memory store based implementation that stores metadata of
-// beacon blocks.
-type KVStore[BeaconBlockT BeaconBlock] struct {
+const (
+	// KeyBlocksPrefix is the prefix for the slot to block mapping.
+	KeyBlocksPrefix = "blocks"
+	// KeyBlockRootsPrefix is the prefix for the block root to slot mapping.
+	KeyBlockRootsPrefix = "block_roots"
+	// KeyTimestampsPrefix is the prefix for the timestamp to slot mapping.
+	KeyTimestampsPrefix = "timestamps"
+	// KeyStateRootsPrefix is the prefix for the state root to slot mapping.
+	KeyStateRootsPrefix = "state_roots"
+)
+
+// ErrBlockNotFound is returned when no block is stored at the requested slot.
+var ErrBlockNotFound = errors.New("block not found")
+
+// KVStore is a KV store based implementation that persists SSZ encoded
+// beacon blocks keyed by slot, along with secondary indexes by block root,
+// timestamp, and state root.
+type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
+	// Slot to beacon block mapping, holding the full SSZ encoded block.
+	blocks sdkcollections.Map[math.Slot, BeaconBlockT]
+
 	// Beacon block root to slot mapping is injective for finalized blocks.
-	blockRoots *lru.Cache[common.Root, math.Slot]
+	blockRoots sdkcollections.Map[[]byte, math.Slot]
 
 	// Timestamp to slot mapping is injective for finalized blocks. This is
 	// guaranteed by CometBFT consensus. So each slot will be associated with a
 	// different timestamp (no overwriting) as we store only finalized blocks.
-	timestamps *lru.Cache[math.U64, math.Slot]
+	timestamps sdkcollections.Map[math.U64, math.Slot]
 
 	// Beacon state root to slot mapping is injective for finalized blocks.
-	stateRoots *lru.Cache[common.Root, math.Slot]
+	stateRoots sdkcollections.Map[[]byte, math.Slot]
+
+	// mu protects the maps above from concurrent writes and prunes.
+	mu sync.RWMutex
 
 	// Logger for the store.
 	logger log.Logger
 }
 
 // NewStore creates a new block store.
-func NewStore[BeaconBlockT BeaconBlock](
+func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
+	kvsp store.KVStoreService,
 	logger log.Logger,
-	availabilityWindow int,
 ) *KVStore[BeaconBlockT] {
-	blockRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	return &KVStore[BeaconBlockT]{
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyBlocksPrefix)),
+			KeyBlocksPrefix,
+			encoding.U64Key,
+			encoding.SSZValueCodec[BeaconBlockT]{},
+		),
+		blockRoots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyBlockRootsPrefix)),
+			KeyBlockRootsPrefix,
+			sdkcollections.BytesKey,
+			encoding.U64Value,
+		),
+		timestamps: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyTimestampsPrefix)),
+			KeyTimestampsPrefix,
+			encoding.U64Key,
+			encoding.U64Value,
+		),
+		stateRoots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyStateRootsPrefix)),
+			KeyStateRootsPrefix,
+			sdkcollections.BytesKey,
+			encoding.U64Value,
+		),
+		logger: logger,
 	}
-	timestamps, err := lru.New[math.U64, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
+}
+
+// Set persists the block by its slot in the store, along with indexes for
+// the block root, timestamp, and state root.
+func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
+	var (
+		ctx       = context.TODO()
+		slot      = blk.GetSlot()
+		blockRoot = blk.HashTreeRoot()
+		stateRoot = blk.GetStateRoot()
+	)
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err := kv.blocks.Set(ctx, slot, blk); err != nil {
+		return err
 	}
-	stateRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
+	if err := kv.blockRoots.Set(ctx, blockRoot[:], slot); err != nil {
+		return err
 	}
-	return &KVStore[BeaconBlockT]{
-		blockRoots: blockRoots,
-		timestamps: timestamps,
-		stateRoots: stateRoots,
-		logger:     logger,
+	if err := kv.timestamps.Set(ctx, blk.GetTimestamp(), slot); err != nil {
+		return err
 	}
+	return kv.stateRoots.Set(ctx, stateRoot[:], slot)
 }
 
-// Set sets the block by a given index in the store, storing the block root,
-// timestamp, and state root. Only this function may potentially evict
-// entries from the store if the availability window is reached.
-func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
-	slot := blk.GetSlot()
-	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
-	kv.timestamps.Add(blk.GetTimestamp(), slot)
-	kv.stateRoots.Add(blk.GetStateRoot(), slot)
-	return nil
+// GetBlockBySlot retrieves the full beacon block at the given slot from the
+// store.
+func (kv *KVStore[BeaconBlockT]) GetBlockBySlot(
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	blk, err := kv.blocks.Get(context.TODO(), slot)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return blk, errors.Wrapf(ErrBlockNotFound, "slot %d", slot)
+	}
+	return blk, err
 }
 
-// GetSlotByRoot retrieves the slot by a given block root from the store.
+// GetSlotByBlockRoot retrieves the slot by a given block root from the store.
 func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 	blockRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.blockRoots.Peek(blockRoot)
-	if !ok {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	slot, err := kv.blockRoots.Get(context.TODO(), blockRoot[:])
+	if errors.Is(err, sdkcollections.ErrNotFound) {
 		return 0, fmt.Errorf("slot not found at block root: %s", blockRoot)
 	}
-	return slot, nil
+	return slot, err
 }
 
 // GetParentSlotByTimestamp retrieves the parent slot by a given timestamp from
@@ -100,9 +169,13 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(
 	timestamp math.U64,
 ) (math.Slot, error) {
-	slot, ok := kv.timestamps.Peek(timestamp)
-	if !ok {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	slot, err := kv.timestamps.Get(context.TODO(), timestamp)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
 		return slot, fmt.Errorf("slot not found at timestamp: %d", timestamp)
+	} else if err != nil {
+		return slot, err
 	}
 	if slot == 0 {
 		return slot, errors.New("parent slot not supported for genesis slot 0")
@@ -115,9 +188,64 @@ func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(
 func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(
 	stateRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.stateRoots.Peek(stateRoot)
-	if !ok {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	slot, err := kv.stateRoots.Get(context.TODO(), stateRoot[:])
+	if errors.Is(err, sdkcollections.ErrNotFound) {
 		return 0, fmt.Errorf("slot not found at state root: %s", stateRoot)
 	}
-	return slot, nil
+	return slot, err
+}
+
+// Prune removes the blocks in the slot range [start, end) from the store,
+// along with their block root, timestamp, and state root indexes.
+func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
+	if start > end {
+		return pruner.ErrInvalidRange
+	}
+
+	var ctx = context.TODO()
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	// Collect the stored blocks in range first, as the underlying iterator
+	// must not be used while the store is being written to.
+	iter, err := kv.blocks.Iterate(
+		ctx, new(sdkcollections.Range[math.Slot]).
+			StartInclusive(math.Slot(start)).
+			EndExclusive(math.Slot(end)),
+	)
+	if err != nil {
+		return err
+	}
+	blks, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	for _, blk := range blks {
+		if err = kv.remove(ctx, blk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remove deletes the given block and all of its indexes from the store.
+func (kv *KVStore[BeaconBlockT]) remove(
+	ctx context.Context,
+	blk BeaconBlockT,
+) error {
+	blockRoot := blk.HashTreeRoot()
+	if err := kv.blockRoots.Remove(ctx, blockRoot[:]); err != nil {
+		return err
+	}
+	if err := kv.timestamps.Remove(ctx, blk.GetTimestamp()); err != nil {
+		return err
+	}
+	stateRoot := blk.GetStateRoot()
+	if err := kv.stateRoots.Remove(ctx, stateRoot[:]); err != nil {
+		return err
+	}
+	return kv.blocks.Remove(ctx, blk.GetSlot())
 }
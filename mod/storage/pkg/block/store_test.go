@@ -21,12 +21,17 @@
 package block_test
 
 import (
+	"encoding/binary"
 	"testing"
 
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,39 +39,63 @@ type MockBeaconBlock struct {
 	slot math.Slot
 }
 
-func (m MockBeaconBlock) GetSlot() math.Slot {
+func (m *MockBeaconBlock) Empty() *MockBeaconBlock {
+	return &MockBeaconBlock{}
+}
+
+func (m *MockBeaconBlock) MarshalSSZ() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, m.slot.Unwrap()), nil
+}
+
+func (m *MockBeaconBlock) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != 8 {
+		return errors.New("invalid mock block size")
+	}
+	m.slot = math.Slot(binary.LittleEndian.Uint64(bz))
+	return nil
+}
+
+func (m *MockBeaconBlock) GetSlot() math.Slot {
 	return m.slot
 }
 
-func (m MockBeaconBlock) HashTreeRoot() common.Root {
+func (m *MockBeaconBlock) HashTreeRoot() common.Root {
 	return [32]byte{byte(m.slot)}
 }
 
-func (m MockBeaconBlock) GetTimestamp() math.U64 {
+func (m *MockBeaconBlock) GetTimestamp() math.U64 {
 	return m.slot
 }
 
-func (m MockBeaconBlock) GetStateRoot() common.Root {
-	return [32]byte{byte(m.slot)}
+func (m *MockBeaconBlock) GetStateRoot() common.Root {
+	return [32]byte{0xff, byte(m.slot)}
 }
 
 func TestBlockStore(t *testing.T) {
-	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	db := storage.NewKVStoreProvider(storev2.NewMemDB())
+	blockStore := block.NewStore[*MockBeaconBlock](db, noop.NewLogger[any]())
 
 	var (
 		slot math.Slot
+		blk  *MockBeaconBlock
 		err  error
 	)
 
 	// Set 7 blocks.
-	// The latest block is 7 and should hold the last 5 blocks in the window.
 	for i := 1; i <= 7; i++ {
 		err = blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)})
 		require.NoError(t, err)
 	}
 
-	// Get the slots by roots & timestamps.
+	// Prune the first 2 blocks, keeping the last 5 blocks in the window.
+	require.NoError(t, blockStore.Prune(0, 3))
+
+	// Get the blocks and slots by roots & timestamps.
 	for i := math.Slot(3); i <= 7; i++ {
+		blk, err = blockStore.GetBlockBySlot(i)
+		require.NoError(t, err)
+		require.Equal(t, i, blk.GetSlot())
+
 		slot, err = blockStore.GetSlotByBlockRoot([32]byte{byte(i)})
 		require.NoError(t, err)
 		require.Equal(t, i, slot)
@@ -75,7 +104,7 @@ func TestBlockStore(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, i-1, slot)
 
-		slot, err = blockStore.GetSlotByStateRoot([32]byte{byte(i)})
+		slot, err = blockStore.GetSlotByStateRoot([32]byte{0xff, byte(i)})
 		require.NoError(t, err)
 		require.Equal(t, i, slot)
 	}
@@ -85,4 +114,27 @@ func TestBlockStore(t *testing.T) {
 	require.ErrorContains(t, err, "not found")
 	_, err = blockStore.GetParentSlotByTimestamp(2)
 	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetSlotByStateRoot([32]byte{0xff, byte(1)})
+	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetBlockBySlot(2)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+
+	// An invalid range must not be pruned.
+	require.ErrorIs(t, blockStore.Prune(5, 4), pruner.ErrInvalidRange)
+}
+
+func TestBlockStoreReopen(t *testing.T) {
+	db := storage.NewKVStoreProvider(storev2.NewMemDB())
+	blockStore := block.NewStore[*MockBeaconBlock](db, noop.NewLogger[any]())
+	require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: 42}))
+
+	// A new store over the same backing database must serve the same blocks.
+	reopened := block.NewStore[*MockBeaconBlock](db, noop.NewLogger[any]())
+	blk, err := reopened.GetBlockBySlot(42)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(42), blk.GetSlot())
+
+	slot, err := reopened.GetSlotByBlockRoot([32]byte{42})
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(42), slot)
 }
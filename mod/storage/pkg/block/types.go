@@ -22,12 +22,16 @@ package block
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // BeaconBlock is a block in the beacon chain that has a slot, block root (hash
-// tree root), timestamp, and state root.
-type BeaconBlock interface {
+// tree root), timestamp, and state root. It must be SSZ marshallable so that it
+// can be persisted in the store.
+type BeaconBlock[BeaconBlockT any] interface {
+	constraints.SSZMarshallable
+	constraints.Empty[BeaconBlockT]
 	GetSlot() math.U64
 	HashTreeRoot() common.Root
 	GetTimestamp() math.U64
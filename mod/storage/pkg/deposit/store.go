@@ -27,16 +27,32 @@ import (
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix      = "deposit"
+	KeyDepositRootsPrefix = "roots"
+	KeyDepositTreePrefix  = "tree"
+)
+
+// ErrDepositRootNotFound is returned when the deposit tree never held the
+// requested number of deposits.
+var ErrDepositRootNotFound = errors.New("deposit root not found")
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
+//
+// Alongside the deposits, it maintains the deposit tree over all the
+// deposits enqueued in index order, and the root of that tree at every
+// deposit count, so that the deposit roots carried by eth1 data can be
+// verified.
 type KVStore[DepositT Deposit[DepositT]] struct {
 	store sdkcollections.Map[uint64, DepositT]
+	roots sdkcollections.Map[uint64, []byte]
+	tree  sdkcollections.Item[[]byte]
 	mu    sync.RWMutex
 }
 
@@ -53,6 +69,19 @@ func NewStore[DepositT Deposit[DepositT]](
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		roots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyDepositRootsPrefix)),
+			KeyDepositRootsPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		tree: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyDepositTreePrefix)),
+			KeyDepositTreePrefix,
+			sdkcollections.BytesValue,
+		),
 	}
 }
 
@@ -79,11 +108,33 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	return deposits, nil
 }
 
+// GetDepositRoot returns the root of the deposit tree when it held the given
+// number of deposits.
+func (kv *KVStore[DepositT]) GetDepositRoot(count uint64) (common.Root, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	if count == 0 {
+		return new(depositTree).root(), nil
+	}
+
+	root, err := kv.roots.Get(context.TODO(), count)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return common.Root{}, ErrDepositRootNotFound
+	}
+	if err != nil {
+		return common.Root{}, err
+	}
+	return common.Root(root), nil
+}
+
 // EnqueueDeposit pushes the deposit to the queue.
 func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	return kv.setDeposit(deposit)
+	if err := kv.setDeposit(deposit); err != nil {
+		return err
+	}
+	return kv.extendTree()
 }
 
 // EnqueueDeposits pushes multiple deposits to the queue.
@@ -95,7 +146,7 @@ func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 			return err
 		}
 	}
-	return nil
+	return kv.extendTree()
 }
 
 // setDeposit sets the deposit in the store.
@@ -103,7 +154,51 @@ func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
 	return kv.store.Set(context.TODO(), deposit.GetIndex().Unwrap(), deposit)
 }
 
-// Prune removes the [start, end) deposits from the store.
+// extendTree appends to the deposit tree the stored deposits that directly
+// follow the deposits already in the tree, recording the root of the tree
+// after each of them.
+func (kv *KVStore[DepositT]) extendTree() error {
+	var (
+		ctx  = context.TODO()
+		tree depositTree
+	)
+	switch buf, err := kv.tree.Get(ctx); {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+	case err != nil:
+		return err
+	default:
+		if err = tree.unmarshal(buf); err != nil {
+			return err
+		}
+	}
+
+	initial := tree.count
+	for {
+		deposit, err := kv.store.Get(ctx, tree.count)
+		if errors.Is(err, sdkcollections.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = tree.push(deposit.GetDepositDataRoot()); err != nil {
+			return err
+		}
+		root := tree.root()
+		if err = kv.roots.Set(ctx, tree.count, root[:]); err != nil {
+			return err
+		}
+	}
+
+	if tree.count == initial {
+		return nil
+	}
+	return kv.tree.Set(ctx, tree.marshal())
+}
+
+// Prune removes the [start, end) deposits from the store, along with the
+// deposit roots for the counts in [start, end).
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	if start > end {
 		return pruner.ErrInvalidRange
@@ -118,5 +213,10 @@ func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 			return err
 		}
 	}
+	for count := start; count < end; count++ {
+		if err := kv.roots.Remove(ctx, count); err != nil {
+			return err
+		}
+	}
 	return nil
 }
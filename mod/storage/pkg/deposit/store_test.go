@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"testing"
+
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeposit(index uint64) *types.Deposit {
+	return types.NewDeposit(
+		crypto.BLSPubkey{byte(index)},
+		types.WithdrawalCredentials{},
+		math.Gwei(index+1),
+		crypto.BLSSignature{},
+		index,
+	)
+}
+
+func expectedRoot(t *testing.T, deposits []*types.Deposit) common.Root {
+	t.Helper()
+	leaves := make([]common.Root, len(deposits))
+	for i, d := range deposits {
+		leaves[i] = d.GetDepositDataRoot()
+	}
+	tree, err := merkle.NewTreeFromLeavesWithDepth(
+		leaves, deposit.DepositTreeDepth,
+	)
+	require.NoError(t, err)
+	return tree.HashTreeRoot()
+}
+
+func TestDepositRoots(t *testing.T) {
+	db := storage.NewKVStoreProvider(storev2.NewMemDB())
+	store := deposit.NewStore[*types.Deposit](db)
+
+	deposits := make([]*types.Deposit, 9)
+	for i := range deposits {
+		deposits[i] = newDeposit(uint64(i))
+	}
+
+	// Enqueue deposit 3 ahead of deposits 1 and 2, the tree only extends
+	// over contiguous deposits.
+	require.NoError(t, store.EnqueueDeposit(deposits[0]))
+	require.NoError(t, store.EnqueueDeposit(deposits[3]))
+	_, err := store.GetDepositRoot(2)
+	require.ErrorIs(t, err, deposit.ErrDepositRootNotFound)
+
+	require.NoError(t, store.EnqueueDeposits(deposits[1:3]))
+	for count := uint64(1); count <= 4; count++ {
+		var root common.Root
+		root, err = store.GetDepositRoot(count)
+		require.NoError(t, err)
+		require.Equal(t, expectedRoot(t, deposits[:count]), root)
+	}
+
+	// The tree is persisted, so a reopened store keeps extending it.
+	store = deposit.NewStore[*types.Deposit](db)
+	require.NoError(t, store.EnqueueDeposits(deposits[4:]))
+	for count := uint64(5); count <= uint64(len(deposits)); count++ {
+		var root common.Root
+		root, err = store.GetDepositRoot(count)
+		require.NoError(t, err)
+		require.Equal(t, expectedRoot(t, deposits[:count]), root)
+	}
+
+	_, err = store.GetDepositRoot(uint64(len(deposits)) + 1)
+	require.ErrorIs(t, err, deposit.ErrDepositRootNotFound)
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// DepositTreeDepth is the depth of the deposit merkle tree, matching the
+// deposit contract.
+const DepositTreeDepth = 32
+
+// depositTreeSize is the size of an encoded deposit tree.
+const depositTreeSize = DepositTreeDepth*32 + 8
+
+var (
+	// ErrDepositTreeFull is returned when no more deposits fit in the tree.
+	ErrDepositTreeFull = errors.New("deposit tree is full")
+	// ErrInvalidDepositTree is returned when a stored deposit tree cannot
+	// be decoded.
+	ErrInvalidDepositTree = errors.New("invalid deposit tree encoding")
+)
+
+// depositTree is the incremental merkle tree of the deposit contract, as
+// described in the deposit contract's `deposit` and `get_deposit_root`.
+// Only the left-most branch of the tree is kept, which is enough to append
+// leaves and to compute the root over all the leaves appended so far.
+type depositTree struct {
+	// branch holds, for each height, the root of the last complete subtree
+	// to the left of the next leaf.
+	branch [DepositTreeDepth]common.Root
+	// count is the number of leaves in the tree.
+	count uint64
+}
+
+// push appends the given leaf to the tree.
+func (t *depositTree) push(leaf common.Root) error {
+	if t.count >= 1<<DepositTreeDepth-1 {
+		return ErrDepositTreeFull
+	}
+
+	t.count++
+	size, node := t.count, leaf
+	for height := range DepositTreeDepth {
+		if size&1 == 1 {
+			t.branch[height] = node
+			return nil
+		}
+		node = hashPair(t.branch[height], node)
+		size >>= 1
+	}
+	return nil
+}
+
+// root returns the root of the tree mixed in with the number of leaves,
+// i.e. the hash tree root of the list of deposits.
+func (t *depositTree) root() common.Root {
+	var node common.Root
+	size := t.count
+	for height := range DepositTreeDepth {
+		if size&1 == 1 {
+			node = hashPair(t.branch[height], node)
+		} else {
+			node = hashPair(node, zero.Hashes[height])
+		}
+		size >>= 1
+	}
+
+	var length common.Root
+	binary.LittleEndian.PutUint64(length[:], t.count)
+	return hashPair(node, length)
+}
+
+// marshal encodes the tree as its branch followed by its count.
+func (t *depositTree) marshal() []byte {
+	buf := make([]byte, 0, depositTreeSize)
+	for _, node := range t.branch {
+		buf = append(buf, node[:]...)
+	}
+	return binary.LittleEndian.AppendUint64(buf, t.count)
+}
+
+// unmarshal decodes a tree encoded with marshal.
+func (t *depositTree) unmarshal(buf []byte) error {
+	if len(buf) != depositTreeSize {
+		return ErrInvalidDepositTree
+	}
+	for i := range t.branch {
+		copy(t.branch[i][:], buf[i*32:(i+1)*32])
+	}
+	t.count = binary.LittleEndian.Uint64(buf[DepositTreeDepth*32:])
+	return nil
+}
+
+// hashPair returns the hash of the concatenation of the two nodes.
+func hashPair(left, right [32]byte) common.Root {
+	return sha256.Hash(append(left[:], right[:]...))
+}
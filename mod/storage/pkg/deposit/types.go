@@ -21,6 +21,7 @@
 package deposit
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -30,4 +31,7 @@ type Deposit[DepositT any] interface {
 	constraints.SSZMarshallable
 	constraints.Empty[DepositT]
 	GetIndex() math.U64
+	// GetDepositDataRoot returns the root of the deposit as a leaf of the
+	// deposit contract's deposit tree.
+	GetDepositDataRoot() common.Root
 }
@@ -23,12 +23,15 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/spf13/afero"
 )
 
 // two is a constant for the number 2.
@@ -67,6 +70,38 @@ func (db *RangeDB) Has(index uint64, key []byte) (bool, error) {
 	return db.DB.Has(db.prefix(index, key))
 }
 
+// GetByIndex retrieves all the values stored under the given index, ordered
+// by their key. It returns an empty slice if nothing is stored at the index.
+func (db *RangeDB) GetByIndex(index uint64) ([][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: get by index not supported for this db")
+	}
+
+	path := strconv.FormatUint(index, 10)
+	entries, err := afero.ReadDir(f.fs, path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return [][]byte{}, nil
+		}
+		return nil, err
+	}
+
+	values := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		var value []byte
+		value, err = afero.ReadFile(f.fs, filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
 // Set stores the value with the given index and key in the database.
 // It prefixes the key with the index and a slash before storing it in the
 // underlying database.
@@ -96,6 +96,29 @@ func TestRangeDB(t *testing.T) {
 				require.False(t, exists)
 			},
 		},
+		{
+			name: "GetByIndex",
+			setupFunc: func(rdb *file.RangeDB) error {
+				if err := rdb.Set(
+					6, []byte("testKey1"), []byte("testValue1"),
+				); err != nil {
+					return err
+				}
+				return rdb.Set(6, []byte("testKey2"), []byte("testValue2"))
+			},
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				values, err := rdb.GetByIndex(6)
+				require.NoError(t, err)
+				require.Equal(t, [][]byte{
+					[]byte("testValue1"), []byte("testValue2"),
+				}, values)
+
+				values, err = rdb.GetByIndex(7)
+				require.NoError(t, err)
+				require.Empty(t, values)
+			},
+		},
 		{
 			name: "DeleteRange",
 			setupFunc: func(rdb *file.RangeDB) error {
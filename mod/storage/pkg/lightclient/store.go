@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+)
+
+// KeyUpdatesPrefix is the prefix for the period to update mapping.
+const KeyUpdatesPrefix = "light_client_updates"
+
+// KVStore is a KV store based implementation that persists SSZ encoded light
+// client updates keyed by sync period. Only one update is kept per period.
+type KVStore[LightClientUpdateT LightClientUpdate[LightClientUpdateT]] struct {
+	// Period to light client update mapping.
+	updates sdkcollections.Map[math.U64, LightClientUpdateT]
+
+	// mu protects the updates from concurrent writes and reads.
+	mu sync.RWMutex
+
+	// Logger for the store.
+	logger log.Logger
+}
+
+// NewStore creates a new light client update store.
+func NewStore[LightClientUpdateT LightClientUpdate[LightClientUpdateT]](
+	kvsp store.KVStoreService,
+	logger log.Logger,
+) *KVStore[LightClientUpdateT] {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	return &KVStore[LightClientUpdateT]{
+		updates: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyUpdatesPrefix)),
+			KeyUpdatesPrefix,
+			encoding.U64Key,
+			encoding.SSZValueCodec[LightClientUpdateT]{},
+		),
+		logger: logger,
+	}
+}
+
+// Set persists the update as the update of the given period, replacing any
+// update previously stored for the period.
+func (kv *KVStore[LightClientUpdateT]) Set(
+	period math.U64,
+	update LightClientUpdateT,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.updates.Set(context.TODO(), period, update)
+}
+
+// Get returns the update stored for the given period, or the zero value if
+// no update is stored for the period.
+func (kv *KVStore[LightClientUpdateT]) Get(
+	period math.U64,
+) (LightClientUpdateT, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	update, err := kv.updates.Get(context.TODO(), period)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		var zero LightClientUpdateT
+		return zero, nil
+	}
+	return update, err
+}
+
+// GetRange returns the updates stored for the periods in the range
+// [start, end), in order of period. Periods without an update are skipped.
+func (kv *KVStore[LightClientUpdateT]) GetRange(
+	start, end math.U64,
+) ([]LightClientUpdateT, error) {
+	if start >= end {
+		return nil, nil
+	}
+
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.updates.Iterate(
+		context.TODO(), new(sdkcollections.Range[math.U64]).
+			StartInclusive(start).
+			EndExclusive(end),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
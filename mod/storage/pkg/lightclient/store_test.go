@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lightclient_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/lightclient"
+	"github.com/stretchr/testify/require"
+)
+
+type MockUpdate struct {
+	slot math.Slot
+}
+
+func (m *MockUpdate) Empty() *MockUpdate {
+	return &MockUpdate{}
+}
+
+func (m *MockUpdate) MarshalSSZ() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, m.slot.Unwrap()), nil
+}
+
+func (m *MockUpdate) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != 8 {
+		return errors.New("invalid mock update size")
+	}
+	m.slot = math.Slot(binary.LittleEndian.Uint64(bz))
+	return nil
+}
+
+func TestLightClientStore(t *testing.T) {
+	db := storage.NewKVStoreProvider(storev2.NewMemDB())
+	store := lightclient.NewStore[*MockUpdate](db, noop.NewLogger[any]())
+
+	// Store updates for periods 1, 2 and 4, overwriting the one of period 2.
+	require.NoError(t, store.Set(1, &MockUpdate{slot: 10}))
+	require.NoError(t, store.Set(2, &MockUpdate{slot: 20}))
+	require.NoError(t, store.Set(2, &MockUpdate{slot: 21}))
+	require.NoError(t, store.Set(4, &MockUpdate{slot: 40}))
+
+	update, err := store.Get(2)
+	require.NoError(t, err)
+	require.Equal(t, &MockUpdate{slot: 21}, update)
+
+	update, err = store.Get(3)
+	require.NoError(t, err)
+	require.Nil(t, update)
+
+	updates, err := store.GetRange(0, 5)
+	require.NoError(t, err)
+	require.Equal(t, []*MockUpdate{{slot: 10}, {slot: 21}, {slot: 40}}, updates)
+
+	updates, err = store.GetRange(2, 4)
+	require.NoError(t, err)
+	require.Equal(t, []*MockUpdate{{slot: 21}}, updates)
+
+	updates, err = store.GetRange(5, 10)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	updates, err = store.GetRange(4, 4)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+}
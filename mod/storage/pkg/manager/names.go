@@ -35,4 +35,6 @@ const (
 	BlockPrunerName = "block-store-pruner"
 	// BlockStoreName is the name of the block store.
 	BlockStoreName = "block-store"
+	// LightClientStoreName is the name of the light client store.
+	LightClientStoreName = "light-client-store"
 )
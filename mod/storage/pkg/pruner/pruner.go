@@ -81,7 +81,10 @@ func (p *pruner[_, PrunableT]) listen(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
-		case event := <-p.subBeaconBlockFinalized:
+		case event, ok := <-p.subBeaconBlockFinalized:
+			if !ok {
+				return
+			}
 			p.onFinalizeBlock(event)
 		}
 	}
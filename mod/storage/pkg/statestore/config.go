@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statestore
+
+// The backends the beacon state can be stored with.
+const (
+	// BackendKV stores each field of the beacon state in a collection of its
+	// own, and merkleizes the state whenever its root is needed.
+	BackendKV = "kv"
+	// BackendTree stores the beacon state as an SSZ Merkle tree keyed by
+	// generalized index, whose root is kept up to date on every write.
+	BackendTree = "tree"
+)
+
+// Config is the configuration for the beacon state store.
+type Config struct {
+	// Backend is the backend the beacon state is stored with, either "kv" or
+	// "tree". The backends lay the state out differently, so it must not be
+	// changed on a node that already has state.
+	Backend string `mapstructure:"backend"`
+}
+
+// DefaultConfig returns the default configuration for the beacon state store.
+func DefaultConfig() Config {
+	return Config{
+		Backend: BackendKV,
+	}
+}
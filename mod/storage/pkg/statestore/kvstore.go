@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statestore
+
+import (
+	"context"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/treedb"
+)
+
+// KVStore is a beacon state store that switches between the backends the
+// beacon state can be stored with. Its accessors are served by the backend
+// selected in its configuration.
+type KVStore[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	Eth1DataT Eth1Data[Eth1DataT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	ForkT Fork[ForkT],
+	ValidatorT beacondb.Validator[ValidatorT],
+	ValidatorsT ~[]ValidatorT,
+] struct {
+	beaconStore[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+		ForkT, ValidatorT, ValidatorsT,
+	]
+	// kv is the collections backed store, nil if the tree is used.
+	kv *beacondb.KVStore[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+		ForkT, ValidatorT, ValidatorsT,
+	]
+	// tree is the tree-backed store, nil if the collections are used.
+	tree *treedb.KVStore[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+		ForkT, ValidatorT, ValidatorsT,
+	]
+}
+
+// New creates a new beacon state store with the backend of the given
+// configuration. The state schema of each fork is only used by the tree
+// backend.
+func New[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	Eth1DataT Eth1Data[Eth1DataT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	ForkT Fork[ForkT],
+	ValidatorT beacondb.Validator[ValidatorT],
+	ValidatorsT ~[]ValidatorT,
+](
+	cfg Config,
+	kss store.KVStoreService,
+	payloadCodec *encoding.SSZInterfaceCodec[ExecutionPayloadHeaderT],
+	stateSchema func(forkVersion uint32) schema.SSZType,
+) (*KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+], error) {
+	kv := &KVStore[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+		ForkT, ValidatorT, ValidatorsT,
+	]{}
+	switch cfg.Backend {
+	case BackendKV:
+		kv.kv = beacondb.New[
+			BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+			ForkT, ValidatorT, ValidatorsT,
+		](kss, payloadCodec)
+		kv.beaconStore = kv.kv
+	case BackendTree:
+		kv.tree = treedb.New[
+			BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+			ForkT, ValidatorT, ValidatorsT,
+		](kss, stateSchema)
+		kv.beaconStore = kv.tree
+	default:
+		return nil, errors.Wrapf(ErrUnknownBackend, "%q", cfg.Backend)
+	}
+	return kv, nil
+}
+
+// Copy returns a copy of the Store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) Copy() *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+] {
+	cpy := *kv
+	if kv.tree != nil {
+		cpy.tree = kv.tree.Copy()
+		cpy.beaconStore = cpy.tree
+	} else {
+		cpy.kv = kv.kv.Copy()
+		cpy.beaconStore = cpy.kv
+	}
+	return &cpy
+}
+
+// WithContext returns a copy of the Store with the given context.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) WithContext(
+	ctx context.Context,
+) *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+] {
+	cpy := *kv
+	if kv.tree != nil {
+		cpy.tree = kv.tree.WithContext(ctx)
+		cpy.beaconStore = cpy.tree
+	} else {
+		cpy.kv = kv.kv.WithContext(ctx)
+		cpy.beaconStore = cpy.kv
+	}
+	return &cpy
+}
+
+// IsMerkleized returns true if the store keeps the beacon state as a Merkle
+// tree, whose root is then returned by HashTreeRoot.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) IsMerkleized() bool {
+	return kv.tree != nil
+}
+
+// HashTreeRoot returns the root of the beacon state if the store keeps it as
+// a Merkle tree.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) HashTreeRoot() (common.Root, error) {
+	if kv.tree == nil {
+		return common.Root{}, ErrNotMerkleized
+	}
+	return kv.tree.HashTreeRoot()
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statestore_test
+
+import (
+	"context"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/statestore"
+	"github.com/stretchr/testify/require"
+)
+
+// testKVStoreService is a store service whose stores are never opened, as
+// the tests do not access the state.
+type testKVStoreService struct{}
+
+func (testKVStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	return nil
+}
+
+func newTestKVStore(backend string) (*statestore.KVStore[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	[]*types.Validator,
+], error) {
+	return statestore.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		[]*types.Validator,
+	](
+		statestore.Config{Backend: backend},
+		testKVStoreService{},
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+		types.BeaconStateSchema,
+	)
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name       string
+		backend    string
+		merkleized bool
+		err        error
+	}{
+		{name: "kv", backend: statestore.BackendKV},
+		{name: "tree", backend: statestore.BackendTree, merkleized: true},
+		{
+			name:    "unknown",
+			backend: "iavl",
+			err:     statestore.ErrUnknownBackend,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv, err := newTestKVStore(tt.backend)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.merkleized, kv.IsMerkleized())
+			require.Equal(
+				t,
+				tt.merkleized,
+				kv.WithContext(context.Background()).IsMerkleized(),
+			)
+		})
+	}
+}
+
+func TestKVStore_HashTreeRootNotMerkleized(t *testing.T) {
+	kv, err := newTestKVStore(statestore.BackendKV)
+	require.NoError(t, err)
+	_, err = kv.HashTreeRoot()
+	require.ErrorIs(t, err, statestore.ErrNotMerkleized)
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package treedb
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GetLatestExecutionPayloadHeader retrieves the latest execution payload
+// header from the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetLatestExecutionPayloadHeader() (
+	ExecutionPayloadHeaderT, error,
+) {
+	var t ExecutionPayloadHeaderT
+	forkVersion, err := kv.latestExecutionPayloadVersion.Get(kv.ctx)
+	if err != nil {
+		return t, err
+	}
+	bz, err := kv.GetSSZ(latestExecutionPayloadHeaderPath)
+	if err != nil {
+		return t, err
+	}
+	return t.NewFromSSZ(bz, forkVersion)
+}
+
+// SetLatestExecutionPayloadHeader sets the latest execution payload header in
+// the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetLatestExecutionPayloadHeader(
+	payloadHeader ExecutionPayloadHeaderT,
+) error {
+	if err := kv.latestExecutionPayloadVersion.Set(
+		kv.ctx, payloadHeader.Version(),
+	); err != nil {
+		return err
+	}
+	return kv.setValue(latestExecutionPayloadHeaderPath, payloadHeader)
+}
+
+// GetEth1DepositIndex retrieves the eth1 deposit index from the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetEth1DepositIndex() (uint64, error) {
+	return kv.getUint64(eth1DepositIndexPath)
+}
+
+// SetEth1DepositIndex sets the eth1 deposit index in the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetEth1DepositIndex(
+	index uint64,
+) error {
+	return kv.setUint64(eth1DepositIndexPath, index)
+}
+
+// GetDepositRequestsStartIndex retrieves the deposit requests start index
+// from the state, which is unset before the state is upgraded to Electra.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetDepositRequestsStartIndex() (uint64, error) {
+	forkVersion, err := kv.getStateVersion()
+	if err != nil {
+		return 0, err
+	}
+	if forkVersion < version.Electra {
+		return constants.UnsetDepositRequestsStartIndex, nil
+	}
+	return kv.getUint64(depositRequestsStartIndexPath)
+}
+
+// SetDepositRequestsStartIndex sets the deposit requests start index in the
+// state, which must have been upgraded to Electra.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetDepositRequestsStartIndex(
+	index uint64,
+) error {
+	return kv.setUint64(depositRequestsStartIndexPath, index)
+}
+
+// GetEth1Data retrieves the eth1 data from the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetEth1Data() (Eth1DataT, error) {
+	bz, err := kv.GetSSZ(eth1DataPath)
+	if err != nil {
+		var t Eth1DataT
+		return t, err
+	}
+	return decode[Eth1DataT](bz)
+}
+
+// SetEth1Data sets the eth1 data in the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetEth1Data(
+	data Eth1DataT,
+) error {
+	return kv.setValue(eth1DataPath, data)
+}
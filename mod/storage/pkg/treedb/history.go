@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package treedb
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+
+// UpdateBlockRootAtIndex sets a block root in the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) UpdateBlockRootAtIndex(
+	index uint64,
+	root common.Root,
+) error {
+	return kv.setListElement(blockRootsPath, index, root[:])
+}
+
+// GetBlockRootAtIndex retrieves the block root from the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetBlockRootAtIndex(
+	index uint64,
+) (common.Root, error) {
+	bz, err := kv.getListElement(blockRootsPath, index)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return common.Root(bz), nil
+}
+
+// SetLatestBlockHeader sets the latest block header in the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetLatestBlockHeader(
+	header BeaconBlockHeaderT,
+) error {
+	return kv.setValue(latestBlockHeaderPath, header)
+}
+
+// GetLatestBlockHeader retrieves the latest block header from the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetLatestBlockHeader() (
+	BeaconBlockHeaderT, error,
+) {
+	bz, err := kv.GetSSZ(latestBlockHeaderPath)
+	if err != nil {
+		var t BeaconBlockHeaderT
+		return t, err
+	}
+	return decode[BeaconBlockHeaderT](bz)
+}
+
+// UpdateStateRootAtIndex updates the state root at the given slot.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) UpdateStateRootAtIndex(
+	idx uint64,
+	stateRoot common.Root,
+) error {
+	return kv.setListElement(stateRootsPath, idx, stateRoot[:])
+}
+
+// StateRootAtIndex returns the state root at the given slot.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) StateRootAtIndex(
+	idx uint64,
+) (common.Root, error) {
+	bz, err := kv.getListElement(stateRootsPath, idx)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return common.Root(bz), nil
+}
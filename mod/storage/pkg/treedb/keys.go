@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package treedb
+
+// Collection prefixes of the tree-backed store.
+const (
+	nodesPrefix byte = iota
+	latestExecutionPayloadVersionPrefix
+	validatorPubkeyToIndexPrefix
+	validatorConsAddrToIndexPrefix
+	stateVersionPrefix
+)
+
+// Human readable names of the collection prefixes of the tree-backed store.
+const (
+	nodesPrefixHumanReadable = "TreeNodesPrefix"
+	//nolint:lll // long name.
+	latestExecutionPayloadVersionPrefixHumanReadable = "TreeLatestExecutionPayloadVersionPrefix"
+	validatorPubkeyToIndexPrefixHumanReadable        = "TreeValidatorPubkeyToIndexPrefix"
+	validatorConsAddrToIndexPrefixHumanReadable      = "TreeValidatorConsAddrToIndexPrefix"
+	stateVersionPrefixHumanReadable                  = "TreeStateVersionPrefix"
+)
+
+// Object paths of the fields of the beacon state.
+const (
+	genesisValidatorsRootPath        = "genesis_validators_root"
+	slotPath                         = "slot"
+	forkPath                         = "fork"
+	latestBlockHeaderPath            = "latest_block_header"
+	blockRootsPath                   = "block_roots"
+	stateRootsPath                   = "state_roots"
+	eth1DataPath                     = "eth1_data"
+	eth1DepositIndexPath             = "eth1_deposit_index"
+	latestExecutionPayloadHeaderPath = "latest_execution_payload_header"
+	validatorsPath                   = "validators"
+	balancesPath                     = "balances"
+	randaoMixesPath                  = "randao_mixes"
+	nextWithdrawalIndexPath          = "next_withdrawal_index"
+	nextWithdrawalValidatorIndexPath = "next_withdrawal_validator_index"
+	slashingsPath                    = "slashings"
+	totalSlashingPath                = "total_slashing"
+	depositRequestsStartIndexPath    = "deposit_requests_start_index"
+)
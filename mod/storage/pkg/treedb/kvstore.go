@@ -0,0 +1,492 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package treedb
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/db"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// objectPath is an object path into the beacon state.
+type objectPath = merkle.ObjectPath[merkle.GeneralizedIndex, common.Root]
+
+// KVStore is a beacon state store that persists the state as an SSZ Merkle
+// tree keyed by generalized index. Writes only rehash the branches they touch,
+// reads are served by object path from the leaves of the tree and proofs are
+// built straight from the stored nodes.
+type KVStore[
+	BeaconBlockHeaderT interface {
+		constraints.Empty[BeaconBlockHeaderT]
+		constraints.SSZMarshallable
+	},
+	Eth1DataT interface {
+		constraints.Empty[Eth1DataT]
+		constraints.SSZMarshallable
+	},
+	ExecutionPayloadHeaderT interface {
+		constraints.SSZMarshallable
+		NewFromSSZ([]byte, uint32) (ExecutionPayloadHeaderT, error)
+		Version() uint32
+	},
+	ForkT interface {
+		constraints.Empty[ForkT]
+		constraints.SSZMarshallable
+	},
+	ValidatorT beacondb.Validator[ValidatorT],
+	ValidatorsT ~[]ValidatorT,
+] struct {
+	ctx context.Context
+	// stateSchema returns the schema of the beacon state in the fork of the
+	// given version.
+	stateSchema func(forkVersion uint32) schema.SSZType
+	// nodes stores the nodes of the state tree by generalized index.
+	nodes sdkcollections.Map[uint64, []byte]
+	// latestExecutionPayloadVersion stores the version of the latest
+	// execution payload header, which is needed to decode it.
+	latestExecutionPayloadVersion sdkcollections.Item[uint32]
+	// stateVersion stores the fork version of the layout of the state tree.
+	stateVersion sdkcollections.Item[uint32]
+	// pubkeyToIndex indexes the validators by pubkey.
+	pubkeyToIndex sdkcollections.Map[[]byte, uint64]
+	// consAddrToIndex indexes the validators by CometBFT address.
+	consAddrToIndex sdkcollections.Map[[]byte, uint64]
+}
+
+// New creates a new tree-backed store of a beacon state whose schema in each
+// fork is returned by the given function.
+func New[
+	BeaconBlockHeaderT interface {
+		constraints.Empty[BeaconBlockHeaderT]
+		constraints.SSZMarshallable
+	},
+	Eth1DataT interface {
+		constraints.Empty[Eth1DataT]
+		constraints.SSZMarshallable
+	},
+	ExecutionPayloadHeaderT interface {
+		constraints.SSZMarshallable
+		NewFromSSZ([]byte, uint32) (ExecutionPayloadHeaderT, error)
+		Version() uint32
+	},
+	ForkT interface {
+		constraints.Empty[ForkT]
+		constraints.SSZMarshallable
+	},
+	ValidatorT beacondb.Validator[ValidatorT],
+	ValidatorsT ~[]ValidatorT,
+](
+	kss store.KVStoreService,
+	stateSchema func(forkVersion uint32) schema.SSZType,
+) *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+] {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kss)
+	return &KVStore[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+		ForkT, ValidatorT, ValidatorsT,
+	]{
+		ctx:         nil,
+		stateSchema: stateSchema,
+		nodes: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{nodesPrefix}),
+			nodesPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		latestExecutionPayloadVersion: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{latestExecutionPayloadVersionPrefix},
+			),
+			latestExecutionPayloadVersionPrefixHumanReadable,
+			sdkcollections.Uint32Value,
+		),
+		stateVersion: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{stateVersionPrefix}),
+			stateVersionPrefixHumanReadable,
+			sdkcollections.Uint32Value,
+		),
+		pubkeyToIndex: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{validatorPubkeyToIndexPrefix}),
+			validatorPubkeyToIndexPrefixHumanReadable,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Value,
+		),
+		consAddrToIndex: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{validatorConsAddrToIndexPrefix},
+			),
+			validatorConsAddrToIndexPrefixHumanReadable,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Value,
+		),
+	}
+}
+
+// Copy returns a copy of the Store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) Copy() *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+] {
+	cctx, _ := sdk.UnwrapSDKContext(kv.ctx).CacheContext()
+	return kv.WithContext(cctx)
+}
+
+// Context returns the context of the Store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) Context() context.Context {
+	return kv.ctx
+}
+
+// WithContext returns a copy of the Store with the given context.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) WithContext(
+	ctx context.Context,
+) *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+] {
+	cpy := *kv
+	cpy.ctx = ctx
+	return &cpy
+}
+
+// IsMerkleized returns true, as the store keeps the state as a Merkle tree.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) IsMerkleized() bool {
+	return true
+}
+
+// HashTreeRoot returns the root of the state tree.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) HashTreeRoot() (common.Root, error) {
+	tree, err := kv.tree()
+	if err != nil {
+		return common.Root{}, err
+	}
+	return tree.HashTreeRoot()
+}
+
+// GetSSZ returns the SSZ serialization of the value at the given object path
+// of the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetSSZ(path objectPath) ([]byte, error) {
+	tree, err := kv.tree()
+	if err != nil {
+		return nil, err
+	}
+	return tree.GetSSZ(path)
+}
+
+// Prove returns a Merkle multiproof of the values at the given object paths
+// of the state against the root of the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) Prove(paths ...objectPath) (*db.Proof[common.Root], error) {
+	tree, err := kv.tree()
+	if err != nil {
+		return nil, err
+	}
+	return tree.Prove(paths...)
+}
+
+// Upgrade upgrades the state tree to the layout of the fork of the given
+// version. The state of a fork appends fields to the state of the fork before
+// it, so the nodes of the tree are moved down by the levels the tree grows by,
+// to the generalized indices of the same fields in the new layout.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) Upgrade(forkVersion uint32) error {
+	current, err := kv.getStateVersion()
+	if err != nil {
+		return err
+	}
+	from := log.ILog2Ceil(kv.stateSchema(current).HashChunkCount())
+	to := log.ILog2Ceil(kv.stateSchema(forkVersion).HashChunkCount())
+	if to < from {
+		return errors.Wrapf(
+			ErrStateDowngrade, "from fork %d to fork %d", current, forkVersion,
+		)
+	}
+	if err = kv.stateVersion.Set(kv.ctx, forkVersion); err != nil {
+		return err
+	}
+	if to == from {
+		return nil
+	}
+	return kv.moveNodes(to - from)
+}
+
+// moveNodes moves every node of the state tree down by the given number of
+// levels, below the leftmost node of that level, and rehashes the nodes above
+// it.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) moveNodes(levels uint8) error {
+	iter, err := kv.nodes.Iterate(kv.ctx, nil)
+	if err != nil {
+		return err
+	}
+	nodes, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+	if err = kv.nodes.Clear(kv.ctx, nil); err != nil {
+		return err
+	}
+
+	moved := make(map[merkle.GeneralizedIndex]common.Root, 1)
+	for _, node := range nodes {
+		// Inserting the levels as zero bits right below the leading bit of
+		// the generalized index descends to the leftmost node first.
+		//#nosec:G701 // bits.Len64 is at most 64.
+		depth := uint(bits.Len64(node.Key) - 1)
+		gIndex := node.Key + (1<<levels-1)<<depth
+		if node.Key == 1 {
+			moved[merkle.GeneralizedIndex(gIndex)] = common.Root(node.Value)
+			continue
+		}
+		if err = kv.nodes.Set(kv.ctx, gIndex, node.Value); err != nil {
+			return err
+		}
+	}
+
+	// The old root is set through the tree for its new ancestors to be
+	// rehashed.
+	tree, err := kv.tree()
+	if err != nil {
+		return err
+	}
+	return tree.Set(moved)
+}
+
+// getStateVersion returns the fork version of the layout of the state tree,
+// which is the Deneb layout until the tree is upgraded.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) getStateVersion() (uint32, error) {
+	forkVersion, err := kv.stateVersion.Get(kv.ctx)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return version.Deneb, nil
+	}
+	return forkVersion, err
+}
+
+// tree returns the state tree backed by the nodes of the store, in the layout
+// of its fork.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) tree() (*db.Tree[merkle.GeneralizedIndex, common.Root], error) {
+	forkVersion, err := kv.getStateVersion()
+	if err != nil {
+		return nil, err
+	}
+	return db.NewTree(
+		kv.stateSchema(forkVersion),
+		db.NodeStore[merkle.GeneralizedIndex, common.Root](
+			nodeStore{ctx: kv.ctx, nodes: kv.nodes},
+		),
+	), nil
+}
+
+// setSSZ sets the value at the given object path of the state from its SSZ
+// serialization.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) setSSZ(path objectPath, bz []byte) error {
+	tree, err := kv.tree()
+	if err != nil {
+		return err
+	}
+	return tree.SetSSZ(path, bz)
+}
+
+// getUint64 returns the uint64 at the given object path of the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) getUint64(path objectPath) (uint64, error) {
+	bz, err := kv.GetSSZ(path)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(bz), nil
+}
+
+// setUint64 sets the uint64 at the given object path of the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) setUint64(path objectPath, value uint64) error {
+	return kv.setSSZ(path, binary.LittleEndian.AppendUint64(nil, value))
+}
+
+// getRoot returns the 32 byte value at the given object path of the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) getRoot(path objectPath) (common.Root, error) {
+	bz, err := kv.GetSSZ(path)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return common.Root(bz), nil
+}
+
+// setValue sets the value at the given object path of the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) setValue(path objectPath, value constraints.SSZMarshaler) error {
+	bz, err := value.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return kv.setSSZ(path, bz)
+}
+
+// listLength returns the length of the list at the given object path of the
+// state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) listLength(list string) (uint64, error) {
+	return kv.getUint64(objectPath(list + "/__len__"))
+}
+
+// getListElement returns the SSZ serialization of the element at the given
+// index of the list at the given object path of the state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) getListElement(list string, index uint64) ([]byte, error) {
+	length, err := kv.listLength(list)
+	if err != nil {
+		return nil, err
+	}
+	if index >= length {
+		return nil, errors.Wrapf(
+			sdkcollections.ErrNotFound, "%s: index %d, length %d",
+			list, index, length,
+		)
+	}
+	return kv.GetSSZ(elementPath(list, index))
+}
+
+// setListElement sets the element at the given index of the list at the given
+// object path of the state from its SSZ serialization, extending the list if
+// the index is past its end.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) setListElement(list string, index uint64, bz []byte) error {
+	if err := kv.setSSZ(elementPath(list, index), bz); err != nil {
+		return err
+	}
+	length, err := kv.listLength(list)
+	if err != nil || index < length {
+		return err
+	}
+	return kv.setUint64(objectPath(list+"/__len__"), index+1)
+}
+
+// elementPath returns the object path of the element at the given index of the
+// list at the given object path.
+func elementPath(list string, index uint64) objectPath {
+	return objectPath(fmt.Sprintf("%s/%d", list, index))
+}
+
+// nodeStore stores the nodes of a state tree in a collections map.
+type nodeStore struct {
+	ctx   context.Context
+	nodes sdkcollections.Map[uint64, []byte]
+}
+
+// Get returns the node at the given generalized index and whether it has been
+// set.
+func (s nodeStore) Get(
+	gIndex merkle.GeneralizedIndex,
+) (common.Root, bool, error) {
+	bz, err := s.nodes.Get(s.ctx, uint64(gIndex))
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return common.Root{}, false, nil
+	} else if err != nil {
+		return common.Root{}, false, err
+	}
+	return common.Root(bz), true, nil
+}
+
+// Set stores the node at the given generalized index.
+func (s nodeStore) Set(gIndex merkle.GeneralizedIndex, node common.Root) error {
+	return s.nodes.Set(s.ctx, uint64(gIndex), node[:])
+}
+
+// decode returns the value of the given SSZ serialization.
+func decode[T interface {
+	constraints.Empty[T]
+	constraints.SSZMarshallable
+}](bz []byte) (T, error) {
+	var t T
+	t = t.Empty()
+	return t, t.UnmarshalSSZ(bz)
+}
@@ -0,0 +1,413 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package treedb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkcollections "cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/treedb"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+type testKVStoreService struct {
+	ctx sdk.Context
+}
+
+func (kvs *testKVStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	//nolint:contextcheck // fine with tests
+	return components.NewKVStore(
+		sdk.UnwrapSDKContext(kvs.ctx).KVStore(testStoreKey),
+	)
+}
+
+type testKVStore = treedb.KVStore[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	[]*types.Validator,
+]
+
+type testBeaconState = types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+]
+
+var testStoreKey = storetypes.NewKVStoreKey("tree-storage-tests")
+
+func TestKVStore_EmptyRoot(t *testing.T) {
+	kv, err := initTestStore()
+	require.NoError(t, err)
+
+	expected := (&testBeaconState{
+		Fork:              &types.Fork{},
+		LatestBlockHeader: &types.BeaconBlockHeader{},
+		Eth1Data:          &types.Eth1Data{},
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			BaseFeePerGas: &uint256.Int{},
+		},
+	}).HashTreeRoot()
+	root, err := kv.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+}
+
+func TestKVStore_HashTreeRoot(t *testing.T) {
+	kv, err := initTestStore()
+	require.NoError(t, err)
+	st := newTestBeaconState()
+	writeState(t, kv, st)
+
+	root, err := kv.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, st.HashTreeRoot(), root)
+
+	// Overwriting values only rehashes their branches, and the root tracks
+	// the state.
+	st.Slot = 99
+	st.Balances[1] = 12
+	st.Validators[0].EffectiveBalance = 1
+	require.NoError(t, kv.SetSlot(st.Slot))
+	require.NoError(t, kv.SetBalance(1, st.Balances[1]))
+	require.NoError(t, kv.UpdateValidatorAtIndex(0, st.Validators[0]))
+
+	root, err = kv.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, st.HashTreeRoot(), root)
+}
+
+func TestKVStore_Accessors(t *testing.T) {
+	kv, err := initTestStore()
+	require.NoError(t, err)
+	st := newTestBeaconState()
+	writeState(t, kv, st)
+
+	slot, err := kv.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, st.Slot, slot)
+
+	fork, err := kv.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, st.Fork, fork)
+
+	header, err := kv.GetLatestBlockHeader()
+	require.NoError(t, err)
+	require.Equal(t, st.LatestBlockHeader, header)
+
+	payloadHeader, err := kv.GetLatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	require.Equal(t, st.LatestExecutionPayloadHeader, payloadHeader)
+
+	blockRoot, err := kv.GetBlockRootAtIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, st.BlockRoots[1], blockRoot)
+
+	_, err = kv.GetBlockRootAtIndex(2)
+	require.ErrorIs(t, err, sdkcollections.ErrNotFound)
+
+	vals, err := kv.GetValidators()
+	require.NoError(t, err)
+	require.Equal(t, st.Validators, vals)
+
+	balances, err := kv.GetBalances()
+	require.NoError(t, err)
+	require.Len(t, balances, len(st.Balances))
+	for i, balance := range balances {
+		require.Equal(t, st.Balances[i].Unwrap(), balance)
+	}
+
+	slashings, err := kv.GetSlashings()
+	require.NoError(t, err)
+	require.Equal(t, st.Slashings, slashings)
+
+	idx, err := kv.ValidatorIndexByPubkey(st.Validators[1].Pubkey)
+	require.NoError(t, err)
+	require.Equal(t, math.ValidatorIndex(1), idx)
+
+	byBalance, err := kv.GetValidatorsByEffectiveBalance()
+	require.NoError(t, err)
+	require.Equal(
+		t, []*types.Validator{st.Validators[1], st.Validators[0]}, byBalance,
+	)
+
+}
+
+func TestKVStore_Prove(t *testing.T) {
+	kv, err := initTestStore()
+	require.NoError(t, err)
+	writeState(t, kv, newTestBeaconState())
+
+	proof, err := kv.Prove(
+		"slot", "validators/1/pubkey", "latest_block_header/state_root",
+	)
+	require.NoError(t, err)
+
+	root, err := kv.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, proof.Root)
+	require.True(t, merkle.VerifyMultiproof(
+		proof.GeneralizedIndices, proof.Leaves, proof.Proof, root,
+	))
+}
+
+func TestKVStore_UpgradeElectra(t *testing.T) {
+	kv, err := initTestStore()
+	require.NoError(t, err)
+	st := newTestBeaconState()
+	writeState(t, kv, st)
+
+	// The deposit requests start index is not part of the Deneb state.
+	startIndex, err := kv.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, constants.UnsetDepositRequestsStartIndex, startIndex)
+	require.Error(t, kv.SetDepositRequestsStartIndex(7))
+
+	// Upgrading moves the nodes of the state to the Electra layout.
+	require.NoError(t, kv.Upgrade(version.Electra))
+	require.NoError(t, kv.SetDepositRequestsStartIndex(
+		constants.UnsetDepositRequestsStartIndex,
+	))
+	root, err := kv.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, newTestElectraBeaconState(
+		t, st, constants.UnsetDepositRequestsStartIndex,
+	).HashTreeRoot(), root)
+
+	slot, err := kv.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, st.Slot, slot)
+	vals, err := kv.GetValidators()
+	require.NoError(t, err)
+	require.Equal(t, st.Validators, vals)
+
+	// The state root commits to the deposit requests start index.
+	require.NoError(t, kv.SetDepositRequestsStartIndex(7))
+	startIndex, err = kv.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), startIndex)
+	newRoot, err := kv.HashTreeRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, root, newRoot)
+	require.Equal(t, newTestElectraBeaconState(t, st, 7).HashTreeRoot(), newRoot)
+
+	proof, err := kv.Prove("deposit_requests_start_index", "validators/1/pubkey")
+	require.NoError(t, err)
+	require.True(t, merkle.VerifyMultiproof(
+		proof.GeneralizedIndices, proof.Leaves, proof.Proof, newRoot,
+	))
+
+	// The state tree cannot be downgraded.
+	require.ErrorIs(t, kv.Upgrade(version.Deneb), treedb.ErrStateDowngrade)
+}
+
+func newTestBeaconState() *testBeaconState {
+	return &testBeaconState{
+		GenesisValidatorsRoot: common.Root{0x01, 0x02, 0x03},
+		Slot:                  1234,
+		Fork: &types.Fork{
+			PreviousVersion: common.Version{0x01},
+			CurrentVersion:  common.Version{0x02},
+			Epoch:           5,
+		},
+		LatestBlockHeader: &types.BeaconBlockHeader{
+			Slot:            1233,
+			ProposerIndex:   1,
+			ParentBlockRoot: common.Root{0x0a},
+			StateRoot:       common.Root{0x0b},
+			BodyRoot:        common.Root{0x0c},
+		},
+		BlockRoots: []common.Root{{0x04}, {0x05}},
+		StateRoots: []common.Root{{0x06}, {0x07}},
+		Eth1Data: &types.Eth1Data{
+			DepositRoot:  common.Root{0x08},
+			DepositCount: 2,
+			BlockHash:    common.ExecutionHash{0x09},
+		},
+		Eth1DepositIndex: 2,
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			ParentHash:    common.ExecutionHash{0x10},
+			FeeRecipient:  common.ExecutionAddress{0x11},
+			Number:        7,
+			ExtraData:     []byte("tree"),
+			BaseFeePerGas: uint256.NewInt(3),
+		},
+		Validators: []*types.Validator{
+			{
+				Pubkey:           crypto.BLSPubkey{0x01},
+				EffectiveBalance: 32e9,
+				ExitEpoch:        math.Epoch(1<<64 - 1),
+			},
+			{
+				Pubkey:           crypto.BLSPubkey{0x02},
+				EffectiveBalance: 16e9,
+				Slashed:          true,
+			},
+		},
+		Balances:                     []math.Gwei{32e9, 16e9},
+		RandaoMixes:                  []common.Bytes32{{0x12}},
+		NextWithdrawalIndex:          3,
+		NextWithdrawalValidatorIndex: 1,
+		Slashings:                    []math.Gwei{0, 4},
+		TotalSlashing:                4,
+	}
+}
+
+// newTestElectraBeaconState returns the given state in the Electra fork, with
+// the given deposit requests start index.
+func newTestElectraBeaconState(
+	t *testing.T,
+	st *testBeaconState,
+	depositRequestsStartIndex uint64,
+) *testBeaconState {
+	t.Helper()
+	balances := make([]uint64, len(st.Balances))
+	for i, balance := range st.Balances {
+		balances[i] = balance.Unwrap()
+	}
+	electra, err := st.New(
+		version.Electra,
+		st.GenesisValidatorsRoot,
+		st.Slot,
+		st.Fork,
+		st.LatestBlockHeader,
+		st.BlockRoots,
+		st.StateRoots,
+		st.Eth1Data,
+		st.Eth1DepositIndex.Unwrap(),
+		st.LatestExecutionPayloadHeader,
+		st.Validators,
+		balances,
+		st.RandaoMixes,
+		st.NextWithdrawalIndex.Unwrap(),
+		st.NextWithdrawalValidatorIndex,
+		st.Slashings,
+		st.TotalSlashing,
+		depositRequestsStartIndex,
+	)
+	require.NoError(t, err)
+	return electra
+}
+
+// writeState writes the given state through the store accessors.
+func writeState(t *testing.T, kv *testKVStore, st *testBeaconState) {
+	t.Helper()
+	require.NoError(t, kv.SetGenesisValidatorsRoot(st.GenesisValidatorsRoot))
+	require.NoError(t, kv.SetSlot(st.Slot))
+	require.NoError(t, kv.SetFork(st.Fork))
+	require.NoError(t, kv.SetLatestBlockHeader(st.LatestBlockHeader))
+	for i, root := range st.BlockRoots {
+		require.NoError(t, kv.UpdateBlockRootAtIndex(uint64(i), root))
+	}
+	for i, root := range st.StateRoots {
+		require.NoError(t, kv.UpdateStateRootAtIndex(uint64(i), root))
+	}
+	require.NoError(t, kv.SetEth1Data(st.Eth1Data))
+	require.NoError(t, kv.SetEth1DepositIndex(st.Eth1DepositIndex.Unwrap()))
+	require.NoError(t, kv.SetLatestExecutionPayloadHeader(
+		st.LatestExecutionPayloadHeader,
+	))
+	for i, val := range st.Validators {
+		require.NoError(t, kv.AddValidator(val))
+		require.NoError(t, kv.SetBalance(
+			math.ValidatorIndex(i), st.Balances[i],
+		))
+	}
+	for i, mix := range st.RandaoMixes {
+		require.NoError(t, kv.UpdateRandaoMixAtIndex(uint64(i), mix))
+	}
+	require.NoError(t, kv.SetNextWithdrawalIndex(
+		st.NextWithdrawalIndex.Unwrap(),
+	))
+	require.NoError(t, kv.SetNextWithdrawalValidatorIndex(
+		st.NextWithdrawalValidatorIndex,
+	))
+	for i, slashing := range st.Slashings {
+		require.NoError(t, kv.SetSlashingAtIndex(uint64(i), slashing))
+	}
+	require.NoError(t, kv.SetTotalSlashing(st.TotalSlashing))
+}
+
+func initTestStore() (*testKVStore, error) {
+	db, err := db.OpenDB("", dbm.MemDBBackend)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening mem db: %w", err)
+	}
+	var (
+		nopLog     = log.NewNopLogger()
+		nopMetrics = metrics.NewNoOpMetrics()
+	)
+
+	cms := store.NewCommitMultiStore(
+		db,
+		nopLog,
+		nopMetrics,
+	)
+
+	ctx := sdk.NewContext(cms, true, nopLog)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	if err = cms.LoadLatestVersion(); err != nil {
+		return nil, fmt.Errorf("failed to load latest version: %w", err)
+	}
+	testStoreService := &testKVStoreService{
+		ctx: ctx,
+	}
+
+	return treedb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		[]*types.Validator,
+	](
+		testStoreService,
+		types.BeaconStateSchema,
+	).WithContext(ctx), nil
+}
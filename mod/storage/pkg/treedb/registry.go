@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package treedb
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+)
+
+// AddValidator registers a new validator in the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) AddValidator(val ValidatorT) error {
+	return kv.addValidator(val, 0)
+}
+
+// AddValidatorBartio registers a new validator in the beacon state, with its
+// balance set to its effective balance.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) AddValidatorBartio(val ValidatorT) error {
+	return kv.addValidator(val, val.GetEffectiveBalance())
+}
+
+// addValidator pushes the validator onto the validators list and its balance
+// onto the balances list.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) addValidator(val ValidatorT, balance math.Gwei) error {
+	idx, err := kv.listLength(validatorsPath)
+	if err != nil {
+		return err
+	}
+	if err = kv.UpdateValidatorAtIndex(
+		math.ValidatorIndex(idx), val,
+	); err != nil {
+		return err
+	}
+	return kv.SetBalance(math.ValidatorIndex(idx), balance)
+}
+
+// UpdateValidatorAtIndex updates a validator at a specific index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) UpdateValidatorAtIndex(
+	index math.ValidatorIndex,
+	val ValidatorT,
+) error {
+	bz, err := val.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if err = kv.setListElement(
+		validatorsPath, index.Unwrap(), bz,
+	); err != nil {
+		return err
+	}
+
+	pk := val.GetPubkey()
+	if err = kv.pubkeyToIndex.Set(
+		kv.ctx, pk[:], index.Unwrap(),
+	); err != nil {
+		return err
+	}
+	return kv.consAddrToIndex.Set(
+		kv.ctx, cmtcrypto.AddressHash(pk[:]).Bytes(), index.Unwrap(),
+	)
+}
+
+// ValidatorIndexByPubkey returns the validator address by index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) ValidatorIndexByPubkey(
+	pubkey crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	idx, err := kv.pubkeyToIndex.Get(kv.ctx, pubkey[:])
+	if err != nil {
+		return 0, err
+	}
+	return math.ValidatorIndex(idx), nil
+}
+
+// ValidatorIndexByCometBFTAddress returns the validator address by index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) ValidatorIndexByCometBFTAddress(
+	cometBFTAddress []byte,
+) (math.ValidatorIndex, error) {
+	idx, err := kv.consAddrToIndex.Get(kv.ctx, cometBFTAddress)
+	if err != nil {
+		return 0, err
+	}
+	return math.ValidatorIndex(idx), nil
+}
+
+// ValidatorByIndex returns the validator address by index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) ValidatorByIndex(
+	index math.ValidatorIndex,
+) (ValidatorT, error) {
+	bz, err := kv.getListElement(validatorsPath, index.Unwrap())
+	if err != nil {
+		var t ValidatorT
+		return t, err
+	}
+	return decode[ValidatorT](bz)
+}
+
+// GetValidators retrieves all validators from the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetValidators() (
+	ValidatorsT, error,
+) {
+	registrySize, err := kv.listLength(validatorsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make(ValidatorsT, registrySize)
+	for i := range registrySize {
+		if vals[i], err = kv.ValidatorByIndex(
+			math.ValidatorIndex(i),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+// GetTotalValidators returns the total number of validators.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetTotalValidators() (uint64, error) {
+	return kv.listLength(validatorsPath)
+}
+
+// GetValidatorsByEffectiveBalance retrieves all validators sorted by
+// effective balance from the beacon state. Validators with the same effective
+// balance are sorted by index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetValidatorsByEffectiveBalance() (
+	[]ValidatorT, error,
+) {
+	vals, err := kv.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(vals, func(i, j int) bool {
+		return vals[i].GetEffectiveBalance() < vals[j].GetEffectiveBalance()
+	})
+	return vals, nil
+}
+
+// GetBalance returns the balance of a validator.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetBalance(
+	idx math.ValidatorIndex,
+) (math.Gwei, error) {
+	bz, err := kv.getListElement(balancesPath, idx.Unwrap())
+	if err != nil {
+		return 0, err
+	}
+	return math.Gwei(binary.LittleEndian.Uint64(bz)), nil
+}
+
+// SetBalance sets the balance of a validator.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetBalance(
+	idx math.ValidatorIndex,
+	balance math.Gwei,
+) error {
+	return kv.setListElement(
+		balancesPath, idx.Unwrap(),
+		binary.LittleEndian.AppendUint64(nil, balance.Unwrap()),
+	)
+}
+
+// GetBalances returns the balances of all validators.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetBalances() ([]uint64, error) {
+	bz, err := kv.GetSSZ(balancesPath)
+	if err != nil {
+		return nil, err
+	}
+	var balances []uint64
+	for i := 0; i+8 <= len(bz); i += 8 {
+		balances = append(balances, binary.LittleEndian.Uint64(bz[i:]))
+	}
+	return balances, nil
+}
+
+// GetTotalActiveBalances returns the total active balances of all validators.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetTotalActiveBalances(
+	slotsPerEpoch uint64,
+) (math.Gwei, error) {
+	slot, err := kv.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	vals, err := kv.GetValidators()
+	if err != nil {
+		return 0, err
+	}
+
+	totalActiveBalances := math.Gwei(0)
+	epoch := math.Epoch(slot.Unwrap() / slotsPerEpoch)
+	for _, v := range vals {
+		if v.IsActive(epoch) {
+			totalActiveBalances += v.GetEffectiveBalance()
+		}
+	}
+	return totalActiveBalances, nil
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package treedb
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetSlashings retrieves all slashings from the store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetSlashings() ([]math.Gwei, error) {
+	bz, err := kv.GetSSZ(slashingsPath)
+	if err != nil {
+		return nil, err
+	}
+	var slashings []math.Gwei
+	for i := 0; i+8 <= len(bz); i += 8 {
+		slashings = append(
+			slashings, math.Gwei(binary.LittleEndian.Uint64(bz[i:])),
+		)
+	}
+	return slashings, nil
+}
+
+// GetSlashingAtIndex retrieves the slashing amount by index from the store.
+// Slashings past the end of the list are zero.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetSlashingAtIndex(
+	index uint64,
+) (math.Gwei, error) {
+	amount, err := kv.getUint64(elementPath(slashingsPath, index))
+	return math.Gwei(amount), err
+}
+
+// SetSlashingAtIndex sets the slashing amount in the store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetSlashingAtIndex(
+	index uint64,
+	amount math.Gwei,
+) error {
+	return kv.setListElement(
+		slashingsPath, index,
+		binary.LittleEndian.AppendUint64(nil, amount.Unwrap()),
+	)
+}
+
+// GetTotalSlashing retrieves the total slashing amount from the store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetTotalSlashing() (math.Gwei, error) {
+	total, err := kv.getUint64(totalSlashingPath)
+	return math.Gwei(total), err
+}
+
+// SetTotalSlashing sets the total slashing amount in the store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetTotalSlashing(
+	amount math.Gwei,
+) error {
+	return kv.setUint64(totalSlashingPath, amount.Unwrap())
+}
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockel
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// depositLog is a deposit made to the deposit contract in the block with
+// the given number.
+type depositLog struct {
+	number      uint64
+	pubkey      []byte
+	credentials []byte
+	amount      uint64
+	signature   []byte
+}
+
+// Chain is an execution chain served by one or more mock execution clients.
+// Sharing a chain between the clients of several nodes stands in for the
+// execution layer peer-to-peer network.
+type Chain struct {
+	mu sync.Mutex
+	// chainID is the chain ID served by eth_chainId.
+	chainID uint64
+	// depositContract is the address of the deposit contract.
+	depositContract gethcommon.Address
+	// depositABI is the ABI of the deposit contract.
+	depositABI *abi.ABI
+	// blocks holds every block known to the execution layer, by hash.
+	blocks map[gethcommon.Hash]*gethtypes.Block
+	// canonical holds the hashes of the canonical blocks, by number.
+	canonical []gethcommon.Hash
+	// payloads holds the payloads built through forkchoice updates.
+	payloads map[engine.PayloadID]*engine.ExecutionPayloadEnvelope
+	// deposits holds the deposits made to the deposit contract, in order.
+	deposits []depositLog
+	// maxNumber is the highest number of any known block.
+	maxNumber uint64
+}
+
+// NewChain creates an execution chain starting from the given genesis
+// block.
+func NewChain(genesis *gethtypes.Block) (*Chain, error) {
+	depositABI, err := deposit.BeaconDepositContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Chain{
+		depositABI: depositABI,
+		blocks: map[gethcommon.Hash]*gethtypes.Block{
+			genesis.Hash(): genesis,
+		},
+		canonical: []gethcommon.Hash{genesis.Hash()},
+		payloads:  make(map[engine.PayloadID]*engine.ExecutionPayloadEnvelope),
+	}, nil
+}
+
+// NewGenesisBlock returns an execution genesis block with the given
+// timestamp.
+func NewGenesisBlock(timestamp uint64) *gethtypes.Block {
+	var (
+		zero            uint64
+		withdrawalsRoot = gethtypes.EmptyWithdrawalsHash
+		beaconRoot      gethcommon.Hash
+	)
+	//nolint:mnd // common defaults of execution genesis blocks.
+	return gethtypes.NewBlockWithHeader(&gethtypes.Header{
+		UncleHash:        gethtypes.EmptyUncleHash,
+		Root:             gethtypes.EmptyRootHash,
+		TxHash:           gethtypes.EmptyTxsHash,
+		ReceiptHash:      gethtypes.EmptyReceiptsHash,
+		Difficulty:       gethcommon.Big0,
+		Number:           gethcommon.Big0,
+		GasLimit:         30_000_000,
+		Time:             timestamp,
+		BaseFee:          big.NewInt(1_000_000_000),
+		WithdrawalsHash:  &withdrawalsRoot,
+		BlobGasUsed:      &zero,
+		ExcessBlobGas:    &zero,
+		ParentBeaconRoot: &beaconRoot,
+	}).WithBody(gethtypes.Body{Withdrawals: gethtypes.Withdrawals{}})
+}
+
+// SetChainID sets the chain ID served by eth_chainId.
+func (c *Chain) SetChainID(chainID uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.chainID = chainID
+}
+
+// SetDepositContract sets the address of the deposit contract whose events
+// are served by eth_getLogs.
+func (c *Chain) SetDepositContract(address gethcommon.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.depositContract = address
+}
+
+// AddDeposit adds a deposit to the deposit contract, included in the next
+// block built on top of the highest known block, and returns its index.
+func (c *Chain) AddDeposit(
+	pubkey, credentials []byte, amount uint64, signature []byte,
+) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deposits = append(c.deposits, depositLog{
+		number:      c.maxNumber + 1,
+		pubkey:      pubkey,
+		credentials: credentials,
+		amount:      amount,
+		signature:   signature,
+	})
+	return uint64(len(c.deposits) - 1)
+}
+
+// Block returns the known block with the given hash, if any.
+func (c *Chain) Block(hash gethcommon.Hash) *gethtypes.Block {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.blocks[hash]
+}
+
+// Head returns the head of the canonical chain.
+func (c *Chain) Head() *gethtypes.Block {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.blocks[c.canonical[len(c.canonical)-1]]
+}
+
+// insert adds the block to the known blocks.
+func (c *Chain) insert(block *gethtypes.Block) {
+	c.blocks[block.Hash()] = block
+	c.maxNumber = max(c.maxNumber, block.NumberU64())
+}
+
+// setHead marks the chain ending in the given block as the canonical chain.
+func (c *Chain) setHead(head *gethtypes.Block) {
+	number := head.NumberU64()
+	if uint64(len(c.canonical)) > number+1 {
+		c.canonical = c.canonical[:number+1]
+	}
+	for uint64(len(c.canonical)) <= number {
+		c.canonical = append(c.canonical, gethcommon.Hash{})
+	}
+	for block := head; block != nil; block = c.blocks[block.ParentHash()] {
+		if c.canonical[block.NumberU64()] == block.Hash() {
+			break
+		}
+		c.canonical[block.NumberU64()] = block.Hash()
+		if block.NumberU64() == 0 {
+			break
+		}
+	}
+}
+
+// build builds a payload on top of the given parent block. Payloads only
+// depend on their parent and attributes, so that every execution client
+// builds the same payload for the same request.
+func (c *Chain) build(
+	parent *gethtypes.Block,
+	attrs *engine.PayloadAttributes,
+) (engine.PayloadID, *engine.ExecutionPayloadEnvelope) {
+	withdrawals := gethtypes.Withdrawals(attrs.Withdrawals)
+	if withdrawals == nil {
+		withdrawals = gethtypes.Withdrawals{}
+	}
+	var (
+		zero            uint64
+		withdrawalsRoot = gethtypes.DeriveSha(
+			withdrawals, trie.NewStackTrie(nil),
+		)
+	)
+	block := gethtypes.NewBlockWithHeader(&gethtypes.Header{
+		ParentHash:       parent.Hash(),
+		UncleHash:        gethtypes.EmptyUncleHash,
+		Coinbase:         attrs.SuggestedFeeRecipient,
+		Root:             parent.Root(),
+		TxHash:           gethtypes.EmptyTxsHash,
+		ReceiptHash:      gethtypes.EmptyReceiptsHash,
+		Difficulty:       gethcommon.Big0,
+		Number:           new(big.Int).Add(parent.Number(), gethcommon.Big1),
+		GasLimit:         parent.GasLimit(),
+		Time:             attrs.Timestamp,
+		MixDigest:        attrs.Random,
+		BaseFee:          parent.BaseFee(),
+		WithdrawalsHash:  &withdrawalsRoot,
+		BlobGasUsed:      &zero,
+		ExcessBlobGas:    &zero,
+		ParentBeaconRoot: attrs.BeaconRoot,
+	}).WithBody(gethtypes.Body{Withdrawals: withdrawals})
+
+	var id engine.PayloadID
+	copy(id[:], block.Hash().Bytes())
+	id[0] = byte(engine.PayloadV3)
+
+	c.insert(block)
+	envelope := engine.BlockToExecutableData(block, big.NewInt(0), nil)
+	c.payloads[id] = envelope
+	return id, envelope
+}
+
+// depositCount returns the number of deposits made up to and including the
+// block with the given number.
+func (c *Chain) depositCount(number uint64) uint64 {
+	var count uint64
+	for _, d := range c.deposits {
+		if d.number <= number {
+			count++
+		}
+	}
+	return count
+}
+
+// blockNumber resolves the given block number against the canonical chain.
+func (c *Chain) blockNumber(number rpc.BlockNumber) uint64 {
+	head := uint64(len(c.canonical) - 1)
+	if number < 0 || uint64(number) > head {
+		return head
+	}
+	return uint64(number)
+}
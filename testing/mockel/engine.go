@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockel
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// engineAPI implements the engine namespace of the mock execution client.
+type engineAPI struct {
+	s *Server
+}
+
+// ExchangeCapabilities implements engine_exchangeCapabilities.
+func (api *engineAPI) ExchangeCapabilities([]string) []string {
+	return []string{
+		"engine_forkchoiceUpdatedV3",
+		"engine_getPayloadV3",
+		"engine_newPayloadV3",
+	}
+}
+
+// ForkchoiceUpdatedV3 implements engine_forkchoiceUpdatedV3.
+func (api *engineAPI) ForkchoiceUpdatedV3(
+	ctx context.Context,
+	state engine.ForkchoiceStateV1,
+	attrs *engine.PayloadAttributes,
+) (engine.ForkChoiceResponse, error) {
+	api.s.delay(ctx)
+
+	switch status := api.s.nextForkchoiceStatus(); status {
+	case "", engine.VALID:
+	case engine.INVALID:
+		reason := "scripted invalid head"
+		return engine.ForkChoiceResponse{
+			PayloadStatus: engine.PayloadStatusV1{
+				Status: status, ValidationError: &reason,
+			},
+		}, nil
+	default:
+		return engine.ForkChoiceResponse{
+			PayloadStatus: engine.PayloadStatusV1{Status: status},
+		}, nil
+	}
+
+	c := api.s.chain
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	head, ok := c.blocks[state.HeadBlockHash]
+	if !ok {
+		return engine.STATUS_SYNCING, nil
+	}
+	c.setHead(head)
+
+	hash := head.Hash()
+	res := engine.ForkChoiceResponse{
+		PayloadStatus: engine.PayloadStatusV1{
+			Status: engine.VALID, LatestValidHash: &hash,
+		},
+	}
+	if attrs == nil {
+		return res, nil
+	}
+	if attrs.Timestamp <= head.Time() {
+		return engine.STATUS_INVALID, engine.InvalidPayloadAttributes.With(
+			errTimestampNotAfterParent,
+		)
+	}
+	id, _ := c.build(head, attrs)
+	res.PayloadID = &id
+	return res, nil
+}
+
+// GetPayloadV3 implements engine_getPayloadV3.
+func (api *engineAPI) GetPayloadV3(
+	ctx context.Context,
+	id engine.PayloadID,
+) (*engine.ExecutionPayloadEnvelope, error) {
+	api.s.delay(ctx)
+
+	c := api.s.chain
+	c.mu.Lock()
+	envelope, ok := c.payloads[id]
+	c.mu.Unlock()
+	if !ok {
+		return nil, engine.UnknownPayload
+	}
+
+	if api.s.corrupted() {
+		payload := *envelope.ExecutionPayload
+		payload.ExtraData = []byte("corrupted")
+		envelope = &engine.ExecutionPayloadEnvelope{
+			ExecutionPayload: &payload,
+			BlockValue:       envelope.BlockValue,
+			BlobsBundle:      envelope.BlobsBundle,
+		}
+	}
+	return envelope, nil
+}
+
+// NewPayloadV3 implements engine_newPayloadV3.
+func (api *engineAPI) NewPayloadV3(
+	ctx context.Context,
+	data engine.ExecutableData,
+	versionedHashes []gethcommon.Hash,
+	beaconRoot *gethcommon.Hash,
+) (engine.PayloadStatusV1, error) {
+	api.s.delay(ctx)
+
+	latestValid := data.ParentHash
+	switch status := api.s.nextPayloadStatus(); status {
+	case "", engine.VALID:
+	case engine.INVALID:
+		reason := "scripted invalid payload"
+		return engine.PayloadStatusV1{
+			Status: status, LatestValidHash: &latestValid,
+			ValidationError: &reason,
+		}, nil
+	default:
+		return engine.PayloadStatusV1{Status: status}, nil
+	}
+
+	c := api.s.chain
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	block, err := engine.ExecutableDataToBlock(
+		data, versionedHashes, beaconRoot,
+	)
+	if err != nil {
+		reason := err.Error()
+		return engine.PayloadStatusV1{
+			Status: engine.INVALID, LatestValidHash: &latestValid,
+			ValidationError: &reason,
+		}, nil
+	}
+	parent, ok := c.blocks[data.ParentHash]
+	if !ok {
+		return engine.PayloadStatusV1{Status: engine.SYNCING}, nil
+	}
+	if block.Time() <= parent.Time() {
+		reason := errTimestampNotAfterParent.Error()
+		return engine.PayloadStatusV1{
+			Status: engine.INVALID, LatestValidHash: &latestValid,
+			ValidationError: &reason,
+		}, nil
+	}
+
+	c.insert(block)
+	hash := block.Hash()
+	return engine.PayloadStatusV1{
+		Status: engine.VALID, LatestValidHash: &hash,
+	}, nil
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockel
+
+import (
+	"encoding/binary"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ethAPI implements the eth namespace of the mock execution client.
+type ethAPI struct {
+	s *Server
+}
+
+// ChainId implements eth_chainId.
+//
+//nolint:revive,stylecheck // the method name is fixed by the JSON-RPC API.
+func (api *ethAPI) ChainId() hexutil.Uint64 {
+	c := api.s.chain
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return hexutil.Uint64(c.chainID)
+}
+
+// GetBlockByNumber implements eth_getBlockByNumber, returning the header of
+// the canonical block with the given number.
+func (api *ethAPI) GetBlockByNumber(
+	number rpc.BlockNumber, _ bool,
+) *gethtypes.Header {
+	c := api.s.chain
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if number >= 0 && uint64(number) >= uint64(len(c.canonical)) {
+		return nil
+	}
+	return c.blocks[c.canonical[c.blockNumber(number)]].Header()
+}
+
+// callArgs are the arguments of eth_call.
+type callArgs struct {
+	To    *gethcommon.Address `json:"to"`
+	Data  hexutil.Bytes       `json:"data"`
+	Input hexutil.Bytes       `json:"input"`
+}
+
+// Call implements eth_call for the depositCount method of the deposit
+// contract.
+func (api *ethAPI) Call(
+	args callArgs, number rpc.BlockNumber,
+) (hexutil.Bytes, error) {
+	c := api.s.chain
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	input := args.Input
+	if len(input) == 0 {
+		input = args.Data
+	}
+	method := c.depositABI.Methods["depositCount"]
+	//nolint:mnd // a method selector is 4 bytes long.
+	if args.To == nil || *args.To != c.depositContract ||
+		len(input) < 4 || string(input[:4]) != string(method.ID) {
+		return nil, ErrUnknownMethod
+	}
+	return method.Outputs.Pack(c.depositCount(c.blockNumber(number)))
+}
+
+// filterQuery are the arguments of eth_getLogs.
+type filterQuery struct {
+	FromBlock *rpc.BlockNumber     `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber     `json:"toBlock"`
+	Addresses []gethcommon.Address `json:"address"`
+	Topics    [][]gethcommon.Hash  `json:"topics"`
+}
+
+// GetLogs implements eth_getLogs for the Deposit events of the deposit
+// contract in the canonical chain.
+func (api *ethAPI) GetLogs(query filterQuery) ([]gethtypes.Log, error) {
+	c := api.s.chain
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var from, to uint64
+	if query.FromBlock != nil {
+		from = c.blockNumber(*query.FromBlock)
+	}
+	to = c.blockNumber(rpc.LatestBlockNumber)
+	if query.ToBlock != nil {
+		to = c.blockNumber(*query.ToBlock)
+	}
+	if !matchesAddress(query.Addresses, c.depositContract) {
+		return []gethtypes.Log{}, nil
+	}
+
+	event := c.depositABI.Events["Deposit"]
+	logs := make([]gethtypes.Log, 0)
+	for i, d := range c.deposits {
+		if d.number < from || d.number > to {
+			continue
+		}
+		data, err := event.Inputs.Pack(
+			d.pubkey, d.credentials, d.amount, d.signature, uint64(i),
+		)
+		if err != nil {
+			return nil, err
+		}
+		var index [8]byte
+		binary.BigEndian.PutUint64(index[:], uint64(i))
+		logs = append(logs, gethtypes.Log{
+			Address:     c.depositContract,
+			Topics:      []gethcommon.Hash{event.ID},
+			Data:        data,
+			BlockNumber: d.number,
+			BlockHash:   c.canonical[d.number],
+			TxHash:      crypto.Keccak256Hash(index[:]),
+			Index:       uint(i),
+		})
+	}
+	return logs, nil
+}
+
+// matchesAddress returns true if the address is part of the filtered
+// addresses, or if no address is filtered.
+func matchesAddress(
+	addresses []gethcommon.Address, address gethcommon.Address,
+) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
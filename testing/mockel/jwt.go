@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockel
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	gjwt "github.com/golang-jwt/jwt/v4"
+)
+
+// jwtExpiryTimeout is the maximum drift allowed between the issued-at claim
+// of a token and the current time, as in geth.
+const jwtExpiryTimeout = 60 * time.Second
+
+// jwtHandler authenticates the requests to the next handler with a JWT
+// signed with a shared secret, validating the tokens the same way geth does.
+type jwtHandler struct {
+	secret *jwt.Secret
+	next   http.Handler
+}
+
+// newJWTHandler returns a handler that authenticates the requests to the
+// next handler with the given secret.
+func newJWTHandler(secret *jwt.Secret, next http.Handler) http.Handler {
+	return &jwtHandler{secret: secret, next: next}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *jwtHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		strToken string
+		claims   gjwt.RegisteredClaims
+	)
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(
+		auth, "Bearer ",
+	) {
+		strToken = strings.TrimPrefix(auth, "Bearer ")
+	}
+	if len(strToken) == 0 {
+		http.Error(w, "missing token", http.StatusUnauthorized)
+		return
+	}
+
+	// Only HS256 is allowed and the claims are checked below, allowing for
+	// a bit of drift of the issued-at claim.
+	token, err := gjwt.ParseWithClaims(
+		strToken, &claims,
+		func(*gjwt.Token) (interface{}, error) {
+			return h.secret.Bytes(), nil
+		},
+		gjwt.WithValidMethods([]string{"HS256"}),
+		gjwt.WithoutClaimsValidation(),
+	)
+	switch {
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case !token.Valid:
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+	case !claims.VerifyExpiresAt(time.Now(), false):
+		http.Error(w, "token is expired", http.StatusUnauthorized)
+	case claims.IssuedAt == nil:
+		http.Error(w, "missing issued-at", http.StatusUnauthorized)
+	case time.Since(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		http.Error(w, "stale token", http.StatusUnauthorized)
+	case time.Until(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		http.Error(w, "future token", http.StatusUnauthorized)
+	default:
+		h.next.ServeHTTP(w, r)
+	}
+}
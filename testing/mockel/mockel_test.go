@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockel_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/testing/mockel"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	gjwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const genesisTime = 1_700_000_000
+
+// newServer starts a mock execution client of a new chain and returns it
+// along with a client authenticated with its JWT secret.
+func newServer(t *testing.T) (*mockel.Server, *rpc.Client) {
+	t.Helper()
+	chain, err := mockel.NewChain(mockel.NewGenesisBlock(genesisTime))
+	require.NoError(t, err)
+	return newServerOf(t, chain)
+}
+
+// newServerOf starts a mock execution client of the given chain and returns
+// it along with a client authenticated with its JWT secret.
+func newServerOf(
+	t *testing.T,
+	chain *mockel.Chain,
+) (*mockel.Server, *rpc.Client) {
+	t.Helper()
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	server, err := mockel.New(chain, secret)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	client, err := rpc.DialOptions(
+		context.Background(), server.URL(),
+		rpc.WithHTTPAuth(func(h http.Header) error {
+			token, err := sign(secret.Bytes(), time.Now())
+			h.Set("Authorization", "Bearer "+token)
+			return err
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return server, client
+}
+
+// sign returns a token issued at the given time, signed with the key.
+func sign(key []byte, iat time.Time) (string, error) {
+	return gjwt.NewWithClaims(
+		gjwt.SigningMethodHS256,
+		gjwt.RegisteredClaims{IssuedAt: gjwt.NewNumericDate(iat)},
+	).SignedString(key)
+}
+
+// buildPayload builds a payload on top of the given parent through a
+// forkchoice update and returns it.
+func buildPayload(
+	t *testing.T,
+	client *rpc.Client,
+	parent *gethtypes.Block,
+) *engine.ExecutableData {
+	t.Helper()
+	var res engine.ForkChoiceResponse
+	beaconRoot := gethcommon.Hash{0x01}
+	require.NoError(t, client.Call(
+		&res, "engine_forkchoiceUpdatedV3",
+		engine.ForkchoiceStateV1{HeadBlockHash: parent.Hash()},
+		&engine.PayloadAttributes{
+			Timestamp:   parent.Time() + 1,
+			Withdrawals: []*gethtypes.Withdrawal{},
+			BeaconRoot:  &beaconRoot,
+		},
+	))
+	require.Equal(t, engine.VALID, res.PayloadStatus.Status)
+	require.NotNil(t, res.PayloadID)
+
+	var envelope engine.ExecutionPayloadEnvelope
+	require.NoError(t, client.Call(
+		&envelope, "engine_getPayloadV3", res.PayloadID,
+	))
+	return envelope.ExecutionPayload
+}
+
+// newPayload submits the payload and returns its status.
+func newPayload(
+	t *testing.T,
+	client *rpc.Client,
+	payload *engine.ExecutableData,
+) string {
+	t.Helper()
+	var res engine.PayloadStatusV1
+	require.NoError(t, client.Call(
+		&res, "engine_newPayloadV3",
+		payload, []gethcommon.Hash{}, gethcommon.Hash{0x01},
+	))
+	return res.Status
+}
+
+func TestJWT(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	chain, err := mockel.NewChain(mockel.NewGenesisBlock(genesisTime))
+	require.NoError(t, err)
+	server, err := mockel.New(chain, secret)
+	require.NoError(t, err)
+	defer server.Close()
+
+	mustSign := func(key []byte, iat time.Time) string {
+		token, signErr := sign(key, iat)
+		require.NoError(t, signErr)
+		return token
+	}
+	other, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name   string
+		token  string
+		status int
+	}{
+		{"missing token", "", http.StatusUnauthorized},
+		{
+			"wrong secret", mustSign(other.Bytes(), time.Now()),
+			http.StatusUnauthorized,
+		},
+		{
+			"stale token", mustSign(secret.Bytes(), time.Now().Add(-time.Hour)),
+			http.StatusUnauthorized,
+		},
+		{
+			"future token", mustSign(secret.Bytes(), time.Now().Add(time.Hour)),
+			http.StatusUnauthorized,
+		},
+		{"valid token", mustSign(secret.Bytes(), time.Now()), http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req, reqErr := http.NewRequestWithContext(
+				context.Background(), http.MethodPost, server.URL(),
+				strings.NewReader(
+					`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`,
+				),
+			)
+			require.NoError(t, reqErr)
+			req.Header.Set("Content-Type", "application/json")
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			res, reqErr := http.DefaultClient.Do(req)
+			require.NoError(t, reqErr)
+			defer res.Body.Close()
+			require.Equal(t, tc.status, res.StatusCode)
+		})
+	}
+}
+
+func TestPayloadLifecycle(t *testing.T) {
+	server, client := newServer(t)
+	genesis := server.Chain().Head()
+
+	var capabilities []string
+	require.NoError(t, client.Call(
+		&capabilities, "engine_exchangeCapabilities", []string{},
+	))
+	require.Contains(t, capabilities, "engine_newPayloadV3")
+
+	// Built payloads have a valid block hash and are accepted.
+	payload := buildPayload(t, client, genesis)
+	block, err := engine.ExecutableDataToBlock(
+		*payload, []gethcommon.Hash{}, &gethcommon.Hash{0x01},
+	)
+	require.NoError(t, err)
+	require.Equal(t, payload.BlockHash, block.Hash())
+	require.Equal(t, engine.VALID, newPayload(t, client, payload))
+
+	var res engine.ForkChoiceResponse
+	require.NoError(t, client.Call(
+		&res, "engine_forkchoiceUpdatedV3",
+		engine.ForkchoiceStateV1{HeadBlockHash: payload.BlockHash}, nil,
+	))
+	require.Equal(t, engine.VALID, res.PayloadStatus.Status)
+	require.Equal(t, payload.BlockHash, server.Chain().Head().Hash())
+
+	// Payloads are deterministic across the servers of a chain.
+	_, other := newServerOf(t, server.Chain())
+	require.Equal(t, payload, buildPayload(t, other, genesis))
+
+	// Payloads on top of an unknown parent cannot be validated yet.
+	header := block.Header()
+	header.ParentHash = gethcommon.Hash{0x02}
+	orphan := engine.BlockToExecutableData(
+		gethtypes.NewBlockWithHeader(header).WithBody(*block.Body()),
+		nil, nil,
+	).ExecutionPayload
+	require.Equal(t, engine.SYNCING, newPayload(t, client, orphan))
+}
+
+func TestScriptedStatus(t *testing.T) {
+	server, client := newServer(t)
+	payload := buildPayload(t, client, server.Chain().Head())
+
+	for _, status := range []string{
+		engine.INVALID, engine.SYNCING, engine.ACCEPTED,
+	} {
+		server.SetPayloadStatus(status, 1)
+		require.Equal(t, status, newPayload(t, client, payload))
+		require.Equal(t, engine.VALID, newPayload(t, client, payload))
+	}
+
+	// A status scripted without a count holds until it is reset.
+	server.SetPayloadStatus(engine.SYNCING, 0)
+	require.Equal(t, engine.SYNCING, newPayload(t, client, payload))
+	require.Equal(t, engine.SYNCING, newPayload(t, client, payload))
+	server.SetPayloadStatus("", 0)
+	require.Equal(t, engine.VALID, newPayload(t, client, payload))
+
+	server.SetForkchoiceStatus(engine.SYNCING, 1)
+	var res engine.ForkChoiceResponse
+	require.NoError(t, client.Call(
+		&res, "engine_forkchoiceUpdatedV3",
+		engine.ForkchoiceStateV1{HeadBlockHash: payload.BlockHash}, nil,
+	))
+	require.Equal(t, engine.SYNCING, res.PayloadStatus.Status)
+}
+
+func TestCorruptPayloads(t *testing.T) {
+	server, client := newServer(t)
+	server.CorruptPayloads(true)
+	payload := buildPayload(t, client, server.Chain().Head())
+	_, err := engine.ExecutableDataToBlock(
+		*payload, []gethcommon.Hash{}, &gethcommon.Hash{0x01},
+	)
+	require.Error(t, err)
+	require.Equal(t, engine.INVALID, newPayload(t, client, payload))
+}
+
+func TestLatency(t *testing.T) {
+	server, client := newServer(t)
+	server.SetLatency(50 * time.Millisecond)
+	start := time.Now()
+	buildPayload(t, client, server.Chain().Head())
+	// Both the forkchoice update and the payload retrieval are delayed.
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestDepositLogs(t *testing.T) {
+	server, client := newServer(t)
+	chain := server.Chain()
+	chain.SetChainID(80087)
+	depositContract := gethcommon.HexToAddress("0x4242")
+	chain.SetDepositContract(depositContract)
+
+	var chainID hexutil.Uint64
+	require.NoError(t, client.Call(&chainID, "eth_chainId"))
+	require.Equal(t, hexutil.Uint64(80087), chainID)
+
+	index := chain.AddDeposit(
+		make([]byte, 48), make([]byte, 32), 32e9, make([]byte, 96),
+	)
+	require.Equal(t, uint64(0), index)
+
+	// The deposit is only served once the block it is included in is
+	// canonical.
+	var logs []gethtypes.Log
+	filter := map[string]any{
+		"fromBlock": "0x0",
+		"address":   []gethcommon.Address{depositContract},
+	}
+	require.NoError(t, client.Call(&logs, "eth_getLogs", filter))
+	require.Empty(t, logs)
+
+	payload := buildPayload(t, client, chain.Head())
+	require.Equal(t, engine.VALID, newPayload(t, client, payload))
+	var res engine.ForkChoiceResponse
+	require.NoError(t, client.Call(
+		&res, "engine_forkchoiceUpdatedV3",
+		engine.ForkchoiceStateV1{HeadBlockHash: payload.BlockHash}, nil,
+	))
+	require.NoError(t, client.Call(&logs, "eth_getLogs", filter))
+	require.Len(t, logs, 1)
+	require.Equal(t, payload.BlockHash, logs[0].BlockHash)
+}
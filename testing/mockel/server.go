@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mockel
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// script is a scripted status returned in place of the computed one.
+type script struct {
+	// status is the scripted status, or empty if none is scripted.
+	status string
+	// count is the number of calls the status is returned for, or zero to
+	// return it until it is reset.
+	count int
+}
+
+// next returns the scripted status for the next call, if any.
+func (s *script) next() string {
+	status := s.status
+	if s.count > 0 {
+		if s.count--; s.count == 0 {
+			s.status = ""
+		}
+	}
+	return status
+}
+
+// Server is a mock execution client. It serves the Engine API and the
+// subset of the Ethereum JSON-RPC API used by beacon nodes over HTTP, from
+// an execution chain that may be shared with other servers, and lets tests
+// script its responses.
+type Server struct {
+	chain  *Chain
+	server *httptest.Server
+
+	mu sync.Mutex
+	// payloadStatus is the scripted status of engine_newPayload.
+	payloadStatus script
+	// forkchoiceStatus is the scripted status of engine_forkchoiceUpdated.
+	forkchoiceStatus script
+	// latency delays every Engine API response.
+	latency time.Duration
+	// corrupt makes the server return payloads with an invalid block hash.
+	corrupt bool
+}
+
+// New starts serving a mock execution client of the given chain, which
+// authenticates its requests with the given JWT secret.
+func New(chain *Chain, secret *jwt.Secret) (*Server, error) {
+	s := &Server{chain: chain}
+	server := rpc.NewServer()
+	if err := server.RegisterName("engine", &engineAPI{s}); err != nil {
+		return nil, err
+	}
+	if err := server.RegisterName("eth", &ethAPI{s}); err != nil {
+		return nil, err
+	}
+	s.server = httptest.NewServer(newJWTHandler(secret, server))
+	return s, nil
+}
+
+// URL returns the URL the server is served at.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Chain returns the execution chain served by the server.
+func (s *Server) Chain() *Chain {
+	return s.chain
+}
+
+// Close stops the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// SetPayloadStatus makes engine_newPayload return the given status, e.g.
+// engine.INVALID, engine.SYNCING or engine.ACCEPTED, for the next count
+// calls, or until it is reset with an empty status if count is zero.
+func (s *Server) SetPayloadStatus(status string, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.payloadStatus = script{status: status, count: count}
+}
+
+// SetForkchoiceStatus makes engine_forkchoiceUpdated return the given
+// status, e.g. engine.INVALID or engine.SYNCING, for the next count calls,
+// or until it is reset with an empty status if count is zero.
+func (s *Server) SetForkchoiceStatus(status string, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.forkchoiceStatus = script{status: status, count: count}
+}
+
+// SetLatency delays every Engine API response by the given duration.
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = latency
+}
+
+// CorruptPayloads makes the server return built payloads whose block hash
+// does not match their contents.
+func (s *Server) CorruptPayloads(corrupt bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.corrupt = corrupt
+}
+
+// delay waits for the configured latency.
+func (s *Server) delay(ctx context.Context) {
+	s.mu.Lock()
+	latency := s.latency
+	s.mu.Unlock()
+	if latency == 0 {
+		return
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(latency):
+	}
+}
+
+// nextPayloadStatus returns the scripted status of the next
+// engine_newPayload call, if any.
+func (s *Server) nextPayloadStatus() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.payloadStatus.next()
+}
+
+// nextForkchoiceStatus returns the scripted status of the next
+// engine_forkchoiceUpdated call, if any.
+func (s *Server) nextForkchoiceStatus() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.forkchoiceStatus.next()
+}
+
+// corrupted returns true if built payloads are corrupted.
+func (s *Server) corrupted() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.corrupt
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+// gate holds the nodes of a network once they committed the last block the
+// network is allowed to decide, so that tests inject their faults between
+// blocks rather than at an arbitrary point of consensus.
+type gate struct {
+	mu sync.Mutex
+	// height is the height of the last block that may be decided.
+	height int64
+	// raised is closed when the height is raised.
+	raised chan struct{}
+}
+
+// newGate returns a gate letting the network decide blocks up to the given
+// height.
+func newGate(height int64) *gate {
+	return &gate{height: height, raised: make(chan struct{})}
+}
+
+// raise lets the network decide blocks up to the given height.
+func (g *gate) raise(height int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if height <= g.height {
+		return
+	}
+	g.height = height
+	close(g.raised)
+	g.raised = make(chan struct{})
+}
+
+// wait waits until the block after the given height may be decided or the
+// context is done.
+func (g *gate) wait(ctx context.Context, height int64) {
+	for {
+		g.mu.Lock()
+		allowed, raised := height < g.height, g.raised
+		g.mu.Unlock()
+		if allowed {
+			return
+		}
+		select {
+		case <-raised:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// application is the ABCI application a CometBFT node of the network is
+// started with. It records the blocks the node commits and, once a block
+// is committed, holds the node until the network may decide the next one.
+type application[LoggerT log.AdvancedLogger[LoggerT]] struct {
+	cmtabci.Application
+	// ctx is done once the network is closed, releasing the node.
+	ctx context.Context
+	// node is the node the application runs on.
+	node *Node[LoggerT]
+	// gate holds the node between blocks.
+	gate *gate
+	// finalized is the block finalized and not committed yet.
+	finalized *cmtabci.FinalizeBlockResponse
+	// height is the height of the finalized block.
+	height int64
+}
+
+// FinalizeBlock finalizes the block, halting the node if it fails to.
+func (a *application[_]) FinalizeBlock(
+	ctx context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+) (*cmtabci.FinalizeBlockResponse, error) {
+	res, err := a.Application.FinalizeBlock(ctx, req)
+	if err != nil {
+		a.node.halt(err)
+		return nil, err
+	}
+	a.finalized, a.height = res, req.GetHeight()
+	return res, nil
+}
+
+// Commit commits the finalized block and holds the node until the network
+// may decide the next block.
+func (a *application[_]) Commit(
+	ctx context.Context,
+	req *cmtabci.CommitRequest,
+) (*cmtabci.CommitResponse, error) {
+	res, err := a.Application.Commit(ctx, req)
+	if err != nil {
+		a.node.halt(err)
+		return nil, err
+	}
+	a.node.commit(a.height, a.finalized.GetAppHash())
+	a.gate.wait(a.ctx, a.height)
+	return res, nil
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+const (
+	// defaultNumNodes is the default number of nodes of a network.
+	defaultNumNodes = 4
+	// defaultBlockTime is the default time waited between two blocks.
+	defaultBlockTime = time.Second
+	// defaultProposeTimeout is the default time waited for a proposal.
+	defaultProposeTimeout = time.Second
+	// defaultVoteTimeout is the default time waited for the remaining votes
+	// of a step.
+	defaultVoteTimeout = time.Second
+	// defaultBlockTimeout is the default time the network is given to make
+	// progress.
+	defaultBlockTimeout = 5 * time.Second
+)
+
+// Config is the configuration of a simulated network.
+type Config[LoggerT log.AdvancedLogger[LoggerT]] struct {
+	// NumNodes is the number of nodes, each running one genesis validator.
+	NumNodes int
+	// Components are the depinject components every node is built from,
+	// e.g. the default components of beacond.
+	Components []any
+	// NewLogger returns the logger of the node with the given index.
+	NewLogger func(node int) LoggerT
+	// HomeDir is the directory the homes of the nodes are created in.
+	HomeDir string
+	// KZGTrustedSetupPath is the path to the KZG trusted setup file.
+	KZGTrustedSetupPath string
+	// ChainID is the CometBFT chain ID of the network.
+	ChainID string
+	// Seed is the seed the keys of the validators are derived from.
+	Seed string
+	// GenesisTime is the time of the genesis block, the time the network is
+	// created at if zero.
+	GenesisTime time.Time
+	// BlockTime is the time the nodes wait after committing a block before
+	// starting the next height. Payload timestamps are in seconds and
+	// increase from block to block, so it is at least a second.
+	BlockTime time.Duration
+	// ProposeTimeout is the time the validators wait for the proposal of a
+	// round before voting nil. The nodes expect the payload timestamps of
+	// consecutive blocks to be at least the shortest of the consensus
+	// timeouts apart, so it is at least a second too.
+	ProposeTimeout time.Duration
+	// VoteTimeout is the time the validators wait for the remaining votes of
+	// a step once they have votes of more than two thirds of the power. It
+	// is at least a second, like the propose timeout.
+	VoteTimeout time.Duration
+	// BlockTimeout is the time the network is given to make progress
+	// towards the next block before it is considered halted.
+	BlockTimeout time.Duration
+	// AppOptions override the application options of every node, keyed by
+	// their flag, e.g. flags.BlockStoreServiceEnabled.
+	AppOptions map[string]any
+}
+
+// DefaultConfig returns the default configuration of a network, which is
+// completed with the components, loggers, home directory and trusted setup
+// of the nodes by the caller.
+func DefaultConfig[LoggerT log.AdvancedLogger[LoggerT]]() Config[LoggerT] {
+	return Config[LoggerT]{
+		NumNodes:       defaultNumNodes,
+		ChainID:        "beacond-simulator",
+		Seed:           "beacon-kit simulator",
+		BlockTime:      defaultBlockTime,
+		ProposeTimeout: defaultProposeTimeout,
+		VoteTimeout:    defaultVoteTimeout,
+		BlockTimeout:   defaultBlockTimeout,
+		AppOptions: map[string]any{
+			// Payloads are built instantly by the mock execution clients.
+			"beacon-kit.payload-builder.payload-timeout": "50ms",
+			// The first connection attempt may be made before the JWT of
+			// the engine client is set up, so it is retried right away.
+			"beacon-kit.engine.rpc-startup-check-interval": "50ms",
+		},
+	}
+}
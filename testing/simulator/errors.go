@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNoQuorum is returned when no block gathers the votes of more than
+	// two thirds of the voting power within the block timeout.
+	ErrNoQuorum = errors.New("no quorum reached")
+	// ErrNodeBehind is returned when a node that can reach the nodes which
+	// decided a block does not catch up with them within the block timeout.
+	ErrNodeBehind = errors.New("node behind the network")
+	// ErrNoConsensusParams is returned when the chain spec of the nodes has
+	// no CometBFT consensus parameters to start the network from.
+	ErrNoConsensusParams = errors.New("no CometBFT consensus params")
+	// ErrNotEnoughNodes is returned when the network is configured without
+	// any node.
+	ErrNotEnoughNodes = errors.New("network needs at least one node")
+)
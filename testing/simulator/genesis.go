@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// validatorKey returns the BLS secret key of the validator with the given
+// index, derived from the seed so that every run uses the same keys.
+func validatorKey(seed string, index int) signer.LegacyKey {
+	key := signer.LegacyKey(
+		sha256.Sum256([]byte(seed + "/" + strconv.Itoa(index))),
+	)
+	// Clear the top bits so that the key is below the curve order.
+	key[0] &= 0x3f
+	return key
+}
+
+// withdrawalAddress returns the withdrawal address of the validator with
+// the given index.
+func withdrawalAddress(index int) common.ExecutionAddress {
+	var address common.ExecutionAddress
+	//#nosec:G115 // the index is never negative.
+	binary.BigEndian.PutUint64(address[12:], uint64(index)+1)
+	return address
+}
+
+// newDeposit returns the deposit of the given amount made by the signer,
+// signed over the given genesis validators root.
+func newDeposit(
+	cs common.ChainSpec,
+	s crypto.BLSSigner,
+	credentials types.WithdrawalCredentials,
+	amount math.Gwei,
+	epoch math.Epoch,
+	genesisValidatorsRoot common.Root,
+	index uint64,
+) (*types.Deposit, error) {
+	msg, signature, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(
+			version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(epoch),
+			),
+			genesisValidatorsRoot,
+		),
+		cs.DomainTypeDeposit(),
+		s,
+		credentials,
+		amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewDeposit(
+		msg.Pubkey, msg.Credentials, msg.Amount, signature, index,
+	), nil
+}
+
+// newAppState returns the genesis app state of a network whose validators
+// are the given signers, each depositing the given amount, on top of the
+// given execution genesis block.
+func newAppState(
+	cs common.ChainSpec,
+	signers []crypto.BLSSigner,
+	amount math.Gwei,
+	genesisBlock *gethtypes.Block,
+) ([]byte, error) {
+	deposits := make([]*types.Deposit, len(signers))
+	for i, s := range signers {
+		var err error
+		//#nosec:G115 // the index is never negative.
+		deposits[i], err = newDeposit(
+			cs, s, types.NewCredentialsFromExecutionAddress(
+				withdrawalAddress(i),
+			), amount, 0, common.Root{}, uint64(i),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The genesis header is built from the payload of the genesis block.
+	bz, err := json.Marshal(
+		engine.BlockToExecutableData(genesisBlock, nil, nil).ExecutionPayload,
+	)
+	if err != nil {
+		return nil, err
+	}
+	payload := &types.ExecutionPayload{}
+	if err = json.Unmarshal(bz, payload); err != nil {
+		return nil, err
+	}
+	header, err := payload.ToHeader(
+		cs.MaxWithdrawalsPerPayload(), cs.DepositEth1ChainID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis, err := json.Marshal(
+		&types.Genesis[*types.Deposit, *types.ExecutionPayloadHeader]{
+			ForkVersion: version.FromUint32[common.Version](
+				cs.ActiveForkVersionForEpoch(0),
+			),
+			Deposits:               deposits,
+			ExecutionPayloadHeader: header,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]json.RawMessage{"beacon": genesis})
+}
+
+// writeGenesis writes the genesis file of a network with the given app state
+// to the given path, with the consensus parameters of the chain spec.
+func writeGenesis(
+	path string,
+	cs common.ChainSpec,
+	chainID string,
+	genesisTime time.Time,
+	appState []byte,
+) error {
+	params, ok := cs.GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams)
+	if !ok {
+		return ErrNoConsensusParams
+	}
+	return (&genutiltypes.AppGenesis{
+		ChainID:       chainID,
+		GenesisTime:   genesisTime,
+		InitialHeight: 1,
+		AppState:      appState,
+		Consensus:     &genutiltypes.ConsensusGenesis{Params: params},
+	}).SaveAs(path)
+}
@@ -0,0 +1,403 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/testing/mockel"
+	"github.com/cometbft/cometbft/p2p"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// stopTimeout bounds the time the services of the nodes are given to
+	// flush their in-flight work when the network is closed.
+	stopTimeout = 10 * time.Second
+	// startTimeout bounds the time the nodes are given to connect to each
+	// other and decide the first block.
+	startTimeout = 30 * time.Second
+	// pollInterval is the interval the heights of the nodes are polled at
+	// while the network decides blocks.
+	pollInterval = 10 * time.Millisecond
+)
+
+// Network is an in-process network of beacon nodes. Every node runs a
+// CometBFT node connected to the other nodes through relays, which let
+// tests inject crashes and partitions, while the mock execution clients of
+// the nodes let them inject execution layer faults. Blocks are decided by
+// CometBFT consensus, only as many at a time as tests ask for.
+type Network[LoggerT log.AdvancedLogger[LoggerT]] struct {
+	cfg   Config[LoggerT]
+	ctx   context.Context
+	stop  context.CancelFunc
+	chain *mockel.Chain
+	gate  *gate
+	nodes []*Node[LoggerT]
+	// links holds the links between the nodes, by pair of node indexes.
+	links map[[2]int]*link
+	// relays relay the connections between the nodes over their links.
+	relays []*relay
+	// joined is the number of validators added to the network.
+	joined int
+}
+
+// New creates a network from the given configuration, starts its nodes
+// from a genesis with one validator per node and waits for them to decide
+// the first block.
+func New[LoggerT log.AdvancedLogger[LoggerT]](
+	cfg Config[LoggerT],
+) (*Network[LoggerT], error) {
+	if cfg.NumNodes < 1 {
+		return nil, ErrNotEnoughNodes
+	}
+	if cfg.GenesisTime.IsZero() {
+		cfg.GenesisTime = time.Now().UTC()
+	}
+
+	// The execution genesis block predates the consensus genesis, so that
+	// the payload of the first block has a later timestamp.
+	genesisBlock := mockel.NewGenesisBlock(
+		//#nosec:G115 // the genesis time is never negative.
+		uint64(cfg.GenesisTime.Unix() - 1),
+	)
+	chain, err := mockel.NewChain(genesisBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	n := &Network[LoggerT]{
+		cfg:   cfg,
+		ctx:   ctx,
+		stop:  stop,
+		chain: chain,
+		gate:  newGate(1),
+		nodes: make([]*Node[LoggerT], 0, cfg.NumNodes),
+		links: make(map[[2]int]*link),
+	}
+	if err = n.init(genesisBlock); err != nil {
+		n.Close()
+		return nil, err
+	}
+	return n, nil
+}
+
+// init builds the nodes, writes their genesis, connects and starts them.
+func (n *Network[LoggerT]) init(genesisBlock *gethtypes.Block) error {
+	signers := make([]crypto.BLSSigner, 0, n.cfg.NumNodes)
+	for i := range n.cfg.NumNodes {
+		node, err := newNode(n.ctx, &n.cfg, i, n.chain, n.gate)
+		if err != nil {
+			return err
+		}
+		n.nodes = append(n.nodes, node)
+		signers = append(signers, node.signer)
+	}
+
+	cs := n.nodes[0].chainSpec
+	n.chain.SetChainID(cs.DepositEth1ChainID())
+	n.chain.SetDepositContract(
+		gethcommon.Address(cs.DepositContractAddress()),
+	)
+
+	appState, err := newAppState(
+		cs, signers, math.Gwei(cs.MaxEffectiveBalance()), genesisBlock,
+	)
+	if err != nil {
+		return err
+	}
+	for _, node := range n.nodes {
+		if err = writeGenesis(
+			node.cmtCfg.GenesisFile(), cs, n.cfg.ChainID,
+			n.cfg.GenesisTime, appState,
+		); err != nil {
+			return err
+		}
+	}
+
+	if err = n.connect(); err != nil {
+		return err
+	}
+	for _, node := range n.nodes {
+		if err = node.registry.StartAll(n.ctx); err != nil {
+			return err
+		}
+	}
+	return n.waitFor(n.ctx, 1, startTimeout)
+}
+
+// connect links every pair of nodes, the node with the lower index dialing
+// the other through the relay of their link.
+func (n *Network[LoggerT]) connect() error {
+	for i, node := range n.nodes {
+		peers := make([]string, 0, len(n.nodes)-i-1)
+		for j := i + 1; j < len(n.nodes); j++ {
+			peer := n.nodes[j]
+			l := newLink()
+			r, err := newRelay(
+				strings.TrimPrefix(peer.cmtCfg.P2P.ListenAddress, "tcp://"),
+				l,
+			)
+			if err != nil {
+				return err
+			}
+			n.links[[2]int{i, j}] = l
+			n.relays = append(n.relays, r)
+			peers = append(peers, p2p.IDAddressString(peer.id, r.Addr()))
+		}
+		node.cmtCfg.P2P.PersistentPeers = strings.Join(peers, ",")
+	}
+	return nil
+}
+
+// Close stops the nodes, their connections and their execution clients.
+func (n *Network[_]) Close() {
+	// Release the nodes held between blocks so that they can stop.
+	n.stop()
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+	for _, node := range n.nodes {
+		_ = node.registry.StopAll(ctx)
+	}
+	for _, r := range n.relays {
+		r.Close()
+	}
+	for _, node := range n.nodes {
+		_ = node.cmt.Close()
+		node.el.Close()
+	}
+}
+
+// Node returns the node with the given index.
+func (n *Network[LoggerT]) Node(i int) *Node[LoggerT] {
+	return n.nodes[i]
+}
+
+// Nodes returns the nodes of the network.
+func (n *Network[LoggerT]) Nodes() []*Node[LoggerT] {
+	return n.nodes
+}
+
+// Height returns the height of the last block decided by the network.
+func (n *Network[_]) Height() int64 {
+	return n.leader().Height()
+}
+
+// Round returns the round the block at the given height was decided in, -1
+// if no block was decided at that height.
+func (n *Network[_]) Round(height int64) int {
+	if c := n.leader().blockCommit(height); c != nil {
+		return int(c.Round)
+	}
+	return -1
+}
+
+// Proposer returns the address of the proposer of the block decided at the
+// given height.
+func (n *Network[_]) Proposer(height int64) []byte {
+	return n.leader().proposer(height)
+}
+
+// Validators returns the number of validators in the validator set the last
+// block was decided by.
+func (n *Network[_]) Validators() int {
+	if c := n.leader().blockCommit(n.Height()); c != nil {
+		return len(c.Signatures)
+	}
+	return 0
+}
+
+// Crash crashes the node with the given index. A crashed node can reach no
+// other node, so it neither proposes nor votes until it recovers.
+func (n *Network[_]) Crash(i int) {
+	n.nodes[i].crashed = true
+	n.updateLinks()
+}
+
+// Recover recovers the node with the given index from a crash. The node
+// catches up with the blocks decided in the meantime from its peers.
+func (n *Network[_]) Recover(i int) {
+	n.nodes[i].crashed = false
+	n.updateLinks()
+}
+
+// Partition splits the network into the given groups of node indexes.
+// Nodes of different groups cannot reach each other and nodes not listed
+// in any group are isolated.
+func (n *Network[_]) Partition(groups ...[]int) {
+	for _, node := range n.nodes {
+		node.partition = -1 - node.index
+	}
+	for g, group := range groups {
+		for _, i := range group {
+			n.nodes[i].partition = g
+		}
+	}
+	n.updateLinks()
+}
+
+// Heal heals every partition. The nodes that fell behind catch up with the
+// blocks decided in the meantime from their peers.
+func (n *Network[_]) Heal() {
+	for _, node := range n.nodes {
+		node.partition = 0
+	}
+	n.updateLinks()
+}
+
+// ProduceBlocks lets the network decide the given number of blocks and
+// waits for every node that can reach the nodes which decided them to
+// commit them. It returns an error if the network stops making progress.
+func (n *Network[_]) ProduceBlocks(ctx context.Context, count int) error {
+	target := n.Height() + int64(count)
+	n.gate.raise(target)
+	return n.waitFor(ctx, target, n.cfg.BlockTimeout)
+}
+
+// waitFor waits for the nodes that can reach the leader to commit the block
+// at the given height, returning an error once no node has committed a
+// block for the given timeout.
+func (n *Network[LoggerT]) waitFor(
+	ctx context.Context,
+	height int64,
+	timeout time.Duration,
+) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	progress, deadline := n.progress(), time.Now().Add(timeout)
+	for {
+		behind := n.behind(height)
+		if behind == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			if p := n.progress(); p > progress {
+				progress, deadline = p, now.Add(timeout)
+				continue
+			}
+			if now.Before(deadline) {
+				continue
+			}
+			if leader := n.Height(); leader < height {
+				return errors.Wrapf(ErrNoQuorum, "height %d", leader+1)
+			}
+			return errors.Wrapf(
+				ErrNodeBehind, "node %d at height %d",
+				behind.index, behind.Height(),
+			)
+		}
+	}
+}
+
+// behind returns a node that can reach the leader and has not committed the
+// block at the given height yet, nil if there is none.
+func (n *Network[LoggerT]) behind(height int64) *Node[LoggerT] {
+	leader := n.leader()
+	for _, node := range n.nodes {
+		if node.Height() < height && node.isUp() &&
+			node.partition == leader.partition {
+			return node
+		}
+	}
+	return nil
+}
+
+// progress returns the sum of the heights of the nodes, which increases
+// whenever a node commits a block.
+func (n *Network[_]) progress() int64 {
+	var sum int64
+	for _, node := range n.nodes {
+		sum += node.Height()
+	}
+	return sum
+}
+
+// leader returns a running node with the highest height.
+func (n *Network[LoggerT]) leader() *Node[LoggerT] {
+	var leader *Node[LoggerT]
+	for _, node := range n.nodes {
+		if leader == nil ||
+			node.Height() > leader.Height() ||
+			(!leader.isUp() && node.isUp()) {
+			leader = node
+		}
+	}
+	return leader
+}
+
+// updateLinks brings the links between the nodes that can reach each other
+// up and the other links down.
+func (n *Network[_]) updateLinks() {
+	for pair, l := range n.links {
+		a, b := n.nodes[pair[0]], n.nodes[pair[1]]
+		l.set(!a.crashed && !b.crashed && a.partition == b.partition)
+	}
+}
+
+// AddValidator deposits the given amount to the deposit contract for a new
+// validator, which joins the validator set once the deposit is processed.
+// The validator does not run a node, so it never proposes nor votes.
+func (n *Network[_]) AddValidator(amount math.Gwei) error {
+	node := n.nodes[0]
+	index := n.cfg.NumNodes + n.joined
+	s, err := signer.NewLegacySigner(validatorKey(n.cfg.Seed, index))
+	if err != nil {
+		return err
+	}
+	genesisValidatorsRoot, err := node.backend.GenesisValidatorsRoot(0)
+	if err != nil {
+		return err
+	}
+	cs := node.chainSpec
+	deposit, err := newDeposit(
+		cs, s, types.NewCredentialsFromExecutionAddress(
+			withdrawalAddress(index),
+		), amount,
+		cs.SlotToEpoch(math.Slot(n.Height())),
+		genesisValidatorsRoot, 0,
+	)
+	if err != nil {
+		return err
+	}
+	n.chain.AddDeposit(
+		deposit.Pubkey[:],
+		deposit.Credentials[:],
+		deposit.Amount.Unwrap(),
+		deposit.Signature[:],
+	)
+	n.joined++
+	return nil
+}
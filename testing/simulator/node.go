@@ -0,0 +1,418 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"cosmossdk.io/depinject"
+	clibuilder "github.com/berachain/beacon-kit/mod/cli/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	serverconfig "github.com/berachain/beacon-kit/mod/config/pkg/config"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/backend"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/testing/mockel"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+)
+
+// maj23QuerySleep is the time the CometBFT nodes sleep between two queries
+// of the vote majorities of a peer.
+const maj23QuerySleep = 24 * time.Hour
+
+// nodeBackend is the node API backend of a node.
+type nodeBackend[LoggerT log.AdvancedLogger[LoggerT]] interface {
+	// AttachQueryBackend attaches the CometBFT service the backend queries
+	// the state through.
+	AttachQueryBackend(*cometbft.Service[LoggerT])
+	// AttachServiceRegistry attaches the registry the backend reports the
+	// health of the services through.
+	AttachServiceRegistry(backend.ServiceRegistry)
+	// GenesisValidatorsRoot returns the genesis validators root of the state
+	// at the given slot.
+	GenesisValidatorsRoot(math.Slot) (common.Root, error)
+}
+
+// Node is a node of a simulated network, wired through the depinject graph
+// of the node components and running a CometBFT node connected to the other
+// nodes of the network.
+type Node[LoggerT log.AdvancedLogger[LoggerT]] struct {
+	// index is the index of the node in the network.
+	index int
+	// signer is the signer of the validator run by the node.
+	signer crypto.BLSSigner
+	// address is the consensus address of the validator run by the node.
+	address []byte
+	// el is the mock execution client of the node.
+	el *mockel.Server
+	// registry holds the services of the node.
+	registry *service.Registry
+	// cmt runs the CometBFT node and serves its ABCI methods.
+	cmt *cometbft.Service[LoggerT]
+	// cmtCfg is the configuration of the CometBFT node.
+	cmtCfg *cmtcfg.Config
+	// id is the peer-to-peer ID of the CometBFT node.
+	id p2p.ID
+	// backend is the node API backend of the node.
+	backend nodeBackend[LoggerT]
+	// chainSpec is the chain spec of the node.
+	chainSpec common.ChainSpec
+	// crashed is set while the node is crashed.
+	crashed bool
+	// partition is the partition the node is part of.
+	partition int
+
+	mu sync.Mutex
+	// height is the height of the last block committed by the node.
+	height int64
+	// appHash is the AppHash of the last block committed by the node.
+	appHash []byte
+	// halted is the error the node halted with, if any.
+	halted error
+}
+
+// newNode builds the node with the given index, along with its mock
+// execution client serving the given execution chain. The node is held by
+// the gate between blocks until the given context is done.
+func newNode[LoggerT log.AdvancedLogger[LoggerT]](
+	ctx context.Context,
+	cfg *Config[LoggerT],
+	index int,
+	chain *mockel.Chain,
+	g *gate,
+) (*Node[LoggerT], error) {
+	secret, err := jwt.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	el, err := mockel.New(chain, secret)
+	if err != nil {
+		return nil, err
+	}
+	n, err := buildNode(ctx, cfg, index, el, secret, g)
+	if err != nil {
+		el.Close()
+		return nil, err
+	}
+	return n, nil
+}
+
+// buildNode builds the node with the given index, configured to use the
+// given execution client.
+func buildNode[LoggerT log.AdvancedLogger[LoggerT]](
+	ctx context.Context,
+	cfg *Config[LoggerT],
+	index int,
+	el *mockel.Server,
+	secret *jwt.Secret,
+	g *gate,
+) (*Node[LoggerT], error) {
+	home := filepath.Join(cfg.HomeDir, "node"+strconv.Itoa(index))
+	appOpts, err := newAppOptions(cfg, home, el.URL(), secret)
+	if err != nil {
+		return nil, err
+	}
+	cmtCfg, err := newCometConfig(cfg, home, index)
+	if err != nil {
+		return nil, err
+	}
+
+	key := validatorKey(cfg.Seed, index)
+	s, err := signer.NewLegacySigner(key)
+	if err != nil {
+		return nil, err
+	}
+	address, err := crypto.GetAddressFromPubKey(s.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	id, err := writeNodeKeys(cmtCfg, key)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &Node[LoggerT]{
+		index:   index,
+		signer:  s,
+		address: address,
+		el:      el,
+		cmtCfg:  cmtCfg,
+		id:      id,
+	}
+	wrap := func(app cmtabci.Application) cmtabci.Application {
+		return &application[LoggerT]{
+			Application: app,
+			ctx:         ctx,
+			node:        n,
+			gate:        g,
+		}
+	}
+	if err = depinject.Inject(
+		depinject.Configs(
+			depinject.Provide(cfg.Components...),
+			depinject.Supply(
+				appOpts,
+				cfg.NewLogger(index),
+				dbm.NewMemDB(),
+				cmtCfg,
+				key,
+				[]func(*cometbft.Service[LoggerT]){
+					cometbft.SetApplicationWrapper[LoggerT](wrap),
+				},
+			),
+		),
+		&n.registry,
+		&n.cmt,
+		&n.backend,
+		&n.chainSpec,
+	); err != nil {
+		return nil, err
+	}
+	n.backend.AttachQueryBackend(n.cmt)
+	n.backend.AttachServiceRegistry(n.registry)
+	return n, nil
+}
+
+// newAppOptions writes the default application configuration and the JWT
+// secret to the home of a node and returns the application options read
+// from it.
+func newAppOptions[LoggerT log.AdvancedLogger[LoggerT]](
+	cfg *Config[LoggerT],
+	home string,
+	dialURL string,
+	secret *jwt.Secret,
+) (*viper.Viper, error) {
+	configDir := filepath.Join(home, "config")
+	if err := os.MkdirAll(configDir, 0o700); err != nil {
+		return nil, err
+	}
+
+	jwtPath := filepath.Join(home, "jwt.hex")
+	err := os.WriteFile(jwtPath, []byte(secret.Hex()), 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	appConfigPath := filepath.Join(configDir, "app.toml")
+	if err = serverconfig.SetConfigTemplate(
+		clibuilder.DefaultAppConfigTemplate(),
+	); err != nil {
+		return nil, err
+	}
+	if err = serverconfig.WriteConfigFile(
+		appConfigPath, clibuilder.DefaultAppConfig(),
+	); err != nil {
+		return nil, err
+	}
+
+	v := viper.New()
+	v.SetConfigFile(appConfigPath)
+	if err = v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	v.Set(sdkflags.FlagHome, home)
+	v.Set(sdkflags.FlagChainID, cfg.ChainID)
+	v.Set(flags.RPCDialURL, dialURL)
+	v.Set(flags.JWTSecretPath, jwtPath)
+	v.Set(flags.KZGTrustedSetupPath, cfg.KZGTrustedSetupPath)
+	for key, value := range cfg.AppOptions {
+		v.Set(key, value)
+	}
+	return v, nil
+}
+
+// newCometConfig returns the configuration of the CometBFT node of the node
+// with the given index, listening for its peers on a free local address.
+// The node serves no RPC nor metrics, which would bind fixed ports.
+func newCometConfig[LoggerT log.AdvancedLogger[LoggerT]](
+	cfg *Config[LoggerT],
+	home string,
+	index int,
+) (*cmtcfg.Config, error) {
+	if err := os.MkdirAll(filepath.Join(home, "data"), 0o700); err != nil {
+		return nil, err
+	}
+	listenAddress, err := freeAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	cmtCfg := clibuilder.DefaultCometConfig()
+	cmtCfg.SetRoot(home)
+	cmtCfg.Moniker = "node" + strconv.Itoa(index)
+	cmtCfg.Consensus.TimeoutPropose = cfg.ProposeTimeout
+	cmtCfg.Consensus.TimeoutPrevote = cfg.VoteTimeout
+	cmtCfg.Consensus.TimeoutPrecommit = cfg.VoteTimeout
+	//nolint:staticcheck // beacond still paces blocks with timeout_commit.
+	cmtCfg.Consensus.TimeoutCommit = cfg.BlockTime
+	// The routine querying peers for vote majorities reads the block store
+	// after sleeping without checking whether the node stopped, panicking
+	// once the store is closed. It only helps liveness under a flood of
+	// votes, so it is kept asleep for the lifetime of the network.
+	cmtCfg.Consensus.PeerQueryMaj23SleepDuration = maj23QuerySleep
+	cmtCfg.P2P.ListenAddress = "tcp://" + listenAddress
+	cmtCfg.P2P.PexReactor = false
+	// Every peer connects from the local address of a relay.
+	cmtCfg.P2P.AllowDuplicateIP = true
+	cmtCfg.P2P.AddrBookStrict = false
+	cmtCfg.RPC.ListenAddress = ""
+	cmtCfg.Instrumentation.Prometheus = false
+	return cmtCfg, nil
+}
+
+// writeNodeKeys writes the private validator key of the given validator key
+// and a new node key to the files the CometBFT node loads them from,
+// returning the peer-to-peer ID of the node.
+func writeNodeKeys(
+	cmtCfg *cmtcfg.Config,
+	key signer.LegacyKey,
+) (p2p.ID, error) {
+	privKey, err := bls12381.NewPrivateKeyFromBytes(key[:])
+	if err != nil {
+		return "", err
+	}
+	privval.NewFilePV(
+		privKey,
+		cmtCfg.PrivValidatorKeyFile(),
+		cmtCfg.PrivValidatorStateFile(),
+	).Save()
+
+	nodeKey := &p2p.NodeKey{PrivKey: ed25519.GenPrivKey()}
+	if err = nodeKey.SaveAs(cmtCfg.NodeKeyFile()); err != nil {
+		return "", err
+	}
+	return nodeKey.ID(), nil
+}
+
+// Index returns the index of the node in the network.
+func (n *Node[_]) Index() int {
+	return n.index
+}
+
+// Address returns the consensus address of the validator run by the node.
+func (n *Node[_]) Address() []byte {
+	return n.address
+}
+
+// ExecutionClient returns the mock execution client of the node.
+func (n *Node[_]) ExecutionClient() *mockel.Server {
+	return n.el
+}
+
+// Service returns the CometBFT service running the CometBFT node of the
+// node.
+func (n *Node[LoggerT]) Service() *cometbft.Service[LoggerT] {
+	return n.cmt
+}
+
+// Height returns the height of the last block committed by the node.
+func (n *Node[_]) Height() int64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.height
+}
+
+// AppHash returns the AppHash of the last block committed by the node.
+func (n *Node[_]) AppHash() []byte {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.appHash
+}
+
+// ServicesHealth returns the health of the services of the node by name, nil
+// for the healthy services.
+func (n *Node[_]) ServicesHealth() map[string]error {
+	return n.registry.Health()
+}
+
+// Halted returns the error the node halted with, if any. A node halts when
+// it fails to finalize or commit a decided block, upon which its CometBFT
+// node stops taking part in consensus.
+func (n *Node[_]) Halted() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.halted
+}
+
+// commit records the block committed by the node at the given height.
+func (n *Node[_]) commit(height int64, appHash []byte) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.height, n.appHash = height, appHash
+}
+
+// halt records the error the node halted with.
+func (n *Node[_]) halt(err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.halted = err
+}
+
+// isUp returns true if the node takes part in consensus.
+func (n *Node[_]) isUp() bool {
+	return !n.crashed && n.Halted() == nil
+}
+
+// blockCommit returns the commit of the block the node committed at the
+// given height, nil if the node has not committed it.
+func (n *Node[_]) blockCommit(height int64) *types.Commit {
+	store := n.cmt.BlockStore()
+	if store == nil {
+		return nil
+	}
+	if c := store.LoadBlockCommit(height); c != nil {
+		return c
+	}
+	return store.LoadSeenCommit(height)
+}
+
+// proposer returns the address of the proposer of the block the node
+// committed at the given height, nil if the node has not committed it.
+func (n *Node[_]) proposer(height int64) []byte {
+	store := n.cmt.BlockStore()
+	if store == nil {
+		return nil
+	}
+	if meta := store.LoadBlockMeta(height); meta != nil {
+		return meta.Header.ProposerAddress
+	}
+	return nil
+}
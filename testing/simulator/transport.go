@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulator
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// relayBufferSize is the size of the chunks relayed between two nodes.
+	relayBufferSize = 32 << 10
+	// relayDialInterval is the time waited before dialing a node again when
+	// it is not listening yet.
+	relayDialInterval = 50 * time.Millisecond
+)
+
+// link is the link between two nodes of the network. While a link is down,
+// the bytes sent over it are held back and delivered once it is up again,
+// as over a network whose route between the nodes is temporarily lost.
+type link struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+	// up is set while the nodes can reach each other.
+	up bool
+	// closed is set once the link is torn down.
+	closed bool
+}
+
+// newLink returns a link that is up.
+func newLink() *link {
+	l := &link{up: true}
+	l.cond = sync.NewCond(&l.mu)
+	return l
+}
+
+// set brings the link up or down.
+func (l *link) set(up bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.up = up
+	l.cond.Broadcast()
+}
+
+// wait waits for the link to be up, returning false if it is torn down in
+// the meantime.
+func (l *link) wait() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for !l.up && !l.closed {
+		l.cond.Wait()
+	}
+	return !l.closed
+}
+
+// close tears the link down.
+func (l *link) close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closed = true
+	l.cond.Broadcast()
+}
+
+// relay forwards the connections a node dials to one of its peers over the
+// link between them. The nodes run real CometBFT peer-to-peer connections,
+// the relay only decides when their bytes get through.
+type relay struct {
+	// listener accepts the connections dialed to the peer.
+	listener net.Listener
+	// target is the address the peer listens on.
+	target string
+	// link is the link between the node and the peer.
+	link *link
+
+	mu sync.Mutex
+	// conns holds the open connections, closed with the relay.
+	conns map[net.Conn]struct{}
+	// closed is set once the relay is closed.
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// newRelay starts relaying the connections dialed to the returned relay to
+// the given target address over the given link.
+func newRelay(target string, l *link) (*relay, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	r := &relay{
+		listener: listener,
+		target:   target,
+		link:     l,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	r.wg.Add(1)
+	go r.accept()
+	return r, nil
+}
+
+// Addr returns the address the relay is dialed at.
+func (r *relay) Addr() string {
+	return r.listener.Addr().String()
+}
+
+// Close stops the relay and closes the connections it relays.
+func (r *relay) Close() {
+	r.link.close()
+	_ = r.listener.Close()
+	r.mu.Lock()
+	r.closed = true
+	for conn := range r.conns {
+		_ = conn.Close()
+	}
+	r.mu.Unlock()
+	r.wg.Wait()
+}
+
+// accept relays every accepted connection until the relay is closed.
+func (r *relay) accept() {
+	defer r.wg.Done()
+	for {
+		conn, err := r.listener.Accept()
+		if err != nil {
+			return
+		}
+		if !r.track(conn) {
+			return
+		}
+		r.wg.Add(1)
+		go r.serve(conn)
+	}
+}
+
+// serve dials the target and relays the bytes of the connection in both
+// directions.
+func (r *relay) serve(conn net.Conn) {
+	defer r.wg.Done()
+	defer r.untrack(conn)
+
+	target, err := r.dial()
+	if err != nil {
+		return
+	}
+	defer r.untrack(target)
+
+	var wg sync.WaitGroup
+	wg.Add(2) //nolint:mnd // one per direction.
+	go r.pump(&wg, target, conn)
+	go r.pump(&wg, conn, target)
+	wg.Wait()
+}
+
+// dial dials the target, retrying until it listens or the relay is closed.
+func (r *relay) dial() (net.Conn, error) {
+	for {
+		conn, err := net.Dial("tcp", r.target)
+		if err == nil {
+			if !r.track(conn) {
+				return nil, net.ErrClosed
+			}
+			return conn, nil
+		}
+		if !r.link.wait() {
+			return nil, net.ErrClosed
+		}
+		time.Sleep(relayDialInterval)
+	}
+}
+
+// pump copies the bytes read from src to dst whenever the link is up,
+// closing both connections once either of them fails.
+func (r *relay) pump(wg *sync.WaitGroup, dst, src net.Conn) {
+	defer wg.Done()
+	defer dst.Close()
+	defer src.Close()
+
+	buf := make([]byte, relayBufferSize)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			if !r.link.wait() {
+				return
+			}
+			if _, err = dst.Write(buf[:n]); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// track registers the connection to be closed with the relay, returning
+// false and closing it if the relay is already closed.
+func (r *relay) track(conn net.Conn) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		_ = conn.Close()
+		return false
+	}
+	r.conns[conn] = struct{}{}
+	return true
+}
+
+// untrack closes the connection and forgets about it.
+func (r *relay) untrack(conn net.Conn) {
+	_ = conn.Close()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.conns, conn)
+}
+
+// freeAddress returns a local address nothing listens on.
+func freeAddress() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer listener.Close()
+	return listener.Addr().String(), nil
+}